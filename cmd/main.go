@@ -14,6 +14,7 @@ import (
 	_ "github.com/hanifbg/landing_backend/docs"
 	handlerInit "github.com/hanifbg/landing_backend/internal/handler/util"
 	repoInit "github.com/hanifbg/landing_backend/internal/repository/util"
+	"github.com/hanifbg/landing_backend/internal/scheduler"
 	servInit "github.com/hanifbg/landing_backend/internal/service/util"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -29,6 +30,10 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 	return cv.validator.Struct(i)
 }
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token of a customer or an admin, sent as "Bearer <access_token>"
 func main() {
 	// Initialize configuration
 	cfg, err := config.GetConfig()
@@ -45,7 +50,9 @@ func main() {
 	serv, err := servInit.New(cfg, repo)
 	if err != nil {
 		fmt.Println("GOT ERROR serv Init", err)
-		// Continue to see cache logs even if service init fails
+	}
+	if serv != nil {
+		serv.WarmUp(cfg)
 	}
 
 	// Initialize Echo
@@ -81,6 +88,14 @@ func main() {
 
 	log.Printf("Server is running at http://%s", serverAddr)
 
+	// Start background jobs
+	var jobs []scheduler.Job
+	if serv != nil {
+		jobs = serv.Jobs(cfg)
+	}
+	sched := scheduler.New(jobs...)
+	sched.Start(context.Background())
+
 	// Wait for interrupt signal to gracefully shutdown the server with a timeout of 10 seconds.
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
@@ -89,6 +104,9 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Stop background jobs before the server so in-flight work can finish
+	sched.Stop()
+
 	if err := e.Shutdown(ctx); err != nil {
 		e.Logger.Fatal(err)
 	}
@@ -24,12 +24,18 @@ type AppConfig struct {
 	BaseURL           string `mapstructure:"base_url"`
 	RajaOngkirAPIKey  string `mapstructure:"rajaongkir_api_key"`
 	RajaOngkirBaseURL string `mapstructure:"rajaongkir_base_url"`
+	ShippingOriginID  string `mapstructure:"shipping_origin_id"` // RajaOngkir district ID orders ship from
 
-	// RajaOngkir caching configuration
+	ShippingRateTablePath string `mapstructure:"shipping_rate_table_path"` // JSON file of fixed courier rates quoted next to RajaOngkir; unused while empty
+
+	RajaOngkirWebhookSecret string `mapstructure:"rajaongkir_webhook_secret"` // Signs tracking pushes; the webhook is off while empty
+
+	// RajaOngkir location storage configuration. Provinces, cities and districts are kept in the
+	// database while the cache is enabled, and re-read once they are older than the TTL.
 	RajaOngkirCacheEnabled      bool   `mapstructure:"rajaongkir_cache_enabled"`
 	RajaOngkirCacheTTLHours     int    `mapstructure:"rajaongkir_cache_ttl_hours"`
-	RajaOngkirWarmupOnStartup   bool   `mapstructure:"rajaongkir_warmup_on_startup"`
-	RajaOngkirWarmupTimeoutSecs int    `mapstructure:"rajaongkir_warmup_timeout_secs"`
+	RajaOngkirWarmupOnStartup   bool   `mapstructure:"rajaongkir_warmup_on_startup"`   // Store missing provinces and cities before serving
+	RajaOngkirWarmupTimeoutSecs int    `mapstructure:"rajaongkir_warmup_timeout_secs"` // How long startup waits for the warm-up
 	SMTPHost                    string `mapstructure:"smtp_host"`
 	SMTPPort                    int    `mapstructure:"smtp_port"`
 	SMTPUsername                string `mapstructure:"smtp_username"`
@@ -39,6 +45,29 @@ type AppConfig struct {
 	TeleToken                   string `mapstructure:"tele_token"`
 	TeleOrderChatID             int64  `mapstructure:"tele_order_chat_id"`
 	TeleMessageThreadID         int64  `mapstructure:"tele_message_thread_id"`
+	// Low-stock alerts go to this chat and thread. The chat falls back to TeleOrderChatID when unset.
+	TeleLowStockChatID   int64 `mapstructure:"tele_low_stock_chat_id"`
+	TeleLowStockThreadID int64 `mapstructure:"tele_low_stock_thread_id"`
+
+	// Customer auth configuration
+	JWTSecret           string `mapstructure:"jwt_secret"`
+	JWTAccessTTLMinutes int    `mapstructure:"jwt_access_ttl_minutes"`
+	JWTRefreshTTLHours  int    `mapstructure:"jwt_refresh_ttl_hours"`
+
+	EmailVerificationTTLHours int `mapstructure:"email_verification_ttl_hours"`
+	PasswordResetTTLMinutes   int `mapstructure:"password_reset_ttl_minutes"`
+
+	// Admin auth configuration. The owner account is created on startup while there are no admins yet.
+	AdminTokenTTLMinutes int    `mapstructure:"admin_token_ttl_minutes"`
+	AdminOwnerEmail      string `mapstructure:"admin_owner_email"`
+	AdminOwnerPassword   string `mapstructure:"admin_owner_password"`
+
+	// Background job configuration
+	PaymentExpirySweepIntervalMins int `mapstructure:"payment_expiry_sweep_interval_mins"`
+	AWBRefreshIntervalMins         int `mapstructure:"awb_refresh_interval_mins"`   // How often undelivered shipments are re-tracked
+	AWBTrackingMaxAgeMins          int `mapstructure:"awb_tracking_max_age_mins"`   // Older tracking is re-read from the courier when a customer looks it up
+	LocationSyncIntervalMins       int `mapstructure:"location_sync_interval_mins"` // How often stored locations are checked for a refresh
+	LocationSyncMaxRequests        int `mapstructure:"location_sync_max_requests"`  // RajaOngkir calls one location sync may make
 }
 
 type WhatsappConfig struct {
@@ -91,11 +120,31 @@ func initConfig() (*AppConfig, error) {
 		finalConfig.IsProduction = getEnvBoolOrDefault("IS_PRODUCTION", false)
 		finalConfig.RajaOngkirAPIKey = getEnvOrDefault("RAJAONGKIR_API_KEY", "")
 		finalConfig.RajaOngkirBaseURL = getEnvOrDefault("RAJAONGKIR_BASE_URL", "")
+		finalConfig.RajaOngkirWebhookSecret = getEnvOrDefault("RAJAONGKIR_WEBHOOK_SECRET", "")
+		finalConfig.ShippingOriginID = getEnvOrDefault("SHIPPING_ORIGIN_ID", "")
+		finalConfig.ShippingRateTablePath = getEnvOrDefault("SHIPPING_RATE_TABLE_PATH", "")
 		finalConfig.SMTPHost = getEnvOrDefault("SMTP_HOST", "")
 		finalConfig.SMTPPort = getEnvIntOrDefault("SMTP_PORT", 0)
 		finalConfig.SMTPUsername = getEnvOrDefault("SMTP_USERNAME", "")
 		finalConfig.SMTPPassword = getEnvOrDefault("SMTP_PASSWORD", "")
 		finalConfig.SMTPFrom = getEnvOrDefault("SMTP_FROM", "")
+		finalConfig.PaymentExpirySweepIntervalMins = getEnvIntOrDefault("PAYMENT_EXPIRY_SWEEP_INTERVAL_MINS", 5)
+		finalConfig.AWBRefreshIntervalMins = getEnvIntOrDefault("AWB_REFRESH_INTERVAL_MINS", 60)
+		finalConfig.AWBTrackingMaxAgeMins = getEnvIntOrDefault("AWB_TRACKING_MAX_AGE_MINS", 15)
+		finalConfig.RajaOngkirCacheEnabled = getEnvBoolOrDefault("RAJAONGKIR_CACHE_ENABLED", true)
+		finalConfig.RajaOngkirCacheTTLHours = getEnvIntOrDefault("RAJAONGKIR_CACHE_TTL_HOURS", 168)
+		finalConfig.RajaOngkirWarmupOnStartup = getEnvBoolOrDefault("RAJAONGKIR_WARMUP_ON_STARTUP", true)
+		finalConfig.RajaOngkirWarmupTimeoutSecs = getEnvIntOrDefault("RAJAONGKIR_WARMUP_TIMEOUT_SECS", 30)
+		finalConfig.LocationSyncIntervalMins = getEnvIntOrDefault("LOCATION_SYNC_INTERVAL_MINS", 60)
+		finalConfig.LocationSyncMaxRequests = getEnvIntOrDefault("LOCATION_SYNC_MAX_REQUESTS", 3)
+		finalConfig.JWTSecret = getEnvOrDefault("JWT_SECRET", "")
+		finalConfig.JWTAccessTTLMinutes = getEnvIntOrDefault("JWT_ACCESS_TTL_MINUTES", 15)
+		finalConfig.JWTRefreshTTLHours = getEnvIntOrDefault("JWT_REFRESH_TTL_HOURS", 720)
+		finalConfig.EmailVerificationTTLHours = getEnvIntOrDefault("EMAIL_VERIFICATION_TTL_HOURS", 24)
+		finalConfig.PasswordResetTTLMinutes = getEnvIntOrDefault("PASSWORD_RESET_TTL_MINUTES", 60)
+		finalConfig.AdminTokenTTLMinutes = getEnvIntOrDefault("ADMIN_TOKEN_TTL_MINUTES", 480)
+		finalConfig.AdminOwnerEmail = getEnvOrDefault("ADMIN_OWNER_EMAIL", "")
+		finalConfig.AdminOwnerPassword = getEnvOrDefault("ADMIN_OWNER_PASSWORD", "")
 		return &finalConfig, nil
 	}
 
@@ -111,12 +160,20 @@ func initConfig() (*AppConfig, error) {
 	finalConfig.MidtransServerKey = viper.GetString("payment.midtrans_server_key")
 	finalConfig.MidtransClientKey = viper.GetString("payment.midtrans_client_key")
 	finalConfig.IsProduction = viper.GetBool("payment.is_production")
+	finalConfig.PaymentExpirySweepIntervalMins = viper.GetInt("payment.expiry_sweep_interval_mins")
 	finalConfig.BaseURL = viper.GetString("base_url")
 	finalConfig.HttpTimeout = viper.GetInt("http_timeout")
 	finalConfig.RajaOngkirAPIKey = viper.GetString("shipping.rajaongkir_api_key")
 	finalConfig.RajaOngkirBaseURL = viper.GetString("shipping.rajaongkir_base_url")
-
-	// Load cache configuration
+	finalConfig.RajaOngkirWebhookSecret = viper.GetString("shipping.rajaongkir_webhook_secret")
+	finalConfig.ShippingOriginID = viper.GetString("shipping.origin_district_id")
+	finalConfig.ShippingRateTablePath = viper.GetString("shipping.rate_table_path")
+	finalConfig.AWBRefreshIntervalMins = viper.GetInt("shipping.awb_refresh_interval_mins")
+	finalConfig.AWBTrackingMaxAgeMins = viper.GetInt("shipping.awb_tracking_max_age_mins")
+	finalConfig.LocationSyncIntervalMins = viper.GetInt("shipping.location_sync_interval_mins")
+	finalConfig.LocationSyncMaxRequests = viper.GetInt("shipping.location_sync_max_requests")
+
+	// Load location storage configuration
 	finalConfig.RajaOngkirCacheEnabled = viper.GetBool("shipping.rajaongkir_cache_enabled")
 	finalConfig.RajaOngkirCacheTTLHours = viper.GetInt("shipping.rajaongkir_cache_ttl_hours")
 	finalConfig.RajaOngkirWarmupOnStartup = viper.GetBool("shipping.rajaongkir_warmup_on_startup")
@@ -134,9 +191,23 @@ func initConfig() (*AppConfig, error) {
 	finalConfig.WhatsappConfig.Username = viper.GetString("whatsapp.username")
 	finalConfig.WhatsappConfig.Password = viper.GetString("whatsapp.password")
 
+	//auth
+	finalConfig.JWTSecret = viper.GetString("auth.jwt_secret")
+	finalConfig.JWTAccessTTLMinutes = viper.GetInt("auth.access_token_ttl_minutes")
+	finalConfig.JWTRefreshTTLHours = viper.GetInt("auth.refresh_token_ttl_hours")
+	finalConfig.EmailVerificationTTLHours = viper.GetInt("auth.email_verification_ttl_hours")
+	finalConfig.PasswordResetTTLMinutes = viper.GetInt("auth.password_reset_ttl_minutes")
+
+	//admin
+	finalConfig.AdminTokenTTLMinutes = viper.GetInt("admin.token_ttl_minutes")
+	finalConfig.AdminOwnerEmail = viper.GetString("admin.owner_email")
+	finalConfig.AdminOwnerPassword = viper.GetString("admin.owner_password")
+
 	finalConfig.TeleToken = viper.GetString("telegram.token")
 	finalConfig.TeleOrderChatID = viper.GetInt64("telegram.order_chat_id")
 	finalConfig.TeleMessageThreadID = viper.GetInt64("telegram.message_thread_id")
+	finalConfig.TeleLowStockChatID = viper.GetInt64("telegram.low_stock_chat_id")
+	finalConfig.TeleLowStockThreadID = viper.GetInt64("telegram.low_stock_thread_id")
 
 	return &finalConfig, nil
 }
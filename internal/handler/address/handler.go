@@ -0,0 +1,151 @@
+package address
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// ListAddresses godoc
+// @Summary List saved addresses
+// @Description Get the logged in customer's saved addresses, default address first
+// @Tags addresses
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} response.AddressResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/addresses [get]
+func (h *ApiWrapper) ListAddresses(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	addresses, err := h.addressService.ListAddresses(customerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, addresses)
+}
+
+// GetAddress godoc
+// @Summary Get a saved address
+// @Tags addresses
+// @Produce json
+// @Security BearerAuth
+// @Param address_id path string true "Address ID"
+// @Success 200 {object} response.AddressResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/addresses/{address_id} [get]
+func (h *ApiWrapper) GetAddress(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	address, err := h.addressService.GetAddress(customerID, c.Param("address_id"))
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, address)
+}
+
+// CreateAddress godoc
+// @Summary Save a new address
+// @Description The customer's first address, or one sent with is_default true, becomes the default address
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.AddressRequest true "Address"
+// @Success 201 {object} response.AddressResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/addresses [post]
+func (h *ApiWrapper) CreateAddress(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	var req request.AddressRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	address, err := h.addressService.CreateAddress(customerID, req)
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, address)
+}
+
+// UpdateAddress godoc
+// @Summary Replace a saved address
+// @Description Send is_default true to make the address the default. The default address stays the default until another address is made the default.
+// @Tags addresses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param address_id path string true "Address ID"
+// @Param request body request.AddressRequest true "Address"
+// @Success 200 {object} response.AddressResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/addresses/{address_id} [put]
+func (h *ApiWrapper) UpdateAddress(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	var req request.AddressRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	address, err := h.addressService.UpdateAddress(customerID, c.Param("address_id"), req)
+	if err != nil {
+		return addressError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, address)
+}
+
+// DeleteAddress godoc
+// @Summary Delete a saved address
+// @Description When the default address is deleted, the newest remaining address becomes the default
+// @Tags addresses
+// @Produce json
+// @Security BearerAuth
+// @Param address_id path string true "Address ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/addresses/{address_id} [delete]
+func (h *ApiWrapper) DeleteAddress(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	if err := h.addressService.DeleteAddress(customerID, c.Param("address_id")); err != nil {
+		return addressError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Address deleted successfully"})
+}
+
+func addressError(c echo.Context, err error) error {
+	if errors.Is(err, service.ErrAddressNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Address not found"})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
@@ -0,0 +1,31 @@
+package address
+
+import (
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/util"
+	"github.com/labstack/echo/v4"
+)
+
+type ApiWrapper struct {
+	addressService service.AddressService
+	authService    service.AuthService
+}
+
+func InitRoute(e *echo.Echo, servWrapper *util.ServiceWrapper) {
+	api := ApiWrapper{
+		addressService: servWrapper.AddressService,
+		authService:    servWrapper.AuthService,
+	}
+	api.registerRouter(e)
+}
+
+func (h *ApiWrapper) registerRouter(e *echo.Echo) {
+	addressGroup := e.Group("/api/v1/me/addresses", middleware.RequireCustomer(h.authService))
+
+	addressGroup.GET("", h.ListAddresses)
+	addressGroup.POST("", h.CreateAddress)
+	addressGroup.GET("/:address_id", h.GetAddress)
+	addressGroup.PUT("/:address_id", h.UpdateAddress)
+	addressGroup.DELETE("/:address_id", h.DeleteAddress)
+}
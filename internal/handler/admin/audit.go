@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/labstack/echo/v4"
+)
+
+// ListAuditLogs godoc
+// @Summary List the admin audit log
+// @Description Lists admin actions newest first, each with the fields it changed before and after
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param actor_id query string false "Admin user ID"
+// @Param action query string false "Action, e.g. product.update"
+// @Param target_type query string false "Target type: product, variant, category, order or admin_user"
+// @Param target_id query string false "Target ID"
+// @Param page query int false "Page number, starting at 1"
+// @Param limit query int false "Entries per page, at most 100"
+// @Success 200 {object} response.AuditLogListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/audit-logs [get]
+func (h *ApiWrapper) ListAuditLogs(c echo.Context) error {
+	req := request.AuditLogListRequest{
+		ActorID:    c.QueryParam("actor_id"),
+		Action:     c.QueryParam("action"),
+		TargetType: c.QueryParam("target_type"),
+		TargetID:   c.QueryParam("target_id"),
+	}
+
+	var err error
+	if page := c.QueryParam("page"); page != "" {
+		if req.Page, err = strconv.Atoi(page); err != nil || req.Page < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "page must be a positive number"})
+		}
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		if req.Limit, err = strconv.Atoi(limit); err != nil || req.Limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit must be a positive number"})
+		}
+	}
+
+	logs, err := h.auditLogService.ListAuditLogs(req)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get audit logs"})
+	}
+
+	return c.JSON(http.StatusOK, logs)
+}
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// Login godoc
+// @Summary Sign in as an admin
+// @Description Signs in with an admin email and password and returns an access token for the admin API
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body request.AdminLoginRequest true "Credentials"
+// @Success 200 {object} response.AdminAuthResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/auth/login [post]
+func (h *ApiWrapper) Login(c echo.Context) error {
+	var req request.AdminLoginRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	resp, err := h.adminUserService.Login(req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to sign in"})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Me godoc
+// @Summary Get the signed-in admin
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} entity.AdminUser
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/auth/me [get]
+func (h *ApiWrapper) Me(c echo.Context) error {
+	admin, err := h.adminUserService.GetAdminUser(adminActor(c).ID)
+	if err != nil {
+		return adminUserError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, admin)
+}
+
+// adminActor returns the admin signed in by the RequireAdmin middleware
+func adminActor(c echo.Context) service.AdminActor {
+	actor, _ := middleware.Admin(c)
+	return actor
+}
@@ -0,0 +1,152 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// ListCategories godoc
+// @Summary List categories for the admin
+// @Description Lists every category, active or not, sorted by display order
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entity.Category
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/categories [get]
+func (h *ApiWrapper) ListCategories(c echo.Context) error {
+	categories, err := h.categoryService.GetAllCategories()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to fetch categories"})
+	}
+
+	return c.JSON(http.StatusOK, categories)
+}
+
+// GetCategory godoc
+// @Summary Get a category for the admin
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Success 200 {object} entity.Category
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/categories/{id} [get]
+func (h *ApiWrapper) GetCategory(c echo.Context) error {
+	category, err := h.categoryService.GetCategory(c.Param("id"))
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, category)
+}
+
+// CreateCategory godoc
+// @Summary Create a category
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CategoryRequest true "Category"
+// @Success 201 {object} entity.Category
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/categories [post]
+func (h *ApiWrapper) CreateCategory(c echo.Context) error {
+	var req request.CategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	category, err := h.categoryService.CreateCategory(adminActor(c), req)
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, category)
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Replaces the category. Products stay in the category when its slug is renamed.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Param request body request.CategoryRequest true "Category"
+// @Success 200 {object} entity.Category
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/categories/{id} [put]
+func (h *ApiWrapper) UpdateCategory(c echo.Context) error {
+	var req request.CategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	category, err := h.categoryService.UpdateCategory(adminActor(c), c.Param("id"), req)
+	if err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Only categories without products can be deleted
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/categories/{id} [delete]
+func (h *ApiWrapper) DeleteCategory(c echo.Context) error {
+	if err := h.categoryService.DeleteCategory(adminActor(c), c.Param("id")); err != nil {
+		return categoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Category deleted successfully"})
+}
+
+func categoryError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrCategoryNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Category not found"})
+	case errors.Is(err, service.ErrInvalidCategorySlug):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	case errors.Is(err, service.ErrDuplicateCategory), errors.Is(err, service.ErrCategoryInUse):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
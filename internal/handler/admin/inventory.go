@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// GetInventoryHistory godoc
+// @Summary Get a variant's stock ledger
+// @Description Lists a variant's stock movements newest first, with its current stock and what the whole ledger adds up to
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Param page query int false "Page number, starting at 1"
+// @Param limit query int false "Movements per page, at most 100"
+// @Success 200 {object} response.InventoryHistoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id}/inventory [get]
+func (h *ApiWrapper) GetInventoryHistory(c echo.Context) error {
+	var page, limit int
+	var err error
+	if value := c.QueryParam("page"); value != "" {
+		if page, err = strconv.Atoi(value); err != nil || page < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "page must be a positive number"})
+		}
+	}
+	if value := c.QueryParam("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit must be a positive number"})
+		}
+	}
+
+	history, err := h.inventoryService.GetInventoryHistory(c.Param("id"), c.Param("variant_id"), page, limit)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, history)
+}
+
+// AdjustInventory godoc
+// @Summary Adjust a variant's stock
+// @Description Records a restock, return or manual adjustment in the variant's ledger and changes its stock by quantity_delta. Restocks and returns must add stock.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Param request body request.InventoryAdjustmentRequest true "Stock movement"
+// @Success 201 {object} response.InventoryAdjustmentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id}/inventory/adjustments [post]
+func (h *ApiWrapper) AdjustInventory(c echo.Context) error {
+	var req request.InventoryAdjustmentRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	adjustment, err := h.inventoryService.AdjustInventory(adminActor(c), c.Param("id"), c.Param("variant_id"), req)
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, adjustment)
+}
+
+// RebuildStock godoc
+// @Summary Rebuild a variant's stock from its ledger
+// @Description Sets the variant's stock to the sum of its stock movements
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Success 200 {object} response.StockRebuildResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id}/inventory/rebuild [post]
+func (h *ApiWrapper) RebuildStock(c echo.Context) error {
+	rebuild, err := h.inventoryService.RebuildStock(adminActor(c), c.Param("id"), c.Param("variant_id"))
+	if err != nil {
+		return inventoryError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, rebuild)
+}
+
+func inventoryError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidInventoryAdjustment):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, service.ErrNegativeStock):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return productError(c, err)
+}
@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// dateLayout is the format of the from and to query parameters
+const dateLayout = "2006-01-02"
+
+// ListOrders godoc
+// @Summary List orders for the admin
+// @Description Lists orders newest first, filtered by status, creation date, courier and source channel, and searched by order number, customer name, email or phone
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Comma separated order statuses"
+// @Param from query string false "Created on or after this date (YYYY-MM-DD)"
+// @Param to query string false "Created on or before this date (YYYY-MM-DD)"
+// @Param courier query string false "Courier code, e.g. jne"
+// @Param source_channel query string false "Source channel, e.g. web"
+// @Param q query string false "Part of the order number, customer name, email or phone"
+// @Param page query int false "Page number, starting at 1"
+// @Param limit query int false "Orders per page, at most 100"
+// @Success 200 {object} response.AdminOrderListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/orders [get]
+func (h *ApiWrapper) ListOrders(c echo.Context) error {
+	req := request.AdminListOrdersRequest{
+		Courier:       c.QueryParam("courier"),
+		SourceChannel: c.QueryParam("source_channel"),
+		Search:        c.QueryParam("q"),
+	}
+	for _, status := range strings.Split(c.QueryParam("status"), ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			req.Statuses = append(req.Statuses, status)
+		}
+	}
+
+	if from := c.QueryParam("from"); from != "" {
+		date, err := time.ParseInLocation(dateLayout, from, time.Local)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "from must be a date in YYYY-MM-DD format"})
+		}
+		req.CreatedFrom = &date
+	}
+	if to := c.QueryParam("to"); to != "" {
+		date, err := time.ParseInLocation(dateLayout, to, time.Local)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "to must be a date in YYYY-MM-DD format"})
+		}
+		// The whole "to" day is included
+		before := date.AddDate(0, 0, 1)
+		req.CreatedBefore = &before
+	}
+
+	var err error
+	if page := c.QueryParam("page"); page != "" {
+		if req.Page, err = strconv.Atoi(page); err != nil || req.Page < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "page must be a positive number"})
+		}
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		if req.Limit, err = strconv.Atoi(limit); err != nil || req.Limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit must be a positive number"})
+		}
+	}
+
+	orders, err := h.orderService.ListOrders(req)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get orders"})
+	}
+
+	return c.JSON(http.StatusOK, orders)
+}
+
+// GetOrder godoc
+// @Summary Get an order for the admin
+// @Description Gets the full order with its status history and the statuses it can move to next
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param order_id path string true "Order ID"
+// @Success 200 {object} response.AdminOrderResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/orders/{order_id} [get]
+func (h *ApiWrapper) GetOrder(c echo.Context) error {
+	order, err := h.orderService.GetAdminOrder(c.Param("order_id"))
+	if err != nil {
+		return orderError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+// UpdateOrderStatus godoc
+// @Summary Change an order's status
+// @Description Moves the order to a status allowed from its current one and records which admin changed it
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param order_id path string true "Order ID"
+// @Param request body request.UpdateOrderStatusRequest true "New status"
+// @Success 200 {object} response.AdminOrderResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/orders/{order_id}/status [put]
+func (h *ApiWrapper) UpdateOrderStatus(c echo.Context) error {
+	var req request.UpdateOrderStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	order, err := h.orderService.UpdateOrderStatus(adminActor(c), c.Param("order_id"), req)
+	if err != nil {
+		return orderError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+func orderError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrOrderNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Order not found"})
+	case errors.Is(err, service.ErrInvalidStatusTransition):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, service.ErrOrderStatusChanged):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
@@ -0,0 +1,292 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// ListProducts godoc
+// @Summary List products for the admin
+// @Description Lists every product with all of its variants, including inactive ones unless active_only is true
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param active_only query bool false "Leave out inactive products"
+// @Success 200 {array} entity.Product
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products [get]
+func (h *ApiWrapper) ListProducts(c echo.Context) error {
+	activeOnly := c.QueryParam("active_only") == "true"
+
+	products, err := h.productService.ListProducts(!activeOnly)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to fetch products"})
+	}
+
+	return c.JSON(http.StatusOK, products)
+}
+
+// GetProduct godoc
+// @Summary Get a product for the admin
+// @Description Gets a product with all of its variants, active or not
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} entity.Product
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id} [get]
+func (h *ApiWrapper) GetProduct(c echo.Context) error {
+	product, err := h.productService.GetProduct(c.Param("id"))
+	if err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, product)
+}
+
+// CreateProduct godoc
+// @Summary Create a product
+// @Description Creates an active product with at least one variant. Every SKU must be unique.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CreateProductRequest true "Product with its variants"
+// @Success 201 {object} entity.Product
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products [post]
+func (h *ApiWrapper) CreateProduct(c echo.Context) error {
+	var req request.CreateProductRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	product, err := h.productService.CreateProduct(adminActor(c), req)
+	if err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, product)
+}
+
+// UpdateProduct godoc
+// @Summary Update a product
+// @Description Replaces the product's own fields. Variants are changed through the variant endpoints.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param request body request.ProductRequest true "Product"
+// @Success 200 {object} entity.Product
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id} [put]
+func (h *ApiWrapper) UpdateProduct(c echo.Context) error {
+	var req request.ProductRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	product, err := h.productService.UpdateProduct(adminActor(c), c.Param("id"), req)
+	if err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, product)
+}
+
+// DeleteProduct godoc
+// @Summary Deactivate a product
+// @Description Soft-deletes the product so it disappears from the storefront. It can be reactivated later.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id} [delete]
+func (h *ApiWrapper) DeleteProduct(c echo.Context) error {
+	if err := h.productService.SetProductActive(adminActor(c), c.Param("id"), false); err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Product deactivated successfully"})
+}
+
+// ReactivateProduct godoc
+// @Summary Reactivate a product
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/reactivate [post]
+func (h *ApiWrapper) ReactivateProduct(c echo.Context) error {
+	if err := h.productService.SetProductActive(adminActor(c), c.Param("id"), true); err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Product reactivated successfully"})
+}
+
+// CreateVariant godoc
+// @Summary Add a variant to a product
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param request body request.VariantRequest true "Variant"
+// @Success 201 {object} entity.ProductVariant
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants [post]
+func (h *ApiWrapper) CreateVariant(c echo.Context) error {
+	var req request.VariantRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	variant, err := h.productService.CreateVariant(adminActor(c), c.Param("id"), req)
+	if err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, variant)
+}
+
+// UpdateVariant godoc
+// @Summary Update a variant
+// @Description Replaces the variant's fields. The SKU may change as long as no other variant uses it. A non-zero stock_quantity is rejected; stock changes through inventory adjustments.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Param request body request.VariantRequest true "Variant"
+// @Success 200 {object} entity.ProductVariant
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id} [put]
+func (h *ApiWrapper) UpdateVariant(c echo.Context) error {
+	var req request.VariantRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	variant, err := h.productService.UpdateVariant(adminActor(c), c.Param("id"), c.Param("variant_id"), req)
+	if err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, variant)
+}
+
+// DeleteVariant godoc
+// @Summary Deactivate a variant
+// @Description Soft-deletes the variant so it can no longer be added to carts. It can be reactivated later.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id} [delete]
+func (h *ApiWrapper) DeleteVariant(c echo.Context) error {
+	if err := h.productService.SetVariantActive(adminActor(c), c.Param("id"), c.Param("variant_id"), false); err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Variant deactivated successfully"})
+}
+
+// ReactivateVariant godoc
+// @Summary Reactivate a variant
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Product ID"
+// @Param variant_id path string true "Variant ID"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/products/{id}/variants/{variant_id}/reactivate [post]
+func (h *ApiWrapper) ReactivateVariant(c echo.Context) error {
+	if err := h.productService.SetVariantActive(adminActor(c), c.Param("id"), c.Param("variant_id"), true); err != nil {
+		return productError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Variant reactivated successfully"})
+}
+
+func productError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Product not found"})
+	case errors.Is(err, service.ErrVariantNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Variant not found"})
+	case errors.Is(err, service.ErrCategoryNotFound):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Category not found"})
+	case errors.Is(err, service.ErrDuplicateSKU):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, service.ErrWarehouseRequired), errors.Is(err, service.ErrWarehouseNotFound), errors.Is(err, service.ErrStockNotEditable):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
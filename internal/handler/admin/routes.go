@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/util"
+	"github.com/labstack/echo/v4"
+)
+
+type ApiWrapper struct {
+	productService   service.ProductAdminService
+	inventoryService service.InventoryAdminService
+	categoryService  service.CategoryAdminService
+	orderService     service.OrderAdminService
+	adminUserService service.AdminUserService
+	auditLogService  service.AuditLogService
+}
+
+// InitRoute registers the admin API. Every route except login needs an admin token,
+// and each group declares the permission its routes need.
+func InitRoute(e *echo.Echo, servWrapper *util.ServiceWrapper) {
+	api := ApiWrapper{
+		productService:   servWrapper.ProductAdminService,
+		inventoryService: servWrapper.InventoryService,
+		categoryService:  servWrapper.CategoryAdminService,
+		orderService:     servWrapper.OrderAdminService,
+		adminUserService: servWrapper.AdminUserService,
+		auditLogService:  servWrapper.AuditLogService,
+	}
+	api.registerRouter(e)
+}
+
+func (h *ApiWrapper) registerRouter(e *echo.Echo) {
+	e.POST("/api/v1/admin/auth/login", h.Login)
+
+	adminV1 := e.Group("/api/v1/admin", middleware.RequireAdmin(h.adminUserService))
+	adminV1.GET("/auth/me", h.Me)
+
+	canEditCatalog := middleware.RequirePermission(entity.PermCatalogWrite)
+
+	products := adminV1.Group("/products", middleware.RequirePermission(entity.PermCatalogRead))
+	products.GET("", h.ListProducts)
+	products.POST("", h.CreateProduct, canEditCatalog)
+	products.GET("/:id", h.GetProduct)
+	products.PUT("/:id", h.UpdateProduct, canEditCatalog)
+	products.DELETE("/:id", h.DeleteProduct, canEditCatalog)
+	products.POST("/:id/reactivate", h.ReactivateProduct, canEditCatalog)
+
+	products.POST("/:id/variants", h.CreateVariant, canEditCatalog)
+	products.PUT("/:id/variants/:variant_id", h.UpdateVariant, canEditCatalog)
+	products.DELETE("/:id/variants/:variant_id", h.DeleteVariant, canEditCatalog)
+	products.POST("/:id/variants/:variant_id/reactivate", h.ReactivateVariant, canEditCatalog)
+
+	canEditInventory := middleware.RequirePermission(entity.PermInventoryWrite)
+	products.GET("/:id/variants/:variant_id/inventory", h.GetInventoryHistory)
+	products.POST("/:id/variants/:variant_id/inventory/adjustments", h.AdjustInventory, canEditInventory)
+	products.POST("/:id/variants/:variant_id/inventory/rebuild", h.RebuildStock, canEditInventory)
+
+	categories := adminV1.Group("/categories", middleware.RequirePermission(entity.PermCatalogRead))
+	categories.GET("", h.ListCategories)
+	categories.POST("", h.CreateCategory, canEditCatalog)
+	categories.GET("/:id", h.GetCategory)
+	categories.PUT("/:id", h.UpdateCategory, canEditCatalog)
+	categories.DELETE("/:id", h.DeleteCategory, canEditCatalog)
+
+	orders := adminV1.Group("/orders", middleware.RequirePermission(entity.PermOrdersRead))
+	orders.GET("", h.ListOrders)
+	orders.GET("/:order_id", h.GetOrder)
+	orders.PUT("/:order_id/status", h.UpdateOrderStatus, middleware.RequirePermission(entity.PermOrdersWrite))
+
+	users := adminV1.Group("/users", middleware.RequirePermission(entity.PermAdminUsersManage))
+	users.GET("", h.ListAdminUsers)
+	users.POST("", h.CreateAdminUser)
+	users.GET("/:id", h.GetAdminUser)
+	users.PUT("/:id", h.UpdateAdminUser)
+
+	auditLogs := adminV1.Group("/audit-logs", middleware.RequirePermission(entity.PermAuditLogRead))
+	auditLogs.GET("", h.ListAuditLogs)
+}
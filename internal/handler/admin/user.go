@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// ListAdminUsers godoc
+// @Summary List admin users
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} entity.AdminUser
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/users [get]
+func (h *ApiWrapper) ListAdminUsers(c echo.Context) error {
+	admins, err := h.adminUserService.ListAdminUsers()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to fetch admin users"})
+	}
+
+	return c.JSON(http.StatusOK, admins)
+}
+
+// GetAdminUser godoc
+// @Summary Get an admin user
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin user ID"
+// @Success 200 {object} entity.AdminUser
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/users/{id} [get]
+func (h *ApiWrapper) GetAdminUser(c echo.Context) error {
+	admin, err := h.adminUserService.GetAdminUser(c.Param("id"))
+	if err != nil {
+		return adminUserError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, admin)
+}
+
+// CreateAdminUser godoc
+// @Summary Create an admin user
+// @Description Creates an active admin with the role owner, ops, catalog_editor or finance
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.CreateAdminUserRequest true "Admin user"
+// @Success 201 {object} entity.AdminUser
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/users [post]
+func (h *ApiWrapper) CreateAdminUser(c echo.Context) error {
+	var req request.CreateAdminUserRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	admin, err := h.adminUserService.CreateAdminUser(adminActor(c), req)
+	if err != nil {
+		return adminUserError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, admin)
+}
+
+// UpdateAdminUser godoc
+// @Summary Update an admin user
+// @Description Changes an admin's name and role, and optionally their password or active flag. The last active owner cannot be demoted or deactivated.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin user ID"
+// @Param request body request.UpdateAdminUserRequest true "Admin user"
+// @Success 200 {object} entity.AdminUser
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/admin/users/{id} [put]
+func (h *ApiWrapper) UpdateAdminUser(c echo.Context) error {
+	var req request.UpdateAdminUserRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	admin, err := h.adminUserService.UpdateAdminUser(adminActor(c), c.Param("id"), req)
+	if err != nil {
+		return adminUserError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, admin)
+}
+
+func adminUserError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrAdminUserNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Admin user not found"})
+	case errors.Is(err, service.ErrDuplicateAdminEmail), errors.Is(err, service.ErrLastOwner):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
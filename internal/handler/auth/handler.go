@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// Register godoc
+// @Summary Register a customer account
+// @Description Creates a customer account and returns an access and refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.RegisterRequest true "Register request"
+// @Success 201 {object} response.AuthResponse
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/register [post]
+func (h *ApiWrapper) Register(c echo.Context) error {
+	var req request.RegisterRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	response, err := h.authService.Register(req)
+	if err != nil {
+		if errors.Is(err, service.ErrEmailAlreadyRegistered) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Email is already registered"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// Login godoc
+// @Summary Log in a customer
+// @Description Checks email and password and returns an access and refresh token. A guest cart_id is merged into the customer's cart.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.LoginRequest true "Login request"
+// @Success 200 {object} response.AuthResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/login [post]
+func (h *ApiWrapper) Login(c echo.Context) error {
+	var req request.LoginRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	response, err := h.authService.Login(req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid email or password"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	// A cart problem must not block the login; the customer can still shop with a new cart
+	cart, err := h.cartService.MergeGuestCart(response.Customer.ID, req.CartID)
+	if err != nil {
+		log.Printf("failed to merge cart %q for customer %s: %v", req.CartID, response.Customer.ID, err)
+	} else if cart != nil {
+		response.CartID = cart.CartID
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Refresh godoc
+// @Summary Refresh tokens
+// @Description Exchanges a refresh token for a new access and refresh token. The old refresh token stops working.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.RefreshTokenRequest true "Refresh request"
+// @Success 200 {object} response.AuthResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/refresh [post]
+func (h *ApiWrapper) Refresh(c echo.Context) error {
+	var req request.RefreshTokenRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	response, err := h.authService.Refresh(req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired token"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Logout godoc
+// @Summary Log out a customer
+// @Description Revokes the refresh token. Access tokens stay valid until they expire.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.LogoutRequest true "Logout request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/logout [post]
+func (h *ApiWrapper) Logout(c echo.Context) error {
+	var req request.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	if err := h.authService.Logout(req); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Logged out successfully"})
+}
+
+// VerifyEmail godoc
+// @Summary Verify a customer's email address
+// @Description Marks the email as verified using the token from the verification email. Each token works once.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.VerifyEmailRequest true "Verify email request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/verify-email [post]
+func (h *ApiWrapper) VerifyEmail(c echo.Context) error {
+	var req request.VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	if err := h.authService.VerifyEmail(req); err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid or expired verification link"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Email verified successfully"})
+}
+
+// ResendEmailVerification godoc
+// @Summary Resend the verification email
+// @Description Mails a new verification link to the signed-in customer. Earlier links stop working.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/verify-email/resend [post]
+func (h *ApiWrapper) ResendEmailVerification(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	if err := h.authService.ResendEmailVerification(customerID); err != nil {
+		if errors.Is(err, service.ErrEmailAlreadyVerified) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Email is already verified"})
+		}
+		if errors.Is(err, service.ErrInvalidToken) {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired token"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Verification email sent"})
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Mails a reset link when the email is registered. The response is the same either way.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.ForgotPasswordRequest true "Forgot password request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/forgot-password [post]
+func (h *ApiWrapper) ForgotPassword(c echo.Context) error {
+	var req request.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	if err := h.authService.ForgotPassword(req); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "If the email is registered, a password reset link has been sent"})
+}
+
+// ResetPassword godoc
+// @Summary Reset a customer's password
+// @Description Sets a new password using the token from the reset email and logs out every session
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body request.ResetPasswordRequest true "Reset password request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/auth/reset-password [post]
+func (h *ApiWrapper) ResetPassword(c echo.Context) error {
+	var req request.ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Validation error: " + err.Error()})
+	}
+
+	if err := h.authService.ResetPassword(req); err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid or expired reset link"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Password reset successfully"})
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/util"
+	"github.com/labstack/echo/v4"
+)
+
+type ApiWrapper struct {
+	authService service.AuthService
+	cartService service.CartService
+}
+
+func InitRoute(e *echo.Echo, servWrapper *util.ServiceWrapper) {
+	api := ApiWrapper{
+		authService: servWrapper.AuthService,
+		cartService: servWrapper.CartService,
+	}
+	api.registerRouter(e)
+}
+
+func (h *ApiWrapper) registerRouter(e *echo.Echo) {
+	authGroup := e.Group("/api/v1/auth")
+
+	authGroup.POST("/register", h.Register)
+	authGroup.POST("/login", h.Login)
+	authGroup.POST("/refresh", h.Refresh)
+	authGroup.POST("/logout", h.Logout)
+
+	authGroup.POST("/verify-email", h.VerifyEmail)
+	authGroup.POST("/verify-email/resend", h.ResendEmailVerification, middleware.RequireCustomer(h.authService))
+	authGroup.POST("/forgot-password", h.ForgotPassword)
+	authGroup.POST("/reset-password", h.ResetPassword)
+}
@@ -2,7 +2,9 @@ package cart
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/labstack/echo/v4"
 )
@@ -29,6 +31,8 @@ func (h *ApiWrapper) AddItem(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Quantity must be greater than 0"})
 	}
 
+	req.CustomerID, _ = middleware.CustomerID(c)
+
 	response, err := h.cartService.AddItem(req)
 	if err != nil {
 		switch err.Error() {
@@ -182,3 +186,36 @@ func (h *ApiWrapper) ApplyDiscount(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// RemoveDiscount godoc
+// @Summary Remove discount from cart
+// @Description Removes the discount code applied to the cart
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Param request body request.RemoveDiscountRequest true "Remove discount request"
+// @Success 200 {object} response.CartResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/cart/remove-discount [post]
+func (h *ApiWrapper) RemoveDiscount(c echo.Context) error {
+	var req request.RemoveDiscountRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+
+	if req.CartID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Cart ID is required"})
+	}
+
+	response, err := h.cartService.RemoveDiscount(req)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "failed to get cart") {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Cart not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
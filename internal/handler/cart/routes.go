@@ -1,6 +1,7 @@
 package cart
 
 import (
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
 	"github.com/hanifbg/landing_backend/internal/service"
 	"github.com/hanifbg/landing_backend/internal/service/util"
 	"github.com/labstack/echo/v4"
@@ -8,17 +9,20 @@ import (
 
 type ApiWrapper struct {
 	cartService service.CartService
+	authService service.AuthService
 }
 
 func InitRoute(e *echo.Echo, servWrapper *util.ServiceWrapper) {
 	api := ApiWrapper{
 		cartService: servWrapper.CartService,
+		authService: servWrapper.AuthService,
 	}
 	api.registerRouter(e)
 }
 
 func (h *ApiWrapper) registerRouter(e *echo.Echo) {
-	cartGroup := e.Group("/api/v1/cart")
+	// Carts work for guests; a logged in customer's ID is available to the handlers
+	cartGroup := e.Group("/api/v1/cart", middleware.OptionalCustomer(h.authService))
 
 	// Cart management endpoints
 	cartGroup.POST("/add", h.AddItem)
@@ -26,4 +30,5 @@ func (h *ApiWrapper) registerRouter(e *echo.Echo) {
 	cartGroup.POST("/remove", h.RemoveItem)
 	cartGroup.GET("/:cart_id", h.GetCart)
 	cartGroup.POST("/apply-discount", h.ApplyDiscount)
+	cartGroup.POST("/remove-discount", h.RemoveDiscount)
 }
@@ -24,3 +24,14 @@ func (h *ApiWrapper) GetCategoryBySlug(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, category)
 }
+
+func (h *ApiWrapper) ListCategories(c echo.Context) error {
+	categories, err := h.CategoryService.ListCategories()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Failed to fetch categories",
+		})
+	}
+
+	return c.JSON(http.StatusOK, categories)
+}
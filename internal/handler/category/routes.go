@@ -19,5 +19,6 @@ func InitRoute(e *echo.Echo, servWrapper *util.ServiceWrapper) {
 
 func (h *ApiWrapper) registerRouter(e *echo.Echo) {
 	categoryV1 := e.Group("api/v1/categories")
+	categoryV1.GET("", h.ListCategories)
 	categoryV1.GET("/:slug", h.GetCategoryBySlug)
 }
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// AdminKey is the echo context key holding the signed-in admin
+const AdminKey = "admin"
+
+// RequireAdmin rejects requests without a valid admin access token and stores the
+// admin in the context for the permission checks and handlers behind it
+func RequireAdmin(adminService service.AdminUserService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Authentication required"})
+			}
+
+			token, ok := bearerToken(header)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid authorization header"})
+			}
+
+			actor, err := adminService.Authenticate(token)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired token"})
+			}
+
+			c.Set(AdminKey, *actor)
+			return next(c)
+		}
+	}
+}
+
+// RequirePermission lets through admins whose role grants the permission. It must run
+// after RequireAdmin.
+func RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			actor, ok := Admin(c)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Authentication required"})
+			}
+
+			if !entity.RoleHasPermission(actor.Role, permission) {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Insufficient permissions"})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// Admin returns the signed-in admin, if any
+func Admin(c echo.Context) (service.AdminActor, bool) {
+	actor, ok := c.Get(AdminKey).(service.AdminActor)
+	return actor, ok && actor.ID != ""
+}
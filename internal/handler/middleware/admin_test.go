@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAdminUserService struct {
+	mock.Mock
+}
+
+func (m *MockAdminUserService) Login(req request.AdminLoginRequest) (*response.AdminAuthResponse, error) {
+	args := m.Called(req)
+	return nil, args.Error(1)
+}
+
+func (m *MockAdminUserService) Authenticate(token string) (*service.AdminActor, error) {
+	args := m.Called(token)
+	actor, _ := args.Get(0).(*service.AdminActor)
+	return actor, args.Error(1)
+}
+
+func (m *MockAdminUserService) ListAdminUsers() ([]entity.AdminUser, error) {
+	args := m.Called()
+	return nil, args.Error(1)
+}
+
+func (m *MockAdminUserService) GetAdminUser(id string) (*entity.AdminUser, error) {
+	args := m.Called(id)
+	return nil, args.Error(1)
+}
+
+func (m *MockAdminUserService) CreateAdminUser(actor service.AdminActor, req request.CreateAdminUserRequest) (*entity.AdminUser, error) {
+	args := m.Called(actor, req)
+	return nil, args.Error(1)
+}
+
+func (m *MockAdminUserService) UpdateAdminUser(actor service.AdminActor, id string, req request.UpdateAdminUserRequest) (*entity.AdminUser, error) {
+	args := m.Called(actor, id, req)
+	return nil, args.Error(1)
+}
+
+// serveAdmin runs the middlewares in front of a handler that echoes the admin's email from the context
+func serveAdmin(authorization string, mws ...echo.MiddlewareFunc) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.GET("/", func(c echo.Context) error {
+		actor, _ := Admin(c)
+		return c.String(http.StatusOK, actor.Email)
+	}, mws...)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		req.Header.Set(echo.HeaderAuthorization, authorization)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequireAdmin(t *testing.T) {
+	t.Run("Success - Valid token sets the admin", func(t *testing.T) {
+		adminService := new(MockAdminUserService)
+		adminService.On("Authenticate", "good-token").
+			Return(&service.AdminActor{ID: "admin-123", Email: "ops@example.com", Role: entity.AdminRoleOps}, nil)
+
+		rec := serveAdmin("Bearer good-token", RequireAdmin(adminService))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "ops@example.com", rec.Body.String())
+		adminService.AssertExpectations(t)
+	})
+
+	t.Run("Error - Missing token", func(t *testing.T) {
+		adminService := new(MockAdminUserService)
+
+		rec := serveAdmin("", RequireAdmin(adminService))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Authentication required")
+	})
+
+	t.Run("Error - Invalid token", func(t *testing.T) {
+		adminService := new(MockAdminUserService)
+		adminService.On("Authenticate", "customer-token").Return(nil, service.ErrInvalidToken)
+
+		rec := serveAdmin("Bearer customer-token", RequireAdmin(adminService))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Invalid or expired token")
+	})
+}
+
+func TestRequirePermission(t *testing.T) {
+	signedInAs := func(role string) *MockAdminUserService {
+		adminService := new(MockAdminUserService)
+		adminService.On("Authenticate", "token").
+			Return(&service.AdminActor{ID: "admin-123", Email: "admin@example.com", Role: role}, nil)
+		return adminService
+	}
+
+	t.Run("Success - Role grants the permission", func(t *testing.T) {
+		rec := serveAdmin("Bearer token",
+			RequireAdmin(signedInAs(entity.AdminRoleCatalogEditor)), RequirePermission(entity.PermCatalogWrite))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Success - Owner may do everything", func(t *testing.T) {
+		rec := serveAdmin("Bearer token",
+			RequireAdmin(signedInAs(entity.AdminRoleOwner)), RequirePermission(entity.PermAdminUsersManage))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Error - Role lacks the permission", func(t *testing.T) {
+		rec := serveAdmin("Bearer token",
+			RequireAdmin(signedInAs(entity.AdminRoleFinance)), RequirePermission(entity.PermCatalogWrite))
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Insufficient permissions")
+	})
+
+	t.Run("Error - No admin in the context", func(t *testing.T) {
+		rec := serveAdmin("", RequirePermission(entity.PermCatalogRead))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// CustomerIDKey is the echo context key holding the authenticated customer ID
+const CustomerIDKey = "customer_id"
+
+// RequireCustomer rejects requests without a valid access token and stores the
+// customer ID in the context for the handlers behind it
+func RequireCustomer(authService service.AuthService) echo.MiddlewareFunc {
+	return customerAuth(authService, true)
+}
+
+// OptionalCustomer lets guests through. When an Authorization header is sent the
+// token must be valid, so an expired session is reported instead of silently
+// turning the customer into a guest.
+func OptionalCustomer(authService service.AuthService) echo.MiddlewareFunc {
+	return customerAuth(authService, false)
+}
+
+// CustomerID returns the authenticated customer ID, if any
+func CustomerID(c echo.Context) (string, bool) {
+	customerID, ok := c.Get(CustomerIDKey).(string)
+	return customerID, ok && customerID != ""
+}
+
+func customerAuth(authService service.AuthService, required bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				if required {
+					return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Authentication required"})
+				}
+				return next(c)
+			}
+
+			token, ok := bearerToken(header)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid authorization header"})
+			}
+
+			customerID, err := authService.ParseAccessToken(token)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired token"})
+			}
+
+			c.Set(CustomerIDKey, customerID)
+			return next(c)
+		}
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
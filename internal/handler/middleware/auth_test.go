@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAuthService struct {
+	mock.Mock
+}
+
+func (m *MockAuthService) Register(req request.RegisterRequest) (*response.AuthResponse, error) {
+	args := m.Called(req)
+	return nil, args.Error(1)
+}
+
+func (m *MockAuthService) Login(req request.LoginRequest) (*response.AuthResponse, error) {
+	args := m.Called(req)
+	return nil, args.Error(1)
+}
+
+func (m *MockAuthService) Refresh(req request.RefreshTokenRequest) (*response.AuthResponse, error) {
+	args := m.Called(req)
+	return nil, args.Error(1)
+}
+
+func (m *MockAuthService) Logout(req request.LogoutRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) VerifyEmail(req request.VerifyEmailRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) ResendEmailVerification(customerID string) error {
+	args := m.Called(customerID)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) ForgotPassword(req request.ForgotPasswordRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) ResetPassword(req request.ResetPasswordRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) ParseAccessToken(token string) (string, error) {
+	args := m.Called(token)
+	return args.String(0), args.Error(1)
+}
+
+// serve runs the middleware in front of a handler that echoes the customer ID from the context
+func serve(mw echo.MiddlewareFunc, authorization string) *httptest.ResponseRecorder {
+	e := echo.New()
+	e.GET("/", func(c echo.Context) error {
+		customerID, _ := CustomerID(c)
+		return c.String(http.StatusOK, customerID)
+	}, mw)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		req.Header.Set(echo.HeaderAuthorization, authorization)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequireCustomer(t *testing.T) {
+	t.Run("Success - Valid token sets the customer ID", func(t *testing.T) {
+		authService := new(MockAuthService)
+		authService.On("ParseAccessToken", "good-token").Return("customer-123", nil)
+
+		rec := serve(RequireCustomer(authService), "Bearer good-token")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "customer-123", rec.Body.String())
+		authService.AssertExpectations(t)
+	})
+
+	t.Run("Error - Missing token", func(t *testing.T) {
+		authService := new(MockAuthService)
+
+		rec := serve(RequireCustomer(authService), "")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Authentication required")
+	})
+
+	t.Run("Error - Not a bearer token", func(t *testing.T) {
+		authService := new(MockAuthService)
+
+		rec := serve(RequireCustomer(authService), "Basic dXNlcjpwYXNz")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Invalid authorization header")
+	})
+
+	t.Run("Error - Invalid token", func(t *testing.T) {
+		authService := new(MockAuthService)
+		authService.On("ParseAccessToken", "bad-token").Return("", service.ErrInvalidToken)
+
+		rec := serve(RequireCustomer(authService), "Bearer bad-token")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Invalid or expired token")
+	})
+}
+
+func TestOptionalCustomer(t *testing.T) {
+	t.Run("Success - Guest passes without a customer ID", func(t *testing.T) {
+		authService := new(MockAuthService)
+
+		rec := serve(OptionalCustomer(authService), "")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("Success - Valid token sets the customer ID", func(t *testing.T) {
+		authService := new(MockAuthService)
+		authService.On("ParseAccessToken", "good-token").Return("customer-123", nil)
+
+		rec := serve(OptionalCustomer(authService), "bearer good-token")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "customer-123", rec.Body.String())
+	})
+
+	t.Run("Error - Invalid token is not treated as a guest", func(t *testing.T) {
+		authService := new(MockAuthService)
+		authService.On("ParseAccessToken", "expired-token").Return("", service.ErrInvalidToken)
+
+		rec := serve(OptionalCustomer(authService), "Bearer expired-token")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -2,9 +2,14 @@ package payment
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
 	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
 	"github.com/hanifbg/landing_backend/internal/service"
 	"github.com/labstack/echo/v4"
 )
@@ -28,6 +33,7 @@ func NewPaymentHandler(paymentService service.PaymentService) *PaymentHandler {
 // @Param request body request.CreateOrderRequest true "Order details"
 // @Success 200 {object} response.OrderResponse
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]interface{}
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/orders [post]
 func (h *PaymentHandler) CreateOrder(c echo.Context) error {
@@ -44,8 +50,38 @@ func (h *PaymentHandler) CreateOrder(c echo.Context) error {
 		})
 	}
 
+	req.CustomerID, _ = middleware.CustomerID(c)
+
 	order, err := h.paymentService.CreateOrder(req)
 	if err != nil {
+		var stockErr *repository.InsufficientStockError
+		if errors.As(err, &stockErr) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error":             "Insufficient stock",
+				"insufficient_skus": stockErr.SKUs,
+			})
+		}
+		if errors.Is(err, service.ErrShippingCostMismatch) || errors.Is(err, service.ErrShippingServiceUnavailable) ||
+			errors.Is(err, service.ErrShippingProvinceMismatch) || errors.Is(err, service.ErrShippingDistrictUnknown) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "Shipping cannot be verified: " + err.Error(),
+			})
+		}
+		if errors.Is(err, service.ErrInvalidDiscount) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "Discount cannot be applied: " + err.Error(),
+			})
+		}
+		if errors.Is(err, service.ErrAddressNotFound) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "Address not found",
+			})
+		}
+		if errors.Is(err, service.ErrAddressRequiresLogin) {
+			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"error": "Login required to use a saved address",
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to create order: " + err.Error(),
 		})
@@ -58,7 +94,7 @@ func (h *PaymentHandler) CreateOrder(c echo.Context) error {
 
 // GetOrder godoc
 // @Summary Get order details
-// @Description Get details of an order by ID
+// @Description Get details of an order by ID. The customer's name, contact details and address are only included for the logged in customer who placed the order.
 // @Tags orders
 // @Accept json
 // @Produce json
@@ -75,7 +111,13 @@ func (h *PaymentHandler) GetOrder(c echo.Context) error {
 		})
 	}
 
-	order, err := h.paymentService.GetOrder(orderID)
+	if customerID, ok := middleware.CustomerID(c); ok {
+		if order, err := h.paymentService.GetCustomerOrder(customerID, orderID); err == nil {
+			return c.JSON(http.StatusOK, order)
+		}
+	}
+
+	order, err := h.paymentService.GetOrderSummary(orderID)
 	if err != nil {
 		return c.JSON(http.StatusNotFound, map[string]interface{}{
 			"error": "Order not found: " + err.Error(),
@@ -85,6 +127,126 @@ func (h *PaymentHandler) GetOrder(c echo.Context) error {
 	return c.JSON(http.StatusOK, order)
 }
 
+// LookupGuestOrder godoc
+// @Summary Look up a guest order
+// @Description Get details of an order by its order number and the email or phone given at checkout
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param request body request.GuestOrderLookupRequest true "Order number with email or phone"
+// @Success 200 {object} response.OrderResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/orders/lookup [post]
+func (h *PaymentHandler) LookupGuestOrder(c echo.Context) error {
+	var req request.GuestOrderLookupRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request: " + err.Error(),
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Validation error: " + err.Error(),
+		})
+	}
+
+	order, err := h.paymentService.LookupGuestOrder(req)
+	if err != nil {
+		if errors.Is(err, service.ErrOrderNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Order not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get order",
+		})
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+// ListCustomerOrders godoc
+// @Summary List the customer's orders
+// @Description Get the logged in customer's orders, newest first
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Comma separated order statuses"
+// @Param page query int false "Page number, starting at 1"
+// @Param limit query int false "Orders per page, at most 50"
+// @Success 200 {object} response.OrderListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/me/orders [get]
+func (h *PaymentHandler) ListCustomerOrders(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	var req request.ListCustomerOrdersRequest
+	for _, status := range strings.Split(c.QueryParam("status"), ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			req.Statuses = append(req.Statuses, status)
+		}
+	}
+
+	var err error
+	if page := c.QueryParam("page"); page != "" {
+		if req.Page, err = strconv.Atoi(page); err != nil || req.Page < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "page must be a positive number",
+			})
+		}
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		if req.Limit, err = strconv.Atoi(limit); err != nil || req.Limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "limit must be a positive number",
+			})
+		}
+	}
+
+	orders, err := h.paymentService.ListCustomerOrders(customerID, req)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get orders",
+		})
+	}
+
+	return c.JSON(http.StatusOK, orders)
+}
+
+// GetCustomerOrder godoc
+// @Summary Get one of the customer's orders
+// @Description Get details of an order placed by the logged in customer
+// @Tags orders
+// @Produce json
+// @Security BearerAuth
+// @Param order_id path string true "Order ID"
+// @Success 200 {object} response.OrderResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/me/orders/{order_id} [get]
+func (h *PaymentHandler) GetCustomerOrder(c echo.Context) error {
+	customerID, _ := middleware.CustomerID(c)
+
+	order, err := h.paymentService.GetCustomerOrder(customerID, c.Param("order_id"))
+	if err != nil {
+		if errors.Is(err, service.ErrOrderNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Order not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get order",
+		})
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
 // CreatePayment godoc
 // @Summary Create payment for an order
 // @Description Create a payment transaction for an order
@@ -152,6 +314,7 @@ func (h *PaymentHandler) GetPaymentStatus(c echo.Context) error {
 // @Produce json
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/payments/notification [post]
 func (h *PaymentHandler) HandleNotification(c echo.Context) error {
@@ -165,6 +328,16 @@ func (h *PaymentHandler) HandleNotification(c echo.Context) error {
 
 	// Process notification
 	if err := h.paymentService.HandlePaymentNotification(notificationData); err != nil {
+		if errors.Is(err, service.ErrInvalidNotificationSignature) {
+			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"error": "Invalid notification signature",
+			})
+		}
+		if errors.Is(err, service.ErrNotificationAmountMismatch) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "Notification amount mismatch",
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to process notification: " + err.Error(),
 		})
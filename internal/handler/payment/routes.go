@@ -5,20 +5,23 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func InitRoute(e *echo.Echo, paymentService service.PaymentService) {
+func InitRoute(e *echo.Echo, paymentService service.PaymentService, orderMiddleware ...echo.MiddlewareFunc) {
 	handler := NewPaymentHandler(paymentService)
-	registerRouter(e, handler)
+	registerRouter(e, handler, orderMiddleware...)
 }
 
-func RegisterRoutes(e *echo.Echo, paymentService service.PaymentService) {
+// RegisterRoutes registers order and payment routes. orderMiddleware runs on the order routes only,
+// the payment notification webhook is never behind customer auth.
+func RegisterRoutes(e *echo.Echo, paymentService service.PaymentService, orderMiddleware ...echo.MiddlewareFunc) {
 	handler := NewPaymentHandler(paymentService)
-	registerRouter(e, handler)
+	registerRouter(e, handler, orderMiddleware...)
 }
 
-func registerRouter(e *echo.Echo, handler *PaymentHandler) {
+func registerRouter(e *echo.Echo, handler *PaymentHandler, orderMiddleware ...echo.MiddlewareFunc) {
 	// Order routes
-	orderGroup := e.Group("/api/v1/orders")
+	orderGroup := e.Group("/api/v1/orders", orderMiddleware...)
 	orderGroup.POST("", handler.CreateOrder)
+	orderGroup.POST("/lookup", handler.LookupGuestOrder)
 	orderGroup.GET("/:order_id", handler.GetOrder)
 
 	// Payment routes
@@ -27,3 +30,13 @@ func registerRouter(e *echo.Echo, handler *PaymentHandler) {
 	paymentGroup.GET("/status/:payment_id", handler.GetPaymentStatus)
 	paymentGroup.POST("/notification", handler.HandleNotification)
 }
+
+// RegisterCustomerRoutes registers the logged in customer's order history.
+// requireCustomer must reject requests without a valid access token.
+func RegisterCustomerRoutes(e *echo.Echo, paymentService service.PaymentService, requireCustomer echo.MiddlewareFunc) {
+	handler := NewPaymentHandler(paymentService)
+
+	meGroup := e.Group("/api/v1/me", requireCustomer)
+	meGroup.GET("/orders", handler.ListCustomerOrders)
+	meGroup.GET("/orders/:order_id", handler.GetCustomerOrder)
+}
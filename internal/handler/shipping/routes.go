@@ -24,4 +24,6 @@ func (h *ApiWrapper) registerRouter(e *echo.Echo) {
 	shippingGroup.GET("/districts/:city_id", h.GetDistricts)
 	shippingGroup.POST("/cost", h.CalculateShippingCost)
 	shippingGroup.POST("/awb/validate", h.ValidateAWB)
+	shippingGroup.GET("/track/:order_number", h.TrackShipment)
+	shippingGroup.POST("/webhook/:provider", h.HandleTrackingWebhook)
 }
@@ -1,9 +1,13 @@
 package shipping
 
 import (
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
 	"github.com/labstack/echo/v4"
 )
 
@@ -92,13 +96,14 @@ func (h *ApiWrapper) GetDistricts(c echo.Context) error {
 
 // CalculateShippingCost godoc
 // @Summary Calculate shipping cost
-// @Description Calculate shipping cost based on origin, destination, weight, and courier
+// @Description Calculate shipping cost based on origin, destination, weight, and courier. With a cart_id the cart is quoted from the warehouses holding its items. The destination district must have been listed through the districts endpoint first; an unknown one is rejected with 400.
 // @Tags shipping
 // @Accept json
 // @Produce json
 // @Param request body request.CalculateShippingRequest true "Calculate shipping cost request"
 // @Success 200 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{} "No warehouse holds enough stock of a cart item"
 // @Failure 500 {object} map[string]interface{}
 // @Router /api/v1/shipping/cost [post]
 func (h *ApiWrapper) CalculateShippingCost(c echo.Context) error {
@@ -119,6 +124,25 @@ func (h *ApiWrapper) CalculateShippingCost(c echo.Context) error {
 
 	costs, err := h.shippingService.CalculateShippingCost(req)
 	if err != nil {
+		var stockErr *repository.InsufficientStockError
+		if errors.As(err, &stockErr) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error":             "Insufficient stock",
+				"insufficient_skus": stockErr.SKUs,
+			})
+		}
+		if errors.Is(err, service.ErrShippingProvinceMismatch) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Invalid province",
+				"message": err.Error(),
+			})
+		}
+		if errors.Is(err, service.ErrShippingDistrictUnknown) {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Unknown destination",
+				"message": err.Error(),
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to calculate shipping cost",
 			"message": err.Error(),
@@ -133,13 +157,14 @@ func (h *ApiWrapper) CalculateShippingCost(c echo.Context) error {
 
 // ValidateAWB godoc
 // @Summary Validate and save AWB number
-// @Description Validate AWB number with RajaOngkir API and save to database for specific invoice number. The last_phone_number parameter is only required for JNE courier and should contain the last 5 digits of the recipient's phone number.
+// @Description Validate AWB number with RajaOngkir API and save to database for specific invoice number. The last_phone_number parameter is only required for JNE courier and should contain the last 5 digits of the recipient's phone number. Only processing and shipped orders take an AWB; a processing order is moved to shipped.
 // @Tags shipping
 // @Accept json
 // @Produce json
 // @Param request body request.ValidateAWBRequest true "Validate AWB request. Note: last_phone_number is only for JNE courier (last 5 digits of recipient's phone number)"
 // @Success 200 {object} map[string]interface{} "AWB number validated and saved successfully"
 // @Failure 400 {object} map[string]interface{} "Invalid request, validation failed, or invalid AWB number"
+// @Failure 409 {object} map[string]interface{} "Order is not processing or shipped"
 // @Failure 500 {object} map[string]interface{} "Server error during validation or saving"
 // @Router /api/v1/shipping/awb/validate [post]
 func (h *ApiWrapper) ValidateAWB(c echo.Context) error {
@@ -160,6 +185,12 @@ func (h *ApiWrapper) ValidateAWB(c echo.Context) error {
 
 	result, err := h.shippingService.ValidateAndSaveAWB(req)
 	if err != nil {
+		if errors.Is(err, service.ErrOrderNotShippable) {
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error":   "Order cannot be shipped",
+				"message": err.Error(),
+			})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error":   "Failed to validate AWB",
 			"message": err.Error(),
@@ -180,3 +211,119 @@ func (h *ApiWrapper) ValidateAWB(c echo.Context) error {
 		"data":    result,
 	})
 }
+
+// TrackShipment godoc
+// @Summary Track the shipments of an order
+// @Description Get the courier, AWB number, delivery status and tracking timeline of every shipment of an order. The email or the phone number given at checkout must be sent; a wrong one gets the same response as an unknown order number.
+// @Tags shipping
+// @Produce json
+// @Param order_number path string true "Order number"
+// @Param email query string false "Email given at checkout (required without phone)"
+// @Param phone query string false "Phone number given at checkout, in any format (required without email)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/shipping/track/{order_number} [get]
+func (h *ApiWrapper) TrackShipment(c echo.Context) error {
+	req := request.TrackShipmentRequest{
+		OrderNumber: c.Param("order_number"),
+		Email:       c.QueryParam("email"),
+		Phone:       c.QueryParam("phone"),
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Validation failed",
+			"message": err.Error(),
+		})
+	}
+
+	tracking, err := h.shippingService.TrackShipment(req)
+	if err != nil {
+		if errors.Is(err, service.ErrOrderNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Order not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to track shipment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Shipment tracking retrieved successfully",
+		"data":    tracking,
+	})
+}
+
+// maxWebhookPayloadBytes caps the body read from a tracking webhook call
+const maxWebhookPayloadBytes = 1 << 20
+
+// HandleTrackingWebhook godoc
+// @Summary Receive a tracking push
+// @Description Apply a tracking update pushed by a shipping provider to the matching AWB. The call must carry X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature, the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the provider's webhook secret. Calls older than 5 minutes or already applied are rejected.
+// @Tags shipping
+// @Accept json
+// @Produce json
+// @Param provider path string true "Provider (rajaongkir)"
+// @Param X-Webhook-Timestamp header string true "Unix seconds the call was signed at"
+// @Param X-Webhook-Signature header string true "Hex HMAC-SHA256 of timestamp, a dot and the body"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/shipping/webhook/{provider} [post]
+func (h *ApiWrapper) HandleTrackingWebhook(c echo.Context) error {
+	// The signature covers the exact bytes sent, so the body is read raw instead of bound
+	payload, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookPayloadBytes))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	err = h.shippingService.HandleTrackingWebhook(request.ShippingWebhookRequest{
+		Provider:  c.Param("provider"),
+		Timestamp: c.Request().Header.Get("X-Webhook-Timestamp"),
+		Signature: c.Request().Header.Get("X-Webhook-Signature"),
+		Payload:   payload,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownWebhookProvider):
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "Unknown webhook provider",
+			})
+		case errors.Is(err, service.ErrInvalidWebhookSignature):
+			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+				"error": "Invalid webhook signature",
+			})
+		case errors.Is(err, service.ErrWebhookReplayed):
+			return c.JSON(http.StatusConflict, map[string]interface{}{
+				"error": "Webhook call already received",
+			})
+		case errors.Is(err, service.ErrInvalidWebhookPayload):
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error":   "Invalid webhook payload",
+				"message": err.Error(),
+			})
+		case errors.Is(err, service.ErrAWBTrackingNotFound):
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "AWB not found",
+			})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error":   "Failed to process webhook",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Webhook processed successfully",
+	})
+}
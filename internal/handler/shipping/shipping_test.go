@@ -2,14 +2,17 @@ package shipping
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -47,6 +50,34 @@ func (m *MockShippingService) ValidateAndSaveAWB(req request.ValidateAWBRequest)
 	return args.Get(0).(*response.ValidateAWBResponse), args.Error(1)
 }
 
+func (m *MockShippingService) RefreshAWBTrackings(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockShippingService) SyncLocations(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockShippingService) WarmUpLocations(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockShippingService) TrackShipment(req request.TrackShipmentRequest) (*response.ShipmentTrackingResponse, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ShipmentTrackingResponse), args.Error(1)
+}
+
+func (m *MockShippingService) HandleTrackingWebhook(req request.ShippingWebhookRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
 // ValidatorMock mocks the validator functionality
 type ValidatorMock struct{}
 
@@ -204,6 +235,44 @@ func TestValidateAWB_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestValidateAWB_OrderNotShippable(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &ValidatorMock{}
+
+	req := request.ValidateAWBRequest{
+		InvoiceNumber: "INV001",
+		AWBNumber:     "JX123456789",
+		Courier:       "jnt",
+	}
+
+	reqBody, _ := json.Marshal(req)
+	httpReq := httptest.NewRequest(http.MethodPost, "/api/v1/shipping/awb/validate", bytes.NewReader(reqBody))
+	httpReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httpReq, rec)
+
+	mockService := new(MockShippingService)
+	h := &ApiWrapper{shippingService: mockService}
+
+	mockService.On("ValidateAndSaveAWB", req).Return(nil, fmt.Errorf("%w: order INV001 is cancelled", service.ErrOrderNotShippable))
+
+	// Test
+	err := h.ValidateAWB(c)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var responseMap map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &responseMap)
+
+	assert.Equal(t, "Order cannot be shipped", responseMap["error"])
+
+	// Verify service was called
+	mockService.AssertExpectations(t)
+}
+
 func TestValidateAWB_AWBInvalid(t *testing.T) {
 	// Setup
 	e := echo.New()
@@ -250,3 +319,153 @@ func TestValidateAWB_AWBInvalid(t *testing.T) {
 	// Verify service was called
 	mockService.AssertExpectations(t)
 }
+
+func TestTrackShipment_Success(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &ValidatorMock{}
+
+	httpReq := httptest.NewRequest(http.MethodGet, "/api/v1/shipping/track/IQB-2025-00001?phone=7890", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httpReq, rec)
+	c.SetParamNames("order_number")
+	c.SetParamValues("IQB-2025-00001")
+
+	mockService := new(MockShippingService)
+	h := &ApiWrapper{shippingService: mockService}
+
+	req := request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Phone: "7890"}
+	responseData := &response.ShipmentTrackingResponse{
+		OrderNumber: "IQB-2025-00001",
+		OrderStatus: "shipped",
+		Shipments: []response.ShipmentResponse{
+			{Courier: "jne", AWBNumber: "JNE123"},
+		},
+	}
+
+	mockService.On("TrackShipment", req).Return(responseData, nil)
+
+	// Test
+	err := h.TrackShipment(c)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseMap map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &responseMap)
+
+	assert.Equal(t, "Shipment tracking retrieved successfully", responseMap["message"])
+
+	// Verify service was called
+	mockService.AssertExpectations(t)
+}
+
+func TestTrackShipment_NotFound(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &ValidatorMock{}
+
+	httpReq := httptest.NewRequest(http.MethodGet, "/api/v1/shipping/track/IQB-2025-00001?email=wrong@example.com", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httpReq, rec)
+	c.SetParamNames("order_number")
+	c.SetParamValues("IQB-2025-00001")
+
+	mockService := new(MockShippingService)
+	h := &ApiWrapper{shippingService: mockService}
+
+	req := request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Email: "wrong@example.com"}
+	mockService.On("TrackShipment", req).Return(nil, service.ErrOrderNotFound)
+
+	// Test
+	err := h.TrackShipment(c)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	var responseMap map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &responseMap)
+
+	assert.Equal(t, "Order not found", responseMap["error"])
+
+	// Verify service was called
+	mockService.AssertExpectations(t)
+}
+
+func TestHandleTrackingWebhook_Success(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	body := []byte(`{"data":{"delivered":true}}`)
+	httpReq := httptest.NewRequest(http.MethodPost, "/api/v1/shipping/webhook/rajaongkir", bytes.NewReader(body))
+	httpReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	httpReq.Header.Set("X-Webhook-Timestamp", "1700000000")
+	httpReq.Header.Set("X-Webhook-Signature", "abc123")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httpReq, rec)
+	c.SetParamNames("provider")
+	c.SetParamValues("rajaongkir")
+
+	mockService := new(MockShippingService)
+	h := &ApiWrapper{shippingService: mockService}
+
+	mockService.On("HandleTrackingWebhook", request.ShippingWebhookRequest{
+		Provider:  "rajaongkir",
+		Timestamp: "1700000000",
+		Signature: "abc123",
+		Payload:   body,
+	}).Return(nil)
+
+	// Test
+	err := h.HandleTrackingWebhook(c)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the raw body
+	mockService.AssertExpectations(t)
+}
+
+func TestHandleTrackingWebhook_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		message    string
+	}{
+		{"unknown provider", service.ErrUnknownWebhookProvider, http.StatusNotFound, "Unknown webhook provider"},
+		{"bad signature", service.ErrInvalidWebhookSignature, http.StatusUnauthorized, "Invalid webhook signature"},
+		{"replayed", service.ErrWebhookReplayed, http.StatusConflict, "Webhook call already received"},
+		{"bad payload", service.ErrInvalidWebhookPayload, http.StatusBadRequest, "Invalid webhook payload"},
+		{"unknown AWB", service.ErrAWBTrackingNotFound, http.StatusNotFound, "AWB not found"},
+		{"server error", errors.New("database error"), http.StatusInternalServerError, "Failed to process webhook"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			httpReq := httptest.NewRequest(http.MethodPost, "/api/v1/shipping/webhook/rajaongkir", bytes.NewReader([]byte(`{}`)))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httpReq, rec)
+
+			mockService := new(MockShippingService)
+			h := &ApiWrapper{shippingService: mockService}
+			mockService.On("HandleTrackingWebhook", mock.Anything).Return(tt.err)
+
+			// Test
+			err := h.HandleTrackingWebhook(c)
+
+			// Assertions
+			assert.NoError(t, err)
+			assert.Equal(t, tt.statusCode, rec.Code)
+
+			var responseMap map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &responseMap)
+			assert.Equal(t, tt.message, responseMap["error"])
+		})
+	}
+}
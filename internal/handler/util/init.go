@@ -2,8 +2,12 @@ package util
 
 import (
 	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/handler/address"
+	"github.com/hanifbg/landing_backend/internal/handler/admin"
+	"github.com/hanifbg/landing_backend/internal/handler/auth"
 	"github.com/hanifbg/landing_backend/internal/handler/cart"
 	"github.com/hanifbg/landing_backend/internal/handler/category"
+	"github.com/hanifbg/landing_backend/internal/handler/middleware"
 	"github.com/hanifbg/landing_backend/internal/handler/payment"
 	"github.com/hanifbg/landing_backend/internal/handler/product"
 	"github.com/hanifbg/landing_backend/internal/handler/shipping"
@@ -20,7 +24,8 @@ func InitHandler(cfg *config.AppConfig, e *echo.Echo, servWrapper *serv.ServiceW
 	cart.InitRoute(e, servWrapper)
 
 	// Initialize payment routes
-	payment.RegisterRoutes(e, servWrapper.PaymentService)
+	payment.RegisterRoutes(e, servWrapper.PaymentService, middleware.OptionalCustomer(servWrapper.AuthService))
+	payment.RegisterCustomerRoutes(e, servWrapper.PaymentService, middleware.RequireCustomer(servWrapper.AuthService))
 
 	// Initialize shipping routes
 	shipping.InitRoute(e, servWrapper)
@@ -28,6 +33,15 @@ func InitHandler(cfg *config.AppConfig, e *echo.Echo, servWrapper *serv.ServiceW
 	// Initialize category routes
 	category.InitRoute(e, servWrapper)
 
+	// Initialize auth routes
+	auth.InitRoute(e, servWrapper)
+
+	// Initialize address book routes
+	address.InitRoute(e, servWrapper)
+
+	// Initialize admin routes
+	admin.InitRoute(e, servWrapper)
+
 	// Init swagger
 	swagger.InitRoute(e)
 }
@@ -0,0 +1,75 @@
+package entity
+
+import "time"
+
+// Admin roles
+const (
+	AdminRoleOwner         = "owner"
+	AdminRoleOps           = "ops"
+	AdminRoleCatalogEditor = "catalog_editor"
+	AdminRoleFinance       = "finance"
+)
+
+// Admin permissions. Each admin route group declares the permission it needs.
+const (
+	PermCatalogRead      = "catalog:read"
+	PermCatalogWrite     = "catalog:write"
+	PermInventoryWrite   = "inventory:write"
+	PermOrdersRead       = "orders:read"
+	PermOrdersWrite      = "orders:write"
+	PermAdminUsersManage = "admin_users:manage"
+	PermAuditLogRead     = "audit_log:read"
+)
+
+// rolePermissions lists what each role may do. Owners may do everything.
+var rolePermissions = map[string][]string{
+	AdminRoleOwner:         {PermCatalogRead, PermCatalogWrite, PermInventoryWrite, PermOrdersRead, PermOrdersWrite, PermAdminUsersManage, PermAuditLogRead},
+	AdminRoleOps:           {PermCatalogRead, PermInventoryWrite, PermOrdersRead, PermOrdersWrite},
+	AdminRoleCatalogEditor: {PermCatalogRead, PermCatalogWrite},
+	AdminRoleFinance:       {PermOrdersRead, PermAuditLogRead},
+}
+
+// RoleHasPermission reports whether the role grants the permission. Unknown roles grant nothing.
+func RoleHasPermission(role, permission string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Target types of admin audit log entries
+const (
+	AuditTargetProduct   = "product"
+	AuditTargetVariant   = "variant"
+	AuditTargetCategory  = "category"
+	AuditTargetOrder     = "order"
+	AuditTargetAdminUser = "admin_user"
+)
+
+// AdminUser is a member of staff who can sign in to the admin API
+type AdminUser struct {
+	ID           string     `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Name         string     `gorm:"type:varchar(255);not null" json:"name"`
+	Email        string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"email"`
+	PasswordHash string     `gorm:"type:varchar(255);not null" json:"-"`
+	Role         string     `gorm:"type:varchar(32);not null" json:"role"`
+	IsActive     bool       `gorm:"not null;default:true" json:"is_active"`
+	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+// AdminAuditLog records one admin action. Changes holds every field the action changed
+// as {"field": {"before": ..., "after": ...}}.
+type AdminAuditLog struct {
+	ID         string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ActorID    string    `gorm:"type:uuid;not null;index" json:"actor_id"`
+	ActorEmail string    `gorm:"type:varchar(255);not null" json:"actor_email"` // Kept as it was when the action was made
+	Action     string    `gorm:"type:varchar(64);not null" json:"action"`
+	TargetType string    `gorm:"type:varchar(32);not null;index:idx_admin_audit_logs_target" json:"target_type"`
+	TargetID   string    `gorm:"type:varchar(64);not null;index:idx_admin_audit_logs_target" json:"target_id"`
+	Changes    JSONMap   `gorm:"type:jsonb" json:"changes,omitempty"`
+	CreatedAt  time.Time `gorm:"not null;index" json:"created_at"`
+}
@@ -3,6 +3,8 @@ package entity
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +19,10 @@ type AWBTracking struct {
 	LastPhoneNumber *string       `json:"last_phone_number,omitempty" db:"last_phone_number"`
 	IsValidated     bool          `json:"is_validated" db:"is_validated"`
 	TrackingData    *TrackingData `json:"tracking_data,omitempty" db:"tracking_data"`
+	CheckFailures   int           `json:"check_failures" db:"check_failures"`             // Failed refreshes in a row, drives the backoff
+	LastCheckedAt   *time.Time    `json:"last_checked_at,omitempty" db:"last_checked_at"` // Last time TrackingData was refreshed from the courier
+	NextCheckAt     *time.Time    `json:"next_check_at,omitempty" db:"next_check_at"`     // Not refreshed again before this; nil means as soon as possible
+	DeliveredAt     *time.Time    `json:"delivered_at,omitempty" db:"delivered_at"`       // Set once the courier reports delivery; refreshing stops then
 	CreatedAt       time.Time     `json:"created_at" db:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at" db:"updated_at"`
 	DeletedAt       *time.Time    `json:"deleted_at,omitempty" db:"deleted_at"`
@@ -27,6 +33,17 @@ func (AWBTracking) TableName() string {
 	return "awb_tracking"
 }
 
+// ShippingWebhookEvent records a tracking webhook call that was accepted, so the same
+// signed call can never be applied twice
+type ShippingWebhookEvent struct {
+	ID         string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Provider   string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_shipping_webhook_events_provider_event" json:"provider"`
+	EventID    string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_shipping_webhook_events_provider_event" json:"event_id"` // The call's signature
+	AWBNumber  string    `gorm:"type:varchar(100);not null" json:"awb_number"`
+	Courier    string    `gorm:"type:varchar(50);not null" json:"courier"`
+	ReceivedAt time.Time `gorm:"not null" json:"received_at"`
+}
+
 // TrackingData represents the detailed tracking information from RajaOngkir API
 type TrackingData struct {
 	Delivered      bool            `json:"delivered"`
@@ -106,6 +123,12 @@ func (td TrackingData) Value() (driver.Value, error) {
 	return json.Marshal(td)
 }
 
+// TrackingLink is the frontend page that tracks the shipments of an order. Emails and
+// WhatsApp messages both link to it.
+func TrackingLink(baseURL, orderNumber string) string {
+	return fmt.Sprintf("%s/track-order/%s", baseURL, url.PathEscape(orderNumber))
+}
+
 // ValidCouriers returns a list of valid courier codes
 func ValidCouriers() []string {
 	return []string{
@@ -107,6 +107,11 @@ func TestValidCouriers(t *testing.T) {
 	}
 }
 
+func TestTrackingLink(t *testing.T) {
+	assert.Equal(t, "https://shop.example.com/track-order/IQB-2025-00001", TrackingLink("https://shop.example.com", "IQB-2025-00001"))
+	assert.Equal(t, "https://shop.example.com/track-order/IQB%2F1", TrackingLink("https://shop.example.com", "IQB/1"))
+}
+
 func TestIsValidCourier(t *testing.T) {
 	testCases := []struct {
 		courier  string
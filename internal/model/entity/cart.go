@@ -7,14 +7,15 @@ import (
 )
 
 type Cart struct {
-	ID         string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	CustomerID *string        `gorm:"type:uuid;index" json:"customer_id,omitempty"` // Nullable for guest carts
-	CartItems  []CartItem     `gorm:"foreignKey:CartID" json:"cart_items,omitempty"`
-	CreatedAt  time.Time      `gorm:"not null" json:"created_at"`
-	UpdatedAt  time.Time      `gorm:"not null" json:"updated_at"`
-	ExpiresAt  *time.Time     `json:"expires_at,omitempty"`          // When the cart should expire (e.g., after 24 hours of inactivity)
-	IsActive   bool           `gorm:"default:true" json:"is_active"` // False if converted to order or explicitly abandoned
-	DeletedAt  gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	ID           string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	CustomerID   *string        `gorm:"type:uuid;index" json:"customer_id,omitempty"` // Nullable for guest carts
+	CartItems    []CartItem     `gorm:"foreignKey:CartID" json:"cart_items,omitempty"`
+	DiscountCode *string        `gorm:"type:varchar(50)" json:"discount_code,omitempty"` // Applied discount code, re-validated on every cart change
+	CreatedAt    time.Time      `gorm:"not null" json:"created_at"`
+	UpdatedAt    time.Time      `gorm:"not null" json:"updated_at"`
+	ExpiresAt    *time.Time     `json:"expires_at,omitempty"`          // When the cart should expire (e.g., after 24 hours of inactivity)
+	IsActive     bool           `gorm:"default:true" json:"is_active"` // False if converted to order or explicitly abandoned
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
 type CartItem struct {
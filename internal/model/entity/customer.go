@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"regexp"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -8,17 +10,98 @@ import (
 
 // Customer represents a customer in the system
 type Customer struct {
-	ID                string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	FirstName         string         `gorm:"type:varchar(255)" json:"first_name"`
-	LastName          string         `gorm:"type:varchar(255)" json:"last_name"`
-	Email             string         `gorm:"type:varchar(255);uniqueIndex" json:"email"`
-	PhoneNumber       string         `gorm:"type:varchar(50)" json:"phone_number"`
-	PasswordHash      string         `gorm:"type:varchar(255)" json:"password_hash"`
-	Salt              string         `gorm:"type:varchar(255)" json:"salt"`
-	AddressDetails    JSONMap        `gorm:"type:jsonb" json:"address_details,omitempty"`
-	LastLoginAt       *time.Time     `json:"last_login_at,omitempty"`
-	IsEmailVerified   bool           `gorm:"default:false" json:"is_email_verified"`
-	CreatedAt         time.Time      `gorm:"not null" json:"created_at"`
-	UpdatedAt         time.Time      `gorm:"not null" json:"updated_at"`
-	DeletedAt         gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
-}
\ No newline at end of file
+	ID              string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	FirstName       string         `gorm:"type:varchar(255)" json:"first_name"`
+	LastName        string         `gorm:"type:varchar(255)" json:"last_name"`
+	Email           string         `gorm:"type:varchar(255);uniqueIndex" json:"email"`
+	PhoneNumber     string         `gorm:"type:varchar(50)" json:"phone_number"`
+	PasswordHash    string         `gorm:"type:varchar(255)" json:"-"`
+	Salt            string         `gorm:"type:varchar(255)" json:"-"` // Unused with bcrypt, which keeps its salt in the hash
+	AddressDetails  JSONMap        `gorm:"type:jsonb" json:"address_details,omitempty"`
+	LastLoginAt     *time.Time     `json:"last_login_at,omitempty"`
+	IsEmailVerified bool           `gorm:"default:false" json:"is_email_verified"`
+	CreatedAt       time.Time      `gorm:"not null" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"not null" json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
+
+// CustomerRefreshToken is an issued refresh token. Only a hash of the token is stored;
+// a token is usable until it expires or is revoked by logout or rotation.
+type CustomerRefreshToken struct {
+	ID         string     `gorm:"primaryKey;type:uuid" json:"id"` // Matches the token's jti claim
+	CustomerID string     `gorm:"type:uuid;not null;index" json:"customer_id"`
+	TokenHash  string     `gorm:"type:varchar(64);not null" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"not null" json:"created_at"`
+}
+
+// Purposes of a CustomerActionToken
+const (
+	CustomerTokenEmailVerification = "email_verification"
+	CustomerTokenPasswordReset     = "password_reset"
+)
+
+// CustomerActionToken is a single-use token mailed to a customer to verify their
+// email address or reset their password. Only a hash of the token is stored.
+type CustomerActionToken struct {
+	ID         string     `gorm:"primaryKey;type:uuid" json:"id"`
+	CustomerID string     `gorm:"type:uuid;not null;index" json:"customer_id"`
+	Purpose    string     `gorm:"type:varchar(32);not null" json:"purpose"`
+	TokenHash  string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt     *time.Time `json:"used_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"not null" json:"created_at"`
+}
+
+// CustomerAddress is a saved shipping address. The RajaOngkir IDs are stored next to the
+// display names so checkout can quote shipping without looking the location up again.
+type CustomerAddress struct {
+	ID            string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	CustomerID    string         `gorm:"type:uuid;not null;index" json:"customer_id"`
+	Label         string         `gorm:"type:varchar(50)" json:"label"` // e.g. "Home" or "Office"
+	RecipientName string         `gorm:"type:varchar(255);not null" json:"recipient_name"`
+	PhoneNumber   string         `gorm:"type:varchar(50);not null" json:"phone_number"`
+	StreetAddress string         `gorm:"type:text;not null" json:"street_address"`
+	ProvinceID    string         `gorm:"type:varchar(20);not null" json:"province_id"`
+	ProvinceName  string         `gorm:"type:varchar(100);not null" json:"province_name"`
+	CityID        string         `gorm:"type:varchar(20);not null" json:"city_id"`
+	CityName      string         `gorm:"type:varchar(100);not null" json:"city_name"`
+	DistrictID    string         `gorm:"type:varchar(20);not null" json:"district_id"`
+	DistrictName  string         `gorm:"type:varchar(100);not null" json:"district_name"`
+	PostalCode    string         `gorm:"type:varchar(20);not null" json:"postal_code"`
+	IsDefault     bool           `gorm:"default:false" json:"is_default"` // At most one default address per customer
+	CreatedAt     time.Time      `gorm:"not null" json:"created_at"`
+	UpdatedAt     time.Time      `gorm:"not null" json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
+
+var nonDigits = regexp.MustCompile(`\D`)
+
+// SamePhoneNumber compares Indonesian phone numbers regardless of formatting,
+// so 0812-3456-7890 matches +62 812 3456 7890
+func SamePhoneNumber(a, b string) bool {
+	normalize := func(phone string) string {
+		digits := nonDigits.ReplaceAllString(phone, "")
+		if strings.HasPrefix(digits, "62") {
+			return digits[2:]
+		}
+		return strings.TrimPrefix(digits, "0")
+	}
+
+	na, nb := normalize(a), normalize(b)
+	return na != "" && na == nb
+}
+
+// WhatsAppAddress converts an Indonesian phone number such as 0812-3456-7890 to the
+// WhatsApp address +6281234567890@s.whatsapp.net
+func WhatsAppAddress(phoneNumber string) string {
+	digitsOnly := nonDigits.ReplaceAllString(phoneNumber, "")
+	if strings.HasPrefix(digitsOnly, "0") {
+		digitsOnly = "62" + digitsOnly[1:]
+	}
+	if !strings.HasPrefix(digitsOnly, "62") {
+		digitsOnly = "62" + digitsOnly
+	}
+	return "+" + digitsOnly + "@s.whatsapp.net"
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSamePhoneNumber(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{"same digits", "081234567890", "081234567890", true},
+		{"local and international", "0812-3456-7890", "+62 812 3456 7890", true},
+		{"without leading zero", "81234567890", "6281234567890", true},
+		{"end of the number", "34567890", "081234567890", false},
+		{"other number", "081234567891", "081234567890", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SamePhoneNumber(tc.a, tc.b))
+		})
+	}
+}
+
+func TestWhatsAppAddress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		phone    string
+		expected string
+	}{
+		{"local", "0812-3456-7890", "+6281234567890@s.whatsapp.net"},
+		{"international", "+62 812 3456 7890", "+6281234567890@s.whatsapp.net"},
+		{"without leading zero", "81234567890", "+6281234567890@s.whatsapp.net"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, WhatsAppAddress(tc.phone))
+		})
+	}
+}
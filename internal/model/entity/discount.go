@@ -1,11 +1,18 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// Discount type constants
+const (
+	DiscountTypePercentage  = "percentage"
+	DiscountTypeFixedAmount = "fixed_amount"
+)
+
 type Discount struct {
 	ID                 string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
 	Code               string         `gorm:"uniqueIndex;not null" json:"code"`
@@ -21,3 +28,43 @@ type Discount struct {
 	UpdatedAt          time.Time      `gorm:"not null" json:"updated_at"`
 	DeletedAt          gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
+
+// Validate checks that the discount can be used at the given time on an order with the given subtotal
+func (d *Discount) Validate(subtotal float64, now time.Time) error {
+	if !d.IsActive {
+		return fmt.Errorf("discount is not active")
+	}
+
+	if now.Before(d.StartsAt) {
+		return fmt.Errorf("discount has not started yet")
+	}
+
+	if d.ExpiresAt != nil && now.After(*d.ExpiresAt) {
+		return fmt.Errorf("discount has expired")
+	}
+
+	if d.UsageLimit != 0 && d.UsesCount >= d.UsageLimit {
+		return fmt.Errorf("discount usage limit reached")
+	}
+
+	if d.MinimumOrderAmount != 0 && subtotal < d.MinimumOrderAmount {
+		return fmt.Errorf("cart subtotal does not meet minimum order amount for discount")
+	}
+
+	return nil
+}
+
+// AmountFor returns how much the discount takes off the given subtotal, never more than the subtotal itself
+func (d *Discount) AmountFor(subtotal float64) float64 {
+	var amount float64
+	if d.Type == DiscountTypePercentage {
+		amount = subtotal * (d.Value / 100)
+	} else { // fixed_amount
+		amount = d.Value
+	}
+
+	if amount > subtotal {
+		return subtotal
+	}
+	return amount
+}
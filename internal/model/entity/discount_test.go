@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newValidDiscount() *Discount {
+	now := time.Now()
+	future := now.Add(24 * time.Hour)
+	return &Discount{
+		Code:               "TEST10",
+		Type:               DiscountTypePercentage,
+		Value:              10,
+		MinimumOrderAmount: 50,
+		StartsAt:           now.Add(-time.Hour),
+		ExpiresAt:          &future,
+		UsageLimit:         100,
+		UsesCount:          5,
+		IsActive:           true,
+	}
+}
+
+func TestDiscount_Validate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		modify   func(d *Discount)
+		subtotal float64
+		wantErr  string
+	}{
+		{name: "valid discount", modify: func(d *Discount) {}, subtotal: 100},
+		{name: "inactive", modify: func(d *Discount) { d.IsActive = false }, subtotal: 100, wantErr: "discount is not active"},
+		{name: "not started", modify: func(d *Discount) { d.StartsAt = now.Add(time.Hour) }, subtotal: 100, wantErr: "discount has not started yet"},
+		{name: "expired", modify: func(d *Discount) {
+			past := now.Add(-time.Minute)
+			d.ExpiresAt = &past
+		}, subtotal: 100, wantErr: "discount has expired"},
+		{name: "no expiry", modify: func(d *Discount) { d.ExpiresAt = nil }, subtotal: 100},
+		{name: "usage limit reached", modify: func(d *Discount) { d.UsesCount = 100 }, subtotal: 100, wantErr: "discount usage limit reached"},
+		{name: "unlimited usage", modify: func(d *Discount) { d.UsageLimit = 0; d.UsesCount = 1000 }, subtotal: 100},
+		{name: "below minimum order amount", modify: func(d *Discount) {}, subtotal: 49, wantErr: "cart subtotal does not meet minimum order amount for discount"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newValidDiscount()
+			tt.modify(d)
+
+			err := d.Validate(tt.subtotal, now)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiscount_AmountFor(t *testing.T) {
+	tests := []struct {
+		name         string
+		discountType string
+		value        float64
+		subtotal     float64
+		expected     float64
+	}{
+		{name: "percentage", discountType: DiscountTypePercentage, value: 10, subtotal: 250, expected: 25},
+		{name: "fixed amount", discountType: DiscountTypeFixedAmount, value: 20, subtotal: 250, expected: 20},
+		{name: "fixed amount capped at subtotal", discountType: DiscountTypeFixedAmount, value: 300, subtotal: 250, expected: 250},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Discount{Type: tt.discountType, Value: tt.value}
+
+			assert.Equal(t, tt.expected, d.AmountFor(tt.subtotal))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Reasons a variant's stock can change
+const (
+	InventoryReasonSale                = "sale"
+	InventoryReasonRestock             = "restock"
+	InventoryReasonAdjustment          = "adjustment"
+	InventoryReasonReturn              = "return"
+	InventoryReasonCancellationRelease = "cancellation_release"
+)
+
+// What an inventory movement's ReferenceID points at
+const (
+	InventoryReferenceOrder     = "order"
+	InventoryReferenceAdminUser = "admin_user"
+	InventoryReferenceSystem    = "system"
+)
+
+// InventoryMovement is one entry of a variant's stock ledger. A variant's
+// StockQuantity only ever changes together with a movement, so it always
+// equals the sum of its movements' QuantityDelta. A movement with a warehouse
+// changes that warehouse's WarehouseStock too, which likewise equals the sum
+// of the variant's movements in the warehouse.
+type InventoryMovement struct {
+	ID               string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ProductVariantID string    `gorm:"type:uuid;not null;index:idx_inventory_movements_variant" json:"product_variant_id"`
+	WarehouseID      *string   `gorm:"type:uuid;index" json:"warehouse_id,omitempty"` // Nil for stock held outside any warehouse
+	Reason           string    `gorm:"type:varchar(32);not null" json:"reason"`
+	QuantityDelta    int       `gorm:"not null" json:"quantity_delta"` // Positive adds stock, negative removes it
+	ReferenceType    string    `gorm:"type:varchar(32);not null" json:"reference_type"`
+	ReferenceID      string    `gorm:"type:varchar(64);not null" json:"reference_id"` // Order ID, admin user ID or a system marker
+	Note             string    `gorm:"type:text" json:"note,omitempty"`
+	CreatedAt        time.Time `gorm:"not null;index:idx_inventory_movements_variant" json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// LocationProvince, LocationCity and LocationDistrict are the places RajaOngkir delivers
+// to, stored so they can be listed without calling RajaOngkir. IDs are RajaOngkir's own.
+type LocationProvince struct {
+	ID             string     `gorm:"primaryKey;type:varchar(20)" json:"id"`
+	Name           string     `gorm:"type:varchar(100);not null" json:"name"`
+	SyncedAt       time.Time  `gorm:"not null" json:"synced_at"`
+	CitiesSyncedAt *time.Time `json:"cities_synced_at,omitempty"` // When the province's cities were last stored; nil until they are
+}
+
+type LocationCity struct {
+	ID                string     `gorm:"primaryKey;type:varchar(20)" json:"id"`
+	ProvinceID        string     `gorm:"type:varchar(20);index;not null" json:"province_id"`
+	Name              string     `gorm:"type:varchar(100);not null" json:"name"`
+	SyncedAt          time.Time  `gorm:"not null" json:"synced_at"`
+	DistrictsSyncedAt *time.Time `json:"districts_synced_at,omitempty"` // When the city's districts were last stored; nil until they are
+}
+
+type LocationDistrict struct {
+	ID       string    `gorm:"primaryKey;type:varchar(20)" json:"id"`
+	CityID   string    `gorm:"type:varchar(20);index;not null" json:"city_id"`
+	Name     string    `gorm:"type:varchar(100);not null" json:"name"`
+	SyncedAt time.Time `gorm:"not null" json:"synced_at"`
+}
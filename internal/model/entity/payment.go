@@ -53,6 +53,16 @@ type Payment struct {
 	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
+// Order status constants
+const (
+	OrderStatusPending    = "pending"
+	OrderStatusProcessing = "processing"
+	OrderStatusShipped    = "shipped"
+	OrderStatusDelivered  = "delivered"
+	OrderStatusCancelled  = "cancelled"
+	OrderStatusRefunded   = "refunded"
+)
+
 // Order represents an order in the system
 type Order struct {
 	ID                          string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
@@ -84,6 +94,7 @@ type Order struct {
 	SourceChannel               string         `gorm:"type:varchar(50);default:'web'" json:"source_channel"`
 	Notes                       string         `gorm:"type:text" json:"notes,omitempty"`
 	Payment                     *Payment       `gorm:"foreignKey:OrderID" json:"payment,omitempty"`
+	StockReleasedAt             *time.Time     `json:"stock_released_at,omitempty"` // Set once reserved stock has been given back
 	CreatedAt                   time.Time      `gorm:"not null" json:"created_at"`
 	UpdatedAt                   time.Time      `gorm:"not null" json:"updated_at"`
 	DeletedAt                   gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
@@ -97,11 +108,43 @@ type OrderItem struct {
 	ProductVariant   *ProductVariant `gorm:"foreignKey:ProductVariantID" json:"product_variant,omitempty"`
 	Quantity         int             `gorm:"not null" json:"quantity"`
 	PriceAtPurchase  float64         `gorm:"type:decimal(10,2);not null" json:"price_at_purchase"`
+	WarehouseID      *string         `gorm:"type:uuid" json:"warehouse_id,omitempty"` // Warehouse the item ships from; nil when it ships from the configured origin
 	CreatedAt        time.Time       `gorm:"not null" json:"created_at"`
 	UpdatedAt        time.Time       `gorm:"not null" json:"updated_at"`
 	DeletedAt        gorm.DeletedAt  `gorm:"index" json:"deleted_at,omitempty"`
 }
 
+// Notification rejection reasons
+const (
+	NotificationRejectInvalidSignature = "invalid_signature"
+	NotificationRejectAmountMismatch   = "amount_mismatch"
+)
+
+// PaymentNotificationAudit records a payment notification that was rejected
+type PaymentNotificationAudit struct {
+	ID                string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OrderID           string    `gorm:"type:varchar(100);index" json:"order_id"`
+	TransactionID     string    `gorm:"type:varchar(100)" json:"transaction_id,omitempty"`
+	TransactionStatus string    `gorm:"type:varchar(50)" json:"transaction_status,omitempty"`
+	StatusCode        string    `gorm:"type:varchar(10)" json:"status_code,omitempty"`
+	GrossAmount       string    `gorm:"type:varchar(50)" json:"gross_amount,omitempty"`
+	Reason            string    `gorm:"type:varchar(50);not null" json:"reason"`
+	Payload           JSONMap   `gorm:"type:jsonb" json:"payload,omitempty"`
+	CreatedAt         time.Time `gorm:"not null" json:"created_at"`
+}
+
+// OrderStatusChange records an order status change made through the admin API or by
+// the shipment tracking refresher
+type OrderStatusChange struct {
+	ID         string    `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OrderID    string    `gorm:"type:uuid;not null;index" json:"order_id"`
+	FromStatus string    `gorm:"type:varchar(20);not null" json:"from_status"`
+	ToStatus   string    `gorm:"type:varchar(20);not null" json:"to_status"`
+	Note       string    `gorm:"type:text" json:"note,omitempty"`
+	ChangedBy  string    `gorm:"type:varchar(255);not null" json:"changed_by"`
+	CreatedAt  time.Time `gorm:"not null" json:"created_at"`
+}
+
 // FormatToIndonesianCurrency formats a float64 value to Indonesian currency format
 // with dot (.) as thousand separator
 // Example: 1000 -> 1.000, 100000 -> 100.000, 1234.56 -> 1.234,56
@@ -13,7 +13,8 @@ type Product struct {
 	ID           string           `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	Name         string           `gorm:"type:varchar(255);not null" json:"name"`
 	Description  string           `gorm:"type:text" json:"description"`
-	Category     string           `gorm:"type:varchar(100)" json:"category"`
+	CategoryID   *string          `gorm:"type:uuid;index" json:"category_id,omitempty"`
+	Category     string           `gorm:"type:varchar(100)" json:"category"` // Slug of the category, kept in sync when the slug is renamed
 	Brand        string           `gorm:"type:varchar(100)" json:"brand"`
 	Features     JSONArray        `gorm:"type:jsonb" json:"features"`
 	InBoxItems   JSONArray        `gorm:"type:jsonb" json:"in_box_items"`
@@ -28,21 +29,23 @@ type Product struct {
 }
 
 type ProductVariant struct {
-	ID              string      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	ProductID       string      `gorm:"type:uuid;not null" json:"product_id"`
-	SKU             string      `gorm:"type:varchar(50);uniqueIndex" json:"sku"`
-	Name            string      `gorm:"type:varchar(255)" json:"name"`
-	Price           float64     `gorm:"type:decimal(10,2);not null" json:"price"`
-	StockQuantity   int         `gorm:"not null" json:"stock_quantity"`
-	ImageURL        string      `gorm:"type:varchar(255)" json:"image_url"`
-	Weight          float64     `gorm:"type:decimal(10,2)" json:"weight"`
-	Dimensions      *Dimensions `gorm:"type:jsonb" json:"dimensions,omitempty"`
-	AttributeValues JSONMap     `gorm:"type:jsonb" json:"attribute_values"`
-	Specifications  JSONMap     `gorm:"type:jsonb" json:"specifications"` // e.g., {"Display": "0.49 Inch, OLED", "Material": "Plastic", "Battery": "45mAh"}
-	IsActive        bool        `gorm:"default:true" json:"is_active"`
-	CreatedAt       time.Time   `json:"created_at"`
-	UpdatedAt       time.Time   `json:"updated_at"`
-	DeletedAt       *time.Time  `gorm:"index" json:"deleted_at,omitempty"`
+	ID                string      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ProductID         string      `gorm:"type:uuid;not null" json:"product_id"`
+	SKU               string      `gorm:"type:varchar(50);uniqueIndex" json:"sku"`
+	Name              string      `gorm:"type:varchar(255)" json:"name"`
+	Price             float64     `gorm:"type:decimal(10,2);not null" json:"price"`
+	StockQuantity     int         `gorm:"not null" json:"stock_quantity"`
+	LowStockThreshold int         `gorm:"not null;default:0" json:"low_stock_threshold"` // Alert when stock drops to this or below; 0 turns alerts off
+	LowStockAlertedAt *time.Time  `json:"low_stock_alerted_at,omitempty"`                // Set when the alert is sent, cleared once stock rises above the threshold
+	ImageURL          string      `gorm:"type:varchar(255)" json:"image_url"`
+	Weight            float64     `gorm:"type:decimal(10,2)" json:"weight"` // In grams, the unit courier rates are quoted in
+	Dimensions        *Dimensions `gorm:"type:jsonb" json:"dimensions,omitempty"`
+	AttributeValues   JSONMap     `gorm:"type:jsonb" json:"attribute_values"`
+	Specifications    JSONMap     `gorm:"type:jsonb" json:"specifications"` // e.g., {"Display": "0.49 Inch, OLED", "Material": "Plastic", "Battery": "45mAh"}
+	IsActive          bool        `gorm:"default:true" json:"is_active"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+	DeletedAt         *time.Time  `gorm:"index" json:"deleted_at,omitempty"`
 }
 
 type Dimensions struct {
@@ -0,0 +1,44 @@
+package entity
+
+// ShippingProvince, ShippingCity and ShippingDistrict are the places a shipping provider
+// delivers to. IDs are the provider's own.
+type ShippingProvince struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ShippingCity struct {
+	ID         string `json:"id"`
+	ProvinceID string `json:"province_id"`
+	Name       string `json:"name"`
+}
+
+type ShippingDistrict struct {
+	ID     string `json:"id"`
+	CityID string `json:"city_id"`
+	Name   string `json:"name"`
+}
+
+// ShippingQuote is what one courier service charges to ship a parcel
+type ShippingQuote struct {
+	Provider    string `json:"provider"` // Shipping provider that quoted the cost
+	Courier     string `json:"courier"`  // Lowercase courier code, such as jne
+	CourierName string `json:"courier_name"`
+	Service     string `json:"service"`
+	Description string `json:"description"`
+	Cost        int    `json:"cost"` // In rupiah
+	ETD         string `json:"etd"`  // Estimated days in transit, such as 2-3
+
+	OriginalCost int           `json:"original_cost"`          // Cost quoted by the provider, before shipping rules
+	AppliedRule  *ShippingRule `json:"applied_rule,omitempty"` // Shipping rule that set Cost; nil when none applied
+
+	Shipments []ShipmentQuote `json:"shipments,omitempty"` // Cost of each warehouse's parcel when the quote covers several
+}
+
+// ShipmentQuote is what one parcel of a split order costs to ship from its warehouse
+type ShipmentQuote struct {
+	WarehouseID   string `json:"warehouse_id"`
+	WarehouseName string `json:"warehouse_name"`
+	Origin        string `json:"origin"` // District ID of the warehouse
+	Cost          int    `json:"cost"`
+}
@@ -0,0 +1,140 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Shipping rule type constants
+const (
+	ShippingRuleFreeShipping = "free_shipping" // Shipping costs nothing
+	ShippingRuleFlatRate     = "flat_rate"     // Shipping costs Amount
+	ShippingRuleSubsidy      = "subsidy"       // Up to Amount is taken off the shipping cost
+	ShippingRuleNoShipping   = "no_shipping"   // The cart holds only SKUs that are not shipped, so shipping costs nothing
+)
+
+// ShippingRule adjusts courier quotes. A rule only applies to quotes matching all of
+// its conditions that are set; of the matching rules, the one with the lowest priority
+// number wins.
+type ShippingRule struct {
+	ID          string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Name        string         `gorm:"type:varchar(100);not null" json:"name"`
+	Type        string         `gorm:"type:varchar(32);not null" json:"type"` // free_shipping, flat_rate, subsidy, no_shipping
+	Priority    int            `gorm:"not null;default:0" json:"priority"`
+	Courier     string         `gorm:"type:varchar(50)" json:"courier,omitempty"`     // Courier code; empty matches every courier
+	ProvinceID  string         `gorm:"type:varchar(20)" json:"province_id,omitempty"` // Destination province ID; empty matches every province
+	MinSubtotal float64        `gorm:"not null;default:0" json:"min_subtotal"`        // 0 matches every subtotal
+	Amount      int            `gorm:"not null;default:0" json:"amount"`              // Flat rate or subsidy cap, in rupiah
+	SKUs        string         `gorm:"column:skus;type:text" json:"skus,omitempty"`   // Comma-separated SKUs of a no_shipping rule
+	IsActive    bool           `gorm:"not null;default:true" json:"is_active"`
+	CreatedAt   time.Time      `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"not null" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
+
+// ShippingParcel is what shipping rules know about the order being shipped
+type ShippingParcel struct {
+	Subtotal   float64
+	ProvinceID string   // Destination province ID; empty when unknown
+	SKUs       []string // SKUs of every item in the cart
+}
+
+// NewShippingParcel describes the cart shipped to the given province for shipping rules
+func NewShippingParcel(cart *Cart, provinceID string) ShippingParcel {
+	parcel := ShippingParcel{ProvinceID: provinceID}
+	for _, item := range cart.CartItems {
+		if item.ProductVariant == nil {
+			continue
+		}
+		parcel.Subtotal += float64(item.Quantity) * item.ProductVariant.Price
+		parcel.SKUs = append(parcel.SKUs, item.ProductVariant.SKU)
+	}
+	return parcel
+}
+
+// Matches reports whether the rule applies to the quote for the parcel
+func (r *ShippingRule) Matches(parcel ShippingParcel, quote ShippingQuote) bool {
+	if !r.IsActive {
+		return false
+	}
+	if r.Courier != "" && !strings.EqualFold(r.Courier, quote.Courier) {
+		return false
+	}
+	if r.ProvinceID != "" && r.ProvinceID != parcel.ProvinceID {
+		return false
+	}
+	if r.MinSubtotal != 0 && parcel.Subtotal < r.MinSubtotal {
+		return false
+	}
+	if r.Type == ShippingRuleNoShipping {
+		return r.coversAll(parcel.SKUs)
+	}
+	return true
+}
+
+// Apply returns the shipping cost after the rule, never below zero
+func (r *ShippingRule) Apply(cost int) int {
+	switch r.Type {
+	case ShippingRuleFreeShipping, ShippingRuleNoShipping:
+		return 0
+	case ShippingRuleFlatRate:
+		return r.Amount
+	case ShippingRuleSubsidy:
+		if r.Amount > cost {
+			return 0
+		}
+		return cost - r.Amount
+	}
+	return cost
+}
+
+// ApplyShippingRules adjusts every quote with the first rule, in the given order, that
+// matches it. Quotes keep their provider cost in OriginalCost. When a rule applied, the
+// quotes are sorted cheapest first again.
+func ApplyShippingRules(rules []ShippingRule, parcel ShippingParcel, quotes []ShippingQuote) []ShippingQuote {
+	adjusted := make([]ShippingQuote, 0, len(quotes))
+	changed := false
+	for _, quote := range quotes {
+		quote.OriginalCost = quote.Cost
+		for i := range rules {
+			if rules[i].Matches(parcel, quote) {
+				quote.Cost = rules[i].Apply(quote.Cost)
+				quote.AppliedRule = &rules[i]
+				changed = true
+				break
+			}
+		}
+		adjusted = append(adjusted, quote)
+	}
+	if !changed {
+		return adjusted
+	}
+
+	sort.SliceStable(adjusted, func(i, j int) bool {
+		return adjusted[i].Cost < adjusted[j].Cost
+	})
+	return adjusted
+}
+
+// coversAll reports whether every SKU is one of the rule's SKUs. An empty cart is not covered.
+func (r *ShippingRule) coversAll(skus []string) bool {
+	ruleSKUs := map[string]bool{}
+	for _, sku := range strings.Split(r.SKUs, ",") {
+		if sku = strings.TrimSpace(sku); sku != "" {
+			ruleSKUs[sku] = true
+		}
+	}
+
+	if len(skus) == 0 {
+		return false
+	}
+	for _, sku := range skus {
+		if !ruleSKUs[sku] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShippingRule_Matches(t *testing.T) {
+	parcel := ShippingParcel{Subtotal: 500000, ProvinceID: "6", SKUs: []string{"EBOOK-1", "EBOOK-2"}}
+	quote := ShippingQuote{Courier: "jne", Service: "REG", Cost: 20000}
+
+	tests := []struct {
+		name string
+		rule ShippingRule
+		want bool
+	}{
+		{name: "no conditions", rule: ShippingRule{Type: ShippingRuleFreeShipping, IsActive: true}, want: true},
+		{name: "inactive", rule: ShippingRule{Type: ShippingRuleFreeShipping}, want: false},
+		{name: "same courier", rule: ShippingRule{Type: ShippingRuleSubsidy, Courier: "JNE", IsActive: true}, want: true},
+		{name: "other courier", rule: ShippingRule{Type: ShippingRuleSubsidy, Courier: "pos", IsActive: true}, want: false},
+		{name: "same province", rule: ShippingRule{Type: ShippingRuleFlatRate, ProvinceID: "6", IsActive: true}, want: true},
+		{name: "other province", rule: ShippingRule{Type: ShippingRuleFlatRate, ProvinceID: "9", IsActive: true}, want: false},
+		{name: "subtotal reached", rule: ShippingRule{Type: ShippingRuleFreeShipping, MinSubtotal: 500000, IsActive: true}, want: true},
+		{name: "subtotal not reached", rule: ShippingRule{Type: ShippingRuleFreeShipping, MinSubtotal: 500001, IsActive: true}, want: false},
+		{name: "every SKU covered", rule: ShippingRule{Type: ShippingRuleNoShipping, SKUs: "EBOOK-1, EBOOK-2,EBOOK-3", IsActive: true}, want: true},
+		{name: "one SKU not covered", rule: ShippingRule{Type: ShippingRuleNoShipping, SKUs: "EBOOK-1", IsActive: true}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Matches(parcel, quote))
+		})
+	}
+
+	t.Run("empty cart is not covered by SKUs", func(t *testing.T) {
+		rule := ShippingRule{Type: ShippingRuleNoShipping, SKUs: "EBOOK-1", IsActive: true}
+		assert.False(t, rule.Matches(ShippingParcel{}, quote))
+	})
+}
+
+func TestShippingRule_Apply(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ShippingRule
+		cost int
+		want int
+	}{
+		{name: "free shipping", rule: ShippingRule{Type: ShippingRuleFreeShipping}, cost: 20000, want: 0},
+		{name: "no shipping", rule: ShippingRule{Type: ShippingRuleNoShipping}, cost: 20000, want: 0},
+		{name: "flat rate", rule: ShippingRule{Type: ShippingRuleFlatRate, Amount: 15000}, cost: 40000, want: 15000},
+		{name: "subsidy below cost", rule: ShippingRule{Type: ShippingRuleSubsidy, Amount: 10000}, cost: 25000, want: 15000},
+		{name: "subsidy capped at cost", rule: ShippingRule{Type: ShippingRuleSubsidy, Amount: 10000}, cost: 8000, want: 0},
+		{name: "unknown type", rule: ShippingRule{Type: "bogus", Amount: 10000}, cost: 8000, want: 8000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Apply(tt.cost))
+		})
+	}
+}
+
+func TestApplyShippingRules(t *testing.T) {
+	rules := []ShippingRule{
+		{ID: "subsidy", Type: ShippingRuleSubsidy, Courier: "jne", Amount: 5000, IsActive: true},
+		{ID: "flat", Type: ShippingRuleFlatRate, Amount: 9000, IsActive: true},
+	}
+	quotes := []ShippingQuote{
+		{Courier: "pos", Service: "KILAT", Cost: 12000},
+		{Courier: "jne", Service: "REG", Cost: 15000},
+	}
+
+	adjusted := ApplyShippingRules(rules, ShippingParcel{}, quotes)
+
+	assert.Len(t, adjusted, 2)
+	// The first matching rule wins, and the quotes are sorted by their new cost
+	assert.Equal(t, "pos", adjusted[0].Courier)
+	assert.Equal(t, 9000, adjusted[0].Cost)
+	assert.Equal(t, 12000, adjusted[0].OriginalCost)
+	assert.Equal(t, "flat", adjusted[0].AppliedRule.ID)
+	assert.Equal(t, "jne", adjusted[1].Courier)
+	assert.Equal(t, 10000, adjusted[1].Cost)
+	assert.Equal(t, 15000, adjusted[1].OriginalCost)
+	assert.Equal(t, "subsidy", adjusted[1].AppliedRule.ID)
+
+	t.Run("no rules keep the quoted cost", func(t *testing.T) {
+		adjusted := ApplyShippingRules(nil, ShippingParcel{}, quotes)
+
+		assert.Equal(t, 12000, adjusted[0].Cost)
+		assert.Equal(t, 12000, adjusted[0].OriginalCost)
+		assert.Nil(t, adjusted[0].AppliedRule)
+	})
+}
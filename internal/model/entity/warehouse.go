@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Warehouse is a place orders ship from. Its district is the origin of courier quotes.
+type Warehouse struct {
+	ID         string         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Code       string         `gorm:"type:varchar(32);uniqueIndex;not null" json:"code"`
+	Name       string         `gorm:"type:varchar(100);not null" json:"name"`
+	DistrictID string         `gorm:"type:varchar(20);not null" json:"district_id"` // RajaOngkir district ID
+	ProvinceID string         `gorm:"type:varchar(20)" json:"province_id"`          // RajaOngkir province ID; orders to this province prefer the warehouse
+	Priority   int            `gorm:"not null;default:0" json:"priority"`           // Lower ships first when several warehouses can
+	IsActive   bool           `gorm:"not null;default:true" json:"is_active"`
+	CreatedAt  time.Time      `gorm:"not null" json:"created_at"`
+	UpdatedAt  time.Time      `gorm:"not null" json:"updated_at"`
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
+
+// WarehouseStock is how many units of a variant a warehouse holds, out of the variant's
+// StockQuantity. It only changes through inventory movements with the warehouse.
+type WarehouseStock struct {
+	WarehouseID      string    `gorm:"primaryKey;type:uuid" json:"warehouse_id"`
+	ProductVariantID string    `gorm:"primaryKey;type:uuid" json:"product_variant_id"`
+	Quantity         int       `gorm:"not null;default:0" json:"quantity"`
+	UpdatedAt        time.Time `gorm:"not null" json:"updated_at"`
+}
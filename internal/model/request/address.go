@@ -0,0 +1,18 @@
+package request
+
+// AddressRequest creates or replaces a saved address. The IDs are the RajaOngkir
+// province, city and district IDs of the chosen location.
+type AddressRequest struct {
+	Label         string `json:"label,omitempty" validate:"max=50"`
+	RecipientName string `json:"recipient_name" validate:"required,max=255"`
+	PhoneNumber   string `json:"phone_number" validate:"required,max=50"`
+	StreetAddress string `json:"street_address" validate:"required"`
+	ProvinceID    string `json:"province_id" validate:"required"`
+	ProvinceName  string `json:"province_name" validate:"required"`
+	CityID        string `json:"city_id" validate:"required"`
+	CityName      string `json:"city_name" validate:"required"`
+	DistrictID    string `json:"district_id" validate:"required"`
+	DistrictName  string `json:"district_name" validate:"required"`
+	PostalCode    string `json:"postal_code" validate:"required"`
+	IsDefault     bool   `json:"is_default"`
+}
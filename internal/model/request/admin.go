@@ -0,0 +1,31 @@
+package request
+
+type AdminLoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+type CreateAdminUserRequest struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8,max=72"` // bcrypt only uses the first 72 bytes
+	Role     string `json:"role" validate:"required,oneof=owner ops catalog_editor finance"`
+}
+
+// UpdateAdminUserRequest changes an admin's name and role. IsActive and Password are
+// left as they are when omitted.
+type UpdateAdminUserRequest struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Role     string `json:"role" validate:"required,oneof=owner ops catalog_editor finance"`
+	IsActive *bool  `json:"is_active,omitempty"`
+	Password string `json:"password,omitempty" validate:"omitempty,min=8,max=72"`
+}
+
+type AuditLogListRequest struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Page       int
+	Limit      int
+}
@@ -0,0 +1,36 @@
+package request
+
+type RegisterRequest struct {
+	FirstName   string `json:"first_name" validate:"required,max=255"`
+	LastName    string `json:"last_name,omitempty" validate:"max=255"`
+	Email       string `json:"email" validate:"required,email"`
+	PhoneNumber string `json:"phone_number,omitempty" validate:"max=50"`
+	Password    string `json:"password" validate:"required,min=8,max=72"` // bcrypt only uses the first 72 bytes
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+	CartID   string `json:"cart_id,omitempty"` // Guest cart to merge into the customer's cart
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
@@ -1,7 +1,8 @@
 package request
 
 type AddItemRequest struct {
-	CartID    string `json:"cart_id,omitempty"`
+	CartID     string `json:"cart_id,omitempty"`
+	CustomerID string `json:"-"` // Set from the access token; a new cart is created for this customer
 	VariantID string `json:"variant_id" binding:"required"`
 	Quantity  int    `json:"quantity" binding:"required,min=1"`
 }
@@ -20,4 +21,8 @@ type RemoveItemRequest struct {
 type ApplyDiscountRequest struct {
 	CartID      string `json:"cart_id" binding:"required"`
 	DiscountCode string `json:"discount_code" binding:"required"`
-}
\ No newline at end of file
+}
+
+type RemoveDiscountRequest struct {
+	CartID string `json:"cart_id" binding:"required"`
+}
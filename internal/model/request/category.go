@@ -0,0 +1,18 @@
+package request
+
+// CategoryRequest holds the fields of a category an admin can change. IsActive
+// defaults to true when creating and is left as it is when omitted on update.
+type CategoryRequest struct {
+	Name                string  `json:"name" validate:"required,max=100"`
+	Slug                string  `json:"slug" validate:"required,max=100"`
+	HeroHeadline        *string `json:"hero_headline,omitempty" validate:"omitempty,max=255"`
+	HeroSubheadline     *string `json:"hero_subheadline,omitempty"`
+	HeroImageUrl        *string `json:"hero_image_url,omitempty" validate:"omitempty,max=255"`
+	Section3Headline    *string `json:"section3_headline,omitempty" validate:"omitempty,max=255"`
+	Section3Subheadline *string `json:"section3_subheadline,omitempty"`
+	Section3ImageUrl    *string `json:"section3_image_url,omitempty" validate:"omitempty,max=255"`
+	MetaTitle           *string `json:"meta_title,omitempty" validate:"omitempty,max=255"`
+	MetaDescription     *string `json:"meta_description,omitempty"`
+	DisplayOrder        int     `json:"display_order" validate:"gte=0"`
+	IsActive            *bool   `json:"is_active,omitempty"`
+}
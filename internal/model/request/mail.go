@@ -26,3 +26,23 @@ type OrderEmailData struct {
 	TotalAmount           string
 	OrderConfirmationLink string
 }
+
+// accountEmailData represents all data needed by the verify_email.html and
+// reset_password.html templates
+
+type AccountEmailData struct {
+	CustomerName string
+	ActionLink   string
+	ValidFor     string
+}
+
+// shipmentEmailData represents all data needed by the shipment_dispatched.html and
+// shipment_delivered.html templates
+
+type ShipmentEmailData struct {
+	CustomerName string
+	OrderNumber  string
+	Courier      string
+	AWBNumber    string
+	TrackingLink string
+}
@@ -1,20 +1,41 @@
 package request
 
+import "time"
+
 type CreateOrderRequest struct {
 	CartID               string  `json:"cart_id" validate:"required"`
-	CustomerName         string  `json:"customer_name" validate:"required"`
+	AddressID            string  `json:"address_id,omitempty"` // Saved address of the logged in customer, replaces the recipient and address fields
+	CustomerName         string  `json:"customer_name" validate:"required_without=AddressID"`
 	CustomerEmail        string  `json:"customer_email" validate:"required,email"`
-	CustomerPhone        string  `json:"customer_phone" validate:"required"`
-	ShippingAddress      string  `json:"shipping_address" validate:"required"`
-	ShippingCityName     string  `json:"shipping_city_name" validate:"required"`
-	ShippingProvinceName string  `json:"shipping_province_name" validate:"required"`
-	ShippingDistrictName string  `json:"shipping_district_name" validate:"required"`
-	ShippingPostalCode   string  `json:"shipping_postal_code" validate:"required"`
+	CustomerPhone        string  `json:"customer_phone" validate:"required_without=AddressID"`
+	ShippingAddress      string  `json:"shipping_address" validate:"required_without=AddressID"`
+	ShippingCityName     string  `json:"shipping_city_name" validate:"required_without=AddressID"`
+	ShippingProvinceName string  `json:"shipping_province_name" validate:"required_without=AddressID"`
+	ShippingDistrictName string  `json:"shipping_district_name" validate:"required_without=AddressID"`
+	ShippingDistrictID   string  `json:"shipping_district_id" validate:"required_without=AddressID"` // RajaOngkir district ID used to verify the shipping cost
+	ShippingProvinceID   string  `json:"shipping_province_id,omitempty"`                             // Checked against the province of the district; province rules use the district's own
+	ShippingPostalCode   string  `json:"shipping_postal_code" validate:"required_without=AddressID"`
 	ShippingCourier      string  `json:"shipping_courier" validate:"required"`
 	ShippingService      string  `json:"shipping_service" validate:"required"`
-	ShippingCost         float64 `json:"shipping_cost" validate:"required"`
-	TotalWeight          int     `json:"total_weight" validate:"required"`
+	ShippingCost         float64 `json:"shipping_cost" validate:"gte=0"` // 0 when a shipping rule makes shipping free
+	DiscountCode         string  `json:"discount_code,omitempty"`
 	Notes                string  `json:"notes,omitempty"`
+	CustomerID           string  `json:"-"` // Set from the access token so the order shows in the customer's history
+}
+
+// ListCustomerOrdersRequest is read from the query string of the customer's order history
+type ListCustomerOrdersRequest struct {
+	Statuses []string // Empty matches every status
+	Page     int
+	Limit    int
+}
+
+// GuestOrderLookupRequest finds an order placed without an account. The email or phone
+// must match the one given at checkout.
+type GuestOrderLookupRequest struct {
+	OrderNumber string `json:"order_number" validate:"required"`
+	Email       string `json:"email,omitempty" validate:"required_without=Phone,omitempty,email"`
+	Phone       string `json:"phone,omitempty" validate:"required_without=Email"`
 }
 
 type PaymentNotificationRequest struct {
@@ -30,3 +51,21 @@ type PaymentNotificationRequest struct {
 	FraudStatus       string `json:"fraud_status"`
 	Currency          string `json:"currency"`
 }
+
+// AdminListOrdersRequest is read from the query string of the admin order list
+type AdminListOrdersRequest struct {
+	Statuses      []string   // Empty matches every status
+	CreatedFrom   *time.Time // Inclusive
+	CreatedBefore *time.Time // Exclusive
+	Courier       string
+	SourceChannel string
+	Search        string // Part of the order number, customer name, email or phone
+	Page          int
+	Limit         int
+}
+
+// UpdateOrderStatusRequest moves an order to a new status
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" validate:"required"`
+	Note   string `json:"note,omitempty" validate:"max=500"`
+}
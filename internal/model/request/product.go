@@ -0,0 +1,55 @@
+package request
+
+// ProductRequest holds the fields of a product an admin can change
+type ProductRequest struct {
+	Name         string   `json:"name" validate:"required,max=255"`
+	Description  string   `json:"description"`
+	CategoryID   string   `json:"category_id" validate:"required,uuid"`
+	Brand        string   `json:"brand" validate:"max=100"`
+	Features     []string `json:"features" validate:"dive,required"`
+	InBoxItems   []string `json:"in_box_items" validate:"dive,required"`
+	ImageURLs    []string `json:"image_urls" validate:"dive,required"`
+	TokopediaURL *string  `json:"tokopedia_url,omitempty" validate:"omitempty,url,max=255"`
+	ShopeeURL    *string  `json:"shopee_url,omitempty" validate:"omitempty,url,max=255"`
+}
+
+// CreateProductRequest creates a product with its first variants
+type CreateProductRequest struct {
+	ProductRequest
+	Variants []VariantRequest `json:"variants" validate:"required,min=1,dive"`
+}
+
+// VariantRequest holds the fields of a product variant an admin can change.
+// Weight is in grams. StockQuantity is only used as the opening stock when
+// the variant is created; after that stock changes through inventory adjustments,
+// and an update sending a non-zero StockQuantity is rejected.
+// LowStockThreshold is the stock at or below which operations get a Telegram
+// alert; 0 turns alerts off.
+type VariantRequest struct {
+	SKU               string                 `json:"sku" validate:"required,max=50"`
+	Name              string                 `json:"name" validate:"required,max=255"`
+	Price             float64                `json:"price" validate:"gt=0"`
+	StockQuantity     int                    `json:"stock_quantity" validate:"gte=0"`
+	LowStockThreshold int                    `json:"low_stock_threshold" validate:"gte=0"`
+	ImageURL          string                 `json:"image_url" validate:"max=255"`
+	Weight            float64                `json:"weight" validate:"gt=0"`
+	Dimensions        *DimensionsRequest     `json:"dimensions,omitempty"`
+	AttributeValues   map[string]interface{} `json:"attribute_values"`
+	Specifications    map[string]interface{} `json:"specifications"`
+}
+
+type DimensionsRequest struct {
+	Length float64 `json:"length" validate:"gt=0"`
+	Width  float64 `json:"width" validate:"gt=0"`
+	Height float64 `json:"height" validate:"gt=0"`
+	Unit   string  `json:"unit" validate:"required,oneof=mm cm m"`
+}
+
+// InventoryAdjustmentRequest records a manual stock change of a variant.
+// Restocks and returns add stock; adjustments may go either way.
+type InventoryAdjustmentRequest struct {
+	Reason        string `json:"reason" validate:"required,oneof=restock adjustment return"`
+	QuantityDelta int    `json:"quantity_delta" validate:"required"`
+	WarehouseID   string `json:"warehouse_id,omitempty" validate:"omitempty,uuid"` // Required once there is an active warehouse
+	Note          string `json:"note" validate:"max=500"`
+}
@@ -14,10 +14,12 @@ type GetDistrictsRequest struct {
 }
 
 type CalculateShippingRequest struct {
-	Origin      string `json:"origin" validate:"required"`
+	Origin      string `json:"origin" validate:"required_without=CartID"` // Ignored when the cart ships from warehouses
 	Destination string `json:"destination" validate:"required"`
-	Weight      int    `json:"weight" validate:"required"`
+	Weight      int    `json:"weight" validate:"required_without=CartID"` // Ignored when a cart is given
 	Courier     string `json:"courier" validate:"required"`
+	CartID      string `json:"cart_id,omitempty"`     // Cart being shipped; picks the warehouses and is needed by subtotal and SKU shipping rules
+	ProvinceID  string `json:"province_id,omitempty"` // Checked against the destination's stored province, never used in its place
 }
 
 // ValidateAWBRequest represents the request to validate and save AWB number
@@ -27,3 +29,20 @@ type ValidateAWBRequest struct {
 	Courier         string  `json:"courier" validate:"required,oneof=jne jnt ninja tiki pos anteraja sicepat sap lion wahana first ide"` // Courier service name
 	LastPhoneNumber *string `json:"last_phone_number,omitempty" validate:"omitempty,len=5,numeric"`                                      // Last 5 digits of recipient's phone number (only required for JNE courier)
 }
+
+// TrackShipmentRequest looks up the shipments of an order. The email or the phone number
+// must match the ones given at checkout.
+type TrackShipmentRequest struct {
+	OrderNumber string `json:"order_number" validate:"required"`
+	Email       string `json:"email,omitempty" validate:"required_without=Phone,omitempty,email"`
+	Phone       string `json:"phone,omitempty" validate:"required_without=Email"` // The full phone number, in any format
+}
+
+// ShippingWebhookRequest is a tracking push from a provider, with the raw body the
+// signature was computed over
+type ShippingWebhookRequest struct {
+	Provider  string
+	Timestamp string // X-Webhook-Timestamp header, Unix seconds
+	Signature string // X-Webhook-Signature header, hex HMAC-SHA256 of "<timestamp>.<payload>"
+	Payload   []byte
+}
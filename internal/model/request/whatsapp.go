@@ -6,3 +6,12 @@ type WhatsAppRequest struct {
 	TotalAmount           string
 	OrderConfirmationLink string
 }
+
+// WhatsAppShipmentRequest holds the fields of the shipment dispatched and delivered messages
+type WhatsAppShipmentRequest struct {
+	CustomerName string
+	OrderNumber  string
+	Courier      string
+	AWBNumber    string
+	TrackingLink string
+}
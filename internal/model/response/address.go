@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+type AddressResponse struct {
+	ID            string    `json:"id"`
+	Label         string    `json:"label"`
+	RecipientName string    `json:"recipient_name"`
+	PhoneNumber   string    `json:"phone_number"`
+	StreetAddress string    `json:"street_address"`
+	ProvinceID    string    `json:"province_id"`
+	ProvinceName  string    `json:"province_name"`
+	CityID        string    `json:"city_id"`
+	CityName      string    `json:"city_name"`
+	DistrictID    string    `json:"district_id"`
+	DistrictName  string    `json:"district_name"`
+	PostalCode    string    `json:"postal_code"`
+	IsDefault     bool      `json:"is_default"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
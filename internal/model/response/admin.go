@@ -0,0 +1,18 @@
+package response
+
+import "github.com/hanifbg/landing_backend/internal/model/entity"
+
+type AdminAuthResponse struct {
+	AccessToken string           `json:"access_token"`
+	TokenType   string           `json:"token_type"`
+	ExpiresIn   int64            `json:"expires_in"` // Access token lifetime in seconds
+	Admin       entity.AdminUser `json:"admin"`
+}
+
+type AuditLogListResponse struct {
+	Logs       []entity.AdminAuditLog `json:"logs"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	Total      int64                  `json:"total"`
+	TotalPages int                    `json:"total_pages"`
+}
@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+type CustomerResponse struct {
+	ID              string     `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Email           string     `json:"email"`
+	PhoneNumber     string     `json:"phone_number"`
+	IsEmailVerified bool       `json:"is_email_verified"`
+	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
+}
+
+type AuthResponse struct {
+	AccessToken  string           `json:"access_token"`
+	RefreshToken string           `json:"refresh_token"`
+	TokenType    string           `json:"token_type"`
+	ExpiresIn    int64            `json:"expires_in"` // Access token lifetime in seconds
+	Customer     CustomerResponse `json:"customer"`
+	CartID       string           `json:"cart_id,omitempty"` // The customer's active cart, set on login
+}
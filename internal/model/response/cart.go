@@ -10,11 +10,18 @@ type CartItemResponse struct {
 	ProductAttributes map[string]interface{} `json:"product_attributes"`
 }
 
+// RemovedDiscount explains why a previously applied discount was dropped from the cart
+type RemovedDiscount struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
 type CartResponse struct {
 	CartID              string             `json:"cart_id"`
 	TotalItems          int                `json:"total_items"`
 	SubtotalAmount      float64            `json:"subtotal_amount"`
 	DiscountAmount      *float64           `json:"discount_amount,omitempty"`
 	DiscountCodeApplied *string            `json:"discount_code_applied,omitempty"`
+	DiscountRemoved     *RemovedDiscount   `json:"discount_removed,omitempty"`
 	Items               []CartItemResponse `json:"items"`
 }
@@ -0,0 +1,35 @@
+package response
+
+import "github.com/hanifbg/landing_backend/internal/model/entity"
+
+// InventoryHistoryResponse is a page of a variant's stock ledger. LedgerQuantity is
+// what the whole ledger adds up to and should always equal StockQuantity.
+type InventoryHistoryResponse struct {
+	VariantID      string                     `json:"variant_id"`
+	SKU            string                     `json:"sku"`
+	StockQuantity  int                        `json:"stock_quantity"`
+	LedgerQuantity int                        `json:"ledger_quantity"`
+	Movements      []entity.InventoryMovement `json:"movements"`
+	Page           int                        `json:"page"`
+	Limit          int                        `json:"limit"`
+	Total          int64                      `json:"total"`
+	TotalPages     int                        `json:"total_pages"`
+}
+
+type InventoryAdjustmentResponse struct {
+	Movement      entity.InventoryMovement `json:"movement"`
+	StockQuantity int                      `json:"stock_quantity"` // Stock after the adjustment
+}
+
+type StockRebuildResponse struct {
+	VariantID   string                          `json:"variant_id"`
+	StockBefore int                             `json:"stock_before"`
+	StockAfter  int                             `json:"stock_after"`
+	Warehouses  []WarehouseStockRebuildResponse `json:"warehouses"`
+}
+
+type WarehouseStockRebuildResponse struct {
+	WarehouseID string `json:"warehouse_id"`
+	StockBefore int    `json:"stock_before"`
+	StockAfter  int    `json:"stock_after"`
+}
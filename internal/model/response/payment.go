@@ -13,6 +13,7 @@ type OrderItemResponse struct {
 	ProductImage     string  `json:"product_image"`
 	Quantity         int     `json:"quantity"`
 	PriceAtPurchase  float64 `json:"price_at_purchase"`
+	WarehouseID      *string `json:"warehouse_id,omitempty"` // Warehouse the item ships from; empty when shipped from the default origin
 }
 
 type CreateOrderResponse struct {
@@ -51,6 +52,62 @@ type OrderResponse struct {
 	UpdatedAt                   time.Time           `json:"updated_at"`
 }
 
+// OrderSummaryResponse is an order as listed in the customer's order history
+type OrderSummaryResponse struct {
+	ID          string              `json:"id"`
+	OrderNumber string              `json:"order_number"`
+	OrderStatus string              `json:"order_status"`
+	TotalAmount float64             `json:"total_amount"`
+	Currency    string              `json:"currency"`
+	TotalItems  int                 `json:"total_items"`
+	OrderItems  []OrderItemResponse `json:"order_items"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+type OrderListResponse struct {
+	Orders     []OrderSummaryResponse `json:"orders"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	Total      int64                  `json:"total"`
+	TotalPages int                    `json:"total_pages"`
+}
+
+// AdminOrderSummaryResponse is an order as listed in the admin order list
+type AdminOrderSummaryResponse struct {
+	ID              string    `json:"id"`
+	OrderNumber     string    `json:"order_number"`
+	OrderStatus     string    `json:"order_status"`
+	CustomerName    string    `json:"customer_name"`
+	CustomerEmail   string    `json:"customer_email"`
+	CustomerPhone   string    `json:"customer_phone"`
+	ShippingCourier string    `json:"shipping_courier"`
+	ShippingService string    `json:"shipping_service"`
+	SourceChannel   string    `json:"source_channel"`
+	TotalAmount     float64   `json:"total_amount"`
+	Currency        string    `json:"currency"`
+	TotalItems      int       `json:"total_items"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type AdminOrderListResponse struct {
+	Orders     []AdminOrderSummaryResponse `json:"orders"`
+	Page       int                         `json:"page"`
+	Limit      int                         `json:"limit"`
+	Total      int64                       `json:"total"`
+	TotalPages int                         `json:"total_pages"`
+}
+
+// AdminOrderResponse is the full order for the admin, with its status history and
+// the statuses it can move to next
+type AdminOrderResponse struct {
+	OrderResponse
+	CustomerID         *string                    `json:"customer_id,omitempty"`
+	ShippingCourier    string                     `json:"shipping_courier"`
+	ShippingService    string                     `json:"shipping_service"`
+	AllowedTransitions []string                   `json:"allowed_transitions"`
+	StatusHistory      []entity.OrderStatusChange `json:"status_history"`
+}
+
 type PaymentResponse struct {
 	ID            string               `json:"id"`
 	OrderID       string               `json:"order_id"`
@@ -1,5 +1,11 @@
 package response
 
+import (
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
 type ProvinceResponse struct {
 	ProvinceID string `json:"province_id"`
 	Province   string `json:"province"`
@@ -12,10 +18,30 @@ type CityResponse struct {
 }
 
 type ShippingCostResponse struct {
-	Service     string  `json:"service"`
-	Description string  `json:"description"`
-	Cost        float64 `json:"cost"`
-	ETD         string  `json:"etd"`
+	Courier      string                 `json:"courier"`
+	CourierName  string                 `json:"courier_name"`
+	Service      string                 `json:"service"`
+	Description  string                 `json:"description"`
+	Cost         float64                `json:"cost"`          // What the customer pays, after shipping rules
+	OriginalCost float64                `json:"original_cost"` // What the courier quoted
+	AppliedRule  *AppliedShippingRule   `json:"applied_rule,omitempty"`
+	ETD          string                 `json:"etd"`
+	Shipments    []ShipmentCostResponse `json:"shipments,omitempty"` // Parcels the cart is split into when no single warehouse holds it all
+}
+
+// ShipmentCostResponse is the courier cost of one warehouse's parcel
+type ShipmentCostResponse struct {
+	WarehouseID   string  `json:"warehouse_id"`
+	WarehouseName string  `json:"warehouse_name"`
+	Origin        string  `json:"origin"`
+	Cost          float64 `json:"cost"`
+}
+
+// AppliedShippingRule names the shipping rule that changed a quote's cost
+type AppliedShippingRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
 }
 
 // ValidateAWBResponse represents the response for AWB validation
@@ -28,6 +54,23 @@ type ValidateAWBResponse struct {
 	Message       string `json:"message"`
 }
 
+// ShipmentTrackingResponse represents the shipments of an order as shown to the customer
+type ShipmentTrackingResponse struct {
+	OrderNumber string             `json:"order_number"`
+	OrderStatus string             `json:"order_status"`
+	Shipments   []ShipmentResponse `json:"shipments"`
+}
+
+// ShipmentResponse represents the tracking of one AWB
+type ShipmentResponse struct {
+	Courier        string                `json:"courier"`
+	AWBNumber      string                `json:"awb_number"`
+	Delivered      bool                  `json:"delivered"`
+	DeliveryStatus entity.DeliveryStatus `json:"delivery_status"`
+	Manifest       []entity.Manifest     `json:"manifest"`
+	LastCheckedAt  *time.Time            `json:"last_checked_at,omitempty"` // When the courier was last asked; nil if only checked at validation
+}
+
 // RajaOngkirTrackingResponse represents the response from RajaOngkir tracking API
 type RajaOngkirTrackingResponse struct {
 	Meta RajaOngkirTrackingMeta `json:"meta"`
@@ -19,6 +19,42 @@ Terima kasih,
 Tim iQibla Indonesia
 `
 
+const WAShipmentDispatchedTemplate = `
+Halo {{.CustomerName}},
+
+Pesanan Anda #{{.OrderNumber}} sudah dikirim!
+
+Kurir: {{.Courier}}
+Nomor Resi: {{.AWBNumber}}
+
+Anda dapat melacak pengiriman pesanan Anda melalui link berikut:
+
+{{.TrackingLink}}
+
+Apabila ada pertanyaan lebih lanjut, silakan hubungi kami.
+
+Terima kasih,
+Tim iQibla Indonesia
+`
+
+const WAShipmentDeliveredTemplate = `
+Halo {{.CustomerName}},
+
+Pesanan Anda #{{.OrderNumber}} telah sampai di tujuan.
+
+Kurir: {{.Courier}}
+Nomor Resi: {{.AWBNumber}}
+
+Detail pengiriman dapat dilihat melalui link berikut:
+
+{{.TrackingLink}}
+
+Apabila pesanan belum Anda terima atau ada pertanyaan lebih lanjut, silakan hubungi kami.
+
+Terima kasih telah berbelanja di iQibla Indonesia!
+Tim iQibla Indonesia
+`
+
 const TelegramTemplate = `
 *📦 New Order Confirmation!*
 
@@ -41,3 +77,12 @@ A new order has been paid and confirmed.
 *Shipping Courier:* ` + "`{{.ShippingCourier}}`" + `
 *Shipping Service:* ` + "`{{.ShippingService}}`" + `
 `
+
+const LowStockTelegramTemplate = `
+*⚠️ Low Stock Alert*
+
+{{range .}}
+- ` + "`{{.SKU}}`" + ` {{.ProductName}} - {{.VariantName}}: ` + "`{{.StockQuantity}}`" + ` left (threshold ` + "`{{.LowStockThreshold}}`" + `)
+{{end}}
+No further alerts for these variants until they are restocked above their threshold.
+`
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// AdminUserRepository defines the interface for admin account operations
+type AdminUserRepository interface {
+	// CreateAdminUser returns ErrDuplicateAdminEmail when the email is already used
+	CreateAdminUser(user *entity.AdminUser) error
+	// FindAdminUserByID and FindAdminUserByEmail return nil, nil when no admin matches
+	FindAdminUserByID(id string) (*entity.AdminUser, error)
+	FindAdminUserByEmail(email string) (*entity.AdminUser, error)
+	// FindAdminUsers returns every admin, oldest first
+	FindAdminUsers() ([]entity.AdminUser, error)
+	UpdateAdminUser(user *entity.AdminUser) error
+	UpdateAdminLastLogin(id string, at time.Time) error
+	CountAdminUsers() (int64, error)
+	CountActiveAdminsByRole(role string) (int64, error)
+}
+
+// AuditLogFilter narrows the audit log. Zero values match everything.
+type AuditLogFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+}
+
+// AuditLogRepository stores the record of admin actions
+type AuditLogRepository interface {
+	CreateAuditLog(log *entity.AdminAuditLog) error
+	// FindAuditLogs returns a page of matching entries, newest first, and the total number of matches
+	FindAuditLogs(filter AuditLogFilter, offset, limit int) ([]entity.AdminAuditLog, int64, error)
+}
+
+// ErrDuplicateAdminEmail is returned when an admin is created with an email that is already used
+var ErrDuplicateAdminEmail = errors.New("admin email already used")
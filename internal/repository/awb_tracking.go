@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 )
@@ -26,8 +29,23 @@ type AWBTrackingRepository interface {
 
 	// GetOrderByInvoiceNumber retrieves order by invoice number to validate it exists
 	GetOrderByInvoiceNumber(invoiceNumber string) (*entity.Order, error)
+
+	// FindAWBTrackingsDueForRefresh retrieves validated, undelivered AWB tracking records
+	// created after createdAfter whose next check is due at now, longest waiting first
+	FindAWBTrackingsDueForRefresh(now, createdAfter time.Time, limit int) ([]*entity.AWBTracking, error)
+
+	// ClaimWebhookEvent records the webhook event before it is applied. It returns
+	// ErrWebhookEventSeen when the event was already recorded.
+	ClaimWebhookEvent(event *entity.ShippingWebhookEvent) error
+
+	// ReleaseWebhookEvent deletes a claimed webhook event whose update could not be saved,
+	// so the provider's retry of the call is applied
+	ReleaseWebhookEvent(eventID string) error
 }
 
+// ErrWebhookEventSeen is returned when a tracking webhook call was already claimed
+var ErrWebhookEventSeen = errors.New("webhook event already received")
+
 // AWBTrackingError represents errors from the AWB tracking repository
 type AWBTrackingError struct {
 	Operation string // Operation that failed
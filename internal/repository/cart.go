@@ -6,6 +6,17 @@ type CartRepository interface {
 	// Cart operations
 	FindCartByID(cartID string) (*entity.Cart, error)
 	CreateCart(cart *entity.Cart) error
+	// UpdateCartDiscount stores the applied discount code on the cart; nil clears it
+	UpdateCartDiscount(cartID string, discountCode *string) error
+	// FindActiveCartByCustomerID returns the customer's active cart with items, or nil, nil when there is none
+	FindActiveCartByCustomerID(customerID string) (*entity.Cart, error)
+	// AssignCartToCustomer gives an active guest cart to a customer and reports whether
+	// it did; it does nothing when the cart already belongs to someone
+	AssignCartToCustomer(cartID, customerID string) (bool, error)
+	// MergeCartItems deactivates an active guest cart and saves the merged items into the
+	// customer's cart in one transaction. It reports false, without saving anything,
+	// when the guest cart was already merged or converted.
+	MergeCartItems(guestCartID, customerCartID string, items []entity.CartItem) (bool, error)
 
 	// Cart item operations
 	CreateCartItem(item *entity.CartItem) error
@@ -1,7 +1,26 @@
 package repository
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
 
 type CategoryRepository interface {
 	GetCategoryBySlug(slug string) (*entity.Category, error)
+	// FindCategories returns categories sorted by display order, then name
+	FindCategories(includeInactive bool) ([]entity.Category, error)
+	// FindCategoryByID returns nil, nil when the category does not exist
+	FindCategoryByID(id string) (*entity.Category, error)
+	// CreateCategory and UpdateCategory return ErrDuplicateCategory when the name or slug
+	// is already taken. UpdateCategory also copies a renamed slug onto the category's products.
+	CreateCategory(category *entity.Category) error
+	UpdateCategory(category *entity.Category) error
+	// DeleteCategory soft-deletes the category and reports false when there was no such category
+	DeleteCategory(id string) (bool, error)
+	// CountCategoryProducts counts the products assigned to the category, active or not
+	CountCategoryProducts(id string) (int64, error)
 }
+
+// ErrDuplicateCategory is returned when a category is saved with a name or slug another category already uses
+var ErrDuplicateCategory = errors.New("category name or slug already exists")
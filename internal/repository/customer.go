@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// CustomerRepository defines the interface for customer account operations
+type CustomerRepository interface {
+	// Customer operations
+	// CreateCustomer returns ErrDuplicateEmail when the email is already registered
+	CreateCustomer(customer *entity.Customer) error
+	// FindCustomerByID and FindCustomerByEmail return nil, nil when no customer matches
+	FindCustomerByID(customerID string) (*entity.Customer, error)
+	FindCustomerByEmail(email string) (*entity.Customer, error)
+	UpdateLastLogin(customerID string, at time.Time) error
+	MarkEmailVerified(customerID string) error
+
+	// Refresh token operations
+	CreateRefreshToken(token *entity.CustomerRefreshToken) error
+	// FindRefreshToken returns nil, nil when no token matches
+	FindRefreshToken(tokenID string) (*entity.CustomerRefreshToken, error)
+	// RevokeRefreshToken revokes a token that is not yet revoked and reports whether it did,
+	// so two concurrent refreshes with the same token cannot both succeed
+	RevokeRefreshToken(tokenID string, at time.Time) (bool, error)
+	RevokeCustomerRefreshTokens(customerID string, at time.Time) error
+
+	// Email verification and password reset token operations
+	// CreateActionToken stores a new token and invalidates the customer's earlier
+	// unused tokens for the same purpose, so only the latest link works
+	CreateActionToken(token *entity.CustomerActionToken) error
+	// ConsumeActionToken marks an unused, unexpired token as used and returns it.
+	// It returns nil, nil when no such token exists, so a token works only once.
+	ConsumeActionToken(purpose, tokenHash string, at time.Time) (*entity.CustomerActionToken, error)
+	// ResetPassword consumes a password reset token, sets the customer's new password and
+	// revokes their refresh tokens in one transaction, so a failure leaves the token usable.
+	// It returns nil, nil when the token cannot be consumed.
+	ResetPassword(tokenHash, passwordHash string, at time.Time) (*entity.CustomerActionToken, error)
+
+	// Address operations. A customer's first address becomes the default, and saving an
+	// address as the default clears the flag on the customer's other addresses.
+	CreateAddress(address *entity.CustomerAddress) error
+	// FindAddressesByCustomerID returns the default address first, then the newest
+	FindAddressesByCustomerID(customerID string) ([]entity.CustomerAddress, error)
+	// FindAddressByID returns nil, nil when the address does not exist or belongs to another customer
+	FindAddressByID(customerID, addressID string) (*entity.CustomerAddress, error)
+	UpdateAddress(address *entity.CustomerAddress) error
+	// DeleteAddress reports false when there was no such address. When the default address
+	// is deleted the customer's newest remaining address becomes the default.
+	DeleteAddress(customerID, addressID string) (bool, error)
+}
+
+// ErrDuplicateEmail is returned when a customer is created with an email that is already registered
+var ErrDuplicateEmail = errors.New("email already registered")
@@ -0,0 +1,62 @@
+package repository
+
+//go:generate mockgen -source=inventory.go -destination=../service/product/mocks/inventory_repository_mock.go -package=mocks
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// InventoryRepository keeps the stock ledger of product variants. Every change to
+// a variant's stock_quantity, or to a warehouse's stock of it, is written together
+// with its movement.
+type InventoryRepository interface {
+	// AdjustStock applies the movement to its variant and records it, returning the new
+	// stock. It returns ErrNegativeStock when the stock would drop below zero.
+	AdjustStock(movement *entity.InventoryMovement) (int, error)
+	// FindInventoryMovements returns a page of the variant's movements, newest first,
+	// and the total number of movements
+	FindInventoryMovements(variantID string, offset, limit int) ([]entity.InventoryMovement, int64, error)
+	// SumInventoryMovements returns the stock the variant's ledger adds up to
+	SumInventoryMovements(variantID string) (int, error)
+	// RebuildStock sets the variant's stock, and what every warehouse holds of it, to the
+	// sum of its ledger and returns the stock before and after
+	RebuildStock(variantID string) (*StockRebuild, error)
+	// ClaimLowStockAlerts marks every given variant whose stock is at or below its
+	// threshold and that has not been alerted yet as alerted, and returns them. A variant
+	// can be claimed again once its stock has risen above the threshold.
+	ClaimLowStockAlerts(variantIDs []string, at time.Time) ([]LowStockVariant, error)
+	// ReleaseLowStockAlerts undoes a claim made at the given time, so the variants are
+	// alerted again on their next stock change. Claims made at another time are kept.
+	ReleaseLowStockAlerts(variantIDs []string, at time.Time) error
+}
+
+// StockRebuild is a variant's stock before and after RebuildStock, and that of every
+// warehouse holding it or recorded in its ledger
+type StockRebuild struct {
+	Before     int
+	After      int
+	Warehouses []WarehouseStockRebuild
+}
+
+// WarehouseStockRebuild is what a warehouse held of the variant before and after RebuildStock
+type WarehouseStockRebuild struct {
+	WarehouseID string
+	Before      int
+	After       int
+}
+
+// LowStockVariant is a variant whose stock has dropped to its low-stock threshold
+type LowStockVariant struct {
+	VariantID         string
+	ProductName       string
+	VariantName       string
+	SKU               string
+	StockQuantity     int
+	LowStockThreshold int
+}
+
+// ErrNegativeStock is returned when a stock movement would leave a variant with less than zero units
+var ErrNegativeStock = errors.New("stock cannot go below zero")
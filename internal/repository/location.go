@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+//go:generate mockgen -source=location.go -destination=../service/shipping/mocks/location_repository_mock.go -package=mocks
+
+// LocationRepository stores the provinces, cities and districts shipping delivers to
+type LocationRepository interface {
+	// FindLocationProvinces returns the stored provinces by name, or only the given one
+	FindLocationProvinces(provinceID string) ([]entity.LocationProvince, error)
+	// FindLocationCities returns the stored cities of the province by name, or only the given city
+	FindLocationCities(provinceID, cityID string) ([]entity.LocationCity, error)
+	// FindLocationDistricts returns the stored districts of the city by name
+	FindLocationDistricts(cityID string) ([]entity.LocationDistrict, error)
+	// FindLocationDistrictProvinceID returns the ID of the province the stored district is in,
+	// or an empty string when the district or its city is not stored
+	FindLocationDistrictProvinceID(districtID string) (string, error)
+
+	// ReplaceLocationProvinces stores the provinces and removes the ones no longer listed.
+	// An empty list changes nothing.
+	ReplaceLocationProvinces(provinces []entity.ShippingProvince) error
+	// ReplaceLocationCities stores the cities of the province, removes the ones no longer
+	// listed and marks the province's cities synced
+	ReplaceLocationCities(provinceID string, cities []entity.ShippingCity) error
+	// ReplaceLocationDistricts stores the districts of the city, removes the ones no longer
+	// listed and marks the city's districts synced
+	ReplaceLocationDistricts(cityID string, districts []entity.ShippingDistrict) error
+
+	// FindProvincesDueForCitySync returns up to limit (-1 for all) provinces whose cities were
+	// never stored or were stored before the given time, never stored first
+	FindProvincesDueForCitySync(before time.Time, limit int) ([]entity.LocationProvince, error)
+	// FindCitiesDueForDistrictSync returns up to limit (-1 for all) cities whose districts were
+	// stored before the given time, oldest first. Cities whose districts were never asked for are left out.
+	FindCitiesDueForDistrictSync(before time.Time, limit int) ([]entity.LocationCity, error)
+}
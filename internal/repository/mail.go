@@ -1,8 +1,20 @@
 package repository
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
 
 type Mailer interface {
 	Send(from, to, subject, body string) error
 	SendOrderConfirmation(order *entity.Order, items []entity.OrderItem) error
+	// SendEmailVerification and SendPasswordReset mail a link carrying the raw token,
+	// which stays valid for validFor
+	SendEmailVerification(customer *entity.Customer, token string, validFor time.Duration) error
+	SendPasswordReset(customer *entity.Customer, token string, validFor time.Duration) error
+	// SendShipmentDispatched and SendShipmentDelivered tell the customer about the order's
+	// shipment, with the courier, AWB number and a link to track it
+	SendShipmentDispatched(order *entity.Order, tracking *entity.AWBTracking) error
+	SendShipmentDelivered(order *entity.Order, tracking *entity.AWBTracking) error
 }
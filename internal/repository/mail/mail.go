@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	htmltemplate "html/template"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
@@ -61,16 +64,9 @@ func (m *Mailer) SendOrderConfirmation(order *entity.Order, items []entity.Order
 	}
 
 	// Load and execute HTML template
-	tplPath := resolveTemplatePath()
-	tpl, err := htmltemplate.New("mail").ParseFiles(tplPath)
+	body, err := renderTemplate("mail2.html", data)
 	if err != nil {
-		return fmt.Errorf("failed to parse email template: %w", err)
-	}
-
-	var buf bytes.Buffer
-	// Execute template by name (file base name)
-	if err := tpl.ExecuteTemplate(&buf, filepath.Base(tplPath), data); err != nil {
-		return fmt.Errorf("failed to execute email template: %w", err)
+		return err
 	}
 
 	// Determine sender from config or env
@@ -78,20 +74,100 @@ func (m *Mailer) SendOrderConfirmation(order *entity.Order, items []entity.Order
 	subject := fmt.Sprintf("Order Confirmation #%s", order.OrderNumber)
 
 	fmt.Println("from:", from)
-	return m.Send(from, order.CustomerEmail, subject, buf.String())
+	return m.Send(from, order.CustomerEmail, subject, body)
+}
+
+// SendEmailVerification sends the link that confirms the customer owns their email address
+func (m *Mailer) SendEmailVerification(customer *entity.Customer, token string, validFor time.Duration) error {
+	return m.sendAccountEmail(customer, "verify_email.html", "Verify your email address",
+		buildTokenLink("verify-email", token), validFor)
+}
+
+// SendPasswordReset sends the link that lets the customer choose a new password
+func (m *Mailer) SendPasswordReset(customer *entity.Customer, token string, validFor time.Duration) error {
+	return m.sendAccountEmail(customer, "reset_password.html", "Reset your password",
+		buildTokenLink("reset-password", token), validFor)
+}
+
+func (m *Mailer) sendAccountEmail(customer *entity.Customer, templateName, subject, link string, validFor time.Duration) error {
+	if customer == nil {
+		return fmt.Errorf("customer is nil")
+	}
+	if customer.Email == "" {
+		return fmt.Errorf("customer email is empty")
+	}
+
+	body, err := renderTemplate(templateName, request.AccountEmailData{
+		CustomerName: customer.FirstName,
+		ActionLink:   link,
+		ValidFor:     formatValidity(validFor),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.Send(getSMTPFrom(), customer.Email, subject, body)
+}
+
+// SendShipmentDispatched tells the customer their order has been handed to the courier
+func (m *Mailer) SendShipmentDispatched(order *entity.Order, tracking *entity.AWBTracking) error {
+	return m.sendShipmentEmail(order, tracking, "shipment_dispatched.html", "Your order #%s has been shipped")
+}
+
+// SendShipmentDelivered tells the customer the courier has delivered their order
+func (m *Mailer) SendShipmentDelivered(order *entity.Order, tracking *entity.AWBTracking) error {
+	return m.sendShipmentEmail(order, tracking, "shipment_delivered.html", "Your order #%s has been delivered")
+}
+
+func (m *Mailer) sendShipmentEmail(order *entity.Order, tracking *entity.AWBTracking, templateName, subjectFormat string) error {
+	if order == nil || tracking == nil {
+		return fmt.Errorf("order or tracking is nil")
+	}
+	if order.CustomerEmail == "" {
+		return fmt.Errorf("customer email is empty")
+	}
+
+	body, err := renderTemplate(templateName, request.ShipmentEmailData{
+		CustomerName: order.CustomerName,
+		OrderNumber:  order.OrderNumber,
+		Courier:      strings.ToUpper(tracking.Courier),
+		AWBNumber:    tracking.AWBNumber,
+		TrackingLink: entity.TrackingLink(getBaseURL(), order.OrderNumber),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.Send(getSMTPFrom(), order.CustomerEmail, fmt.Sprintf(subjectFormat, order.OrderNumber), body)
+}
+
+// renderTemplate executes the named HTML template from the static directory
+func renderTemplate(name string, data interface{}) (string, error) {
+	tplPath := resolveTemplatePath(name)
+	tpl, err := htmltemplate.New("mail").ParseFiles(tplPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	// Execute template by name (file base name)
+	if err := tpl.ExecuteTemplate(&buf, filepath.Base(tplPath), data); err != nil {
+		return "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+	return buf.String(), nil
 }
 
-// resolveTemplatePath returns absolute path to mail.html template
-func resolveTemplatePath() string {
+// resolveTemplatePath returns absolute path to the named email template
+func resolveTemplatePath(name string) string {
 	// Prefer absolute path relative to project root when running in repo
-	defaultPath := filepath.Join("internal", "model", "static", "mail2.html")
+	defaultPath := filepath.Join("internal", "model", "static", name)
 	if _, err := os.Stat(defaultPath); err == nil {
 		return defaultPath
 	}
 	// Try with working dir adjustments
 	wd, err := os.Getwd()
 	if err == nil {
-		p := filepath.Join(wd, "internal", "model", "static", "mail2.html")
+		p := filepath.Join(wd, "internal", "model", "static", name)
 		if _, err := os.Stat(p); err == nil {
 			return p
 		}
@@ -102,16 +178,36 @@ func resolveTemplatePath() string {
 
 // buildOrderLink creates a link for the order confirmation page
 func buildOrderLink(orderID string) string {
+	// Assuming frontend route to view order details
+	return fmt.Sprintf("%s/order-confirmation/%s", getBaseURL(), orderID)
+}
+
+// buildTokenLink creates a link to the frontend page that submits the token
+func buildTokenLink(path, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", getBaseURL(), path, url.QueryEscape(token))
+}
+
+// formatValidity renders a token lifetime such as "24 hours" or "30 minutes"
+func formatValidity(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return pluralize(int(d/time.Hour), "hour")
+	}
+	return pluralize(int(d.Round(time.Minute)/time.Minute), "minute")
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func getBaseURL() string {
 	cfg, err := config.GetConfig()
-	base := ""
 	if err == nil && cfg != nil && cfg.BaseURL != "" {
-		base = cfg.BaseURL
-	}
-	if base == "" {
-		base = "http://localhost:8080"
+		return cfg.BaseURL
 	}
-	// Assuming frontend route to view order details
-	return fmt.Sprintf("%s/order-confirmation/%s", base, orderID)
+	return "http://localhost:8080"
 }
 
 func getSMTPFrom() string {
@@ -0,0 +1,18 @@
+-- Migration: Create payment notification audits table
+-- Purpose: Record payment notifications rejected for a bad signature or amount
+
+CREATE TABLE IF NOT EXISTS payment_notification_audits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id VARCHAR(100),
+    transaction_id VARCHAR(100),
+    transaction_status VARCHAR(50),
+    status_code VARCHAR(10),
+    gross_amount VARCHAR(50),
+    reason VARCHAR(50) NOT NULL,
+    payload JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for performance
+CREATE INDEX IF NOT EXISTS idx_payment_notification_audits_order_id ON payment_notification_audits(order_id);
+CREATE INDEX IF NOT EXISTS idx_payment_notification_audits_created_at ON payment_notification_audits(created_at);
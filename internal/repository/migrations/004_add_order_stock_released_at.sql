@@ -0,0 +1,11 @@
+-- Migration: Add stock_released_at to orders
+-- Purpose: Release reserved stock at most once when an order is cancelled or its payment expires
+
+-- Orders created before stock reservation never took stock, so there is nothing to give back.
+-- The default fills only the rows that exist when the column is added; running this again
+-- leaves live orders alone.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS stock_released_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE orders ALTER COLUMN stock_released_at DROP DEFAULT;
+
+-- Index for the payment expiry sweep
+CREATE INDEX IF NOT EXISTS idx_payments_status_expiry_time ON payments(status, expiry_time);
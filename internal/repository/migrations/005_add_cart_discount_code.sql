@@ -0,0 +1,4 @@
+-- Migration: Add discount_code to carts
+-- Purpose: Remember the discount applied to a cart between requests
+
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS discount_code VARCHAR(50);
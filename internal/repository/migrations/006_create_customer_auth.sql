@@ -0,0 +1,32 @@
+-- Migration: Create customers and customer refresh tokens tables
+-- Purpose: Customer accounts with password login and revocable refresh tokens
+
+CREATE TABLE IF NOT EXISTS customers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    first_name VARCHAR(255),
+    last_name VARCHAR(255),
+    email VARCHAR(255),
+    phone_number VARCHAR(50),
+    password_hash VARCHAR(255),
+    salt VARCHAR(255),
+    address_details JSONB,
+    last_login_at TIMESTAMP,
+    is_email_verified BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_email ON customers(email);
+CREATE INDEX IF NOT EXISTS idx_customers_deleted_at ON customers(deleted_at);
+
+CREATE TABLE IF NOT EXISTS customer_refresh_tokens (
+    id UUID PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customers(id),
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_refresh_tokens_customer_id ON customer_refresh_tokens(customer_id);
@@ -0,0 +1,15 @@
+-- Migration: Create customer action tokens table
+-- Purpose: Single-use, expiring tokens for email verification and password reset
+
+CREATE TABLE IF NOT EXISTS customer_action_tokens (
+    id UUID PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customers(id),
+    purpose VARCHAR(32) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customer_action_tokens_token_hash ON customer_action_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_customer_action_tokens_customer_id ON customer_action_tokens(customer_id);
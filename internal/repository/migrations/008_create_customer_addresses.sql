@@ -0,0 +1,29 @@
+-- Migration: Create customer addresses table
+-- Purpose: Saved shipping addresses with RajaOngkir location IDs and one default per customer
+
+CREATE TABLE IF NOT EXISTS customer_addresses (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    customer_id UUID NOT NULL REFERENCES customers(id),
+    label VARCHAR(50),
+    recipient_name VARCHAR(255) NOT NULL,
+    phone_number VARCHAR(50) NOT NULL,
+    street_address TEXT NOT NULL,
+    province_id VARCHAR(20) NOT NULL,
+    province_name VARCHAR(100) NOT NULL,
+    city_id VARCHAR(20) NOT NULL,
+    city_name VARCHAR(100) NOT NULL,
+    district_id VARCHAR(20) NOT NULL,
+    district_name VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    is_default BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_addresses_customer_id ON customer_addresses(customer_id);
+CREATE INDEX IF NOT EXISTS idx_customer_addresses_deleted_at ON customer_addresses(deleted_at);
+
+-- Enforce a single default address per customer
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customer_addresses_default
+    ON customer_addresses(customer_id) WHERE is_default AND deleted_at IS NULL;
@@ -0,0 +1,14 @@
+-- Migration: Link products to categories
+-- Purpose: Replace the free-text category match with a foreign key so renaming a category slug keeps its products
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id);
+
+-- Link existing products through the slug they were stored with
+UPDATE products p
+SET category_id = c.id
+FROM categories c
+WHERE p.category_id IS NULL
+  AND p.category = c.slug
+  AND c.deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_category_id ON products(category_id);
@@ -0,0 +1,22 @@
+-- Migration: Create inventory_movements table
+-- Purpose: Record every stock change of a variant so stock can be audited and rebuilt from its history
+
+CREATE TABLE IF NOT EXISTS inventory_movements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_variant_id UUID NOT NULL REFERENCES product_variants(id),
+    reason VARCHAR(32) NOT NULL,
+    quantity_delta INTEGER NOT NULL,
+    reference_type VARCHAR(32) NOT NULL,
+    reference_id VARCHAR(64) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_variant ON inventory_movements(product_variant_id, created_at);
+
+-- Open the ledger of existing variants with their current stock so the ledger sum matches it
+INSERT INTO inventory_movements (product_variant_id, reason, quantity_delta, reference_type, reference_id, note)
+SELECT pv.id, 'adjustment', pv.stock_quantity, 'system', 'opening_balance', 'Opening balance'
+FROM product_variants pv
+WHERE pv.stock_quantity <> 0
+  AND NOT EXISTS (SELECT 1 FROM inventory_movements im WHERE im.product_variant_id = pv.id);
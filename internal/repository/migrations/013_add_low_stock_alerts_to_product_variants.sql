@@ -0,0 +1,5 @@
+-- Migration: Add low-stock alert columns to product_variants
+-- Purpose: Alert operations on Telegram once per variant when its stock drops to its threshold
+
+ALTER TABLE product_variants ADD COLUMN IF NOT EXISTS low_stock_threshold INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE product_variants ADD COLUMN IF NOT EXISTS low_stock_alerted_at TIMESTAMP WITH TIME ZONE;
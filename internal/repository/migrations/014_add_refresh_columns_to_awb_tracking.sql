@@ -0,0 +1,9 @@
+-- Migration: Add refresh columns to awb_tracking
+-- Purpose: Refresh tracking data in the background with backoff and record when a shipment was delivered
+
+ALTER TABLE awb_tracking ADD COLUMN IF NOT EXISTS check_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE awb_tracking ADD COLUMN IF NOT EXISTS last_checked_at TIMESTAMP;
+ALTER TABLE awb_tracking ADD COLUMN IF NOT EXISTS next_check_at TIMESTAMP;
+ALTER TABLE awb_tracking ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_awb_tracking_next_check_at ON awb_tracking(next_check_at) WHERE delivered_at IS NULL AND deleted_at IS NULL;
@@ -0,0 +1,13 @@
+-- Migration: Create shipping_webhook_events table
+-- Purpose: Remember accepted courier tracking webhook calls so a replayed call is rejected
+
+CREATE TABLE IF NOT EXISTS shipping_webhook_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider VARCHAR(32) NOT NULL,
+    event_id VARCHAR(128) NOT NULL,
+    awb_number VARCHAR(100) NOT NULL,
+    courier VARCHAR(50) NOT NULL,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shipping_webhook_events_provider_event ON shipping_webhook_events(provider, event_id);
@@ -0,0 +1,21 @@
+-- Migration: Create shipping_rules table
+-- Purpose: Let marketing adjust courier quotes with free shipping, flat rates, subsidies and non-shipped SKUs
+
+CREATE TABLE IF NOT EXISTS shipping_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(32) NOT NULL CHECK (type IN ('free_shipping', 'flat_rate', 'subsidy', 'no_shipping')),
+    priority INTEGER NOT NULL DEFAULT 0,
+    courier VARCHAR(50),
+    province_id VARCHAR(20),
+    min_subtotal DECIMAL(12,2) NOT NULL DEFAULT 0,
+    amount INTEGER NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    skus TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_shipping_rules_active ON shipping_rules(is_active, priority) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_shipping_rules_deleted_at ON shipping_rules(deleted_at);
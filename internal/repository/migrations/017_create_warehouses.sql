@@ -0,0 +1,31 @@
+-- Migration: Create warehouses and warehouse_stocks tables and link order items to warehouses
+-- Purpose: Pick the shipping origin from where the stock is instead of trusting the client
+
+CREATE TABLE IF NOT EXISTS warehouses (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(32) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    district_id VARCHAR(20) NOT NULL,
+    province_id VARCHAR(20),
+    priority INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_warehouses_code ON warehouses(code);
+CREATE INDEX IF NOT EXISTS idx_warehouses_deleted_at ON warehouses(deleted_at);
+
+CREATE TABLE IF NOT EXISTS warehouse_stocks (
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    product_variant_id UUID NOT NULL REFERENCES product_variants(id),
+    quantity INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (warehouse_id, product_variant_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_warehouse_stocks_variant ON warehouse_stocks(product_variant_id);
+
+-- NULL for orders placed before warehouses, which shipped from the configured origin
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS warehouse_id UUID REFERENCES warehouses(id);
@@ -0,0 +1,28 @@
+-- Migration: Create location_provinces, location_cities and location_districts tables
+-- Purpose: Serve the places shipping delivers to from the database instead of calling RajaOngkir for every lookup
+
+CREATE TABLE IF NOT EXISTS location_provinces (
+    id VARCHAR(20) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    cities_synced_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS location_cities (
+    id VARCHAR(20) PRIMARY KEY,
+    province_id VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    districts_synced_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_location_cities_province_id ON location_cities(province_id);
+
+CREATE TABLE IF NOT EXISTS location_districts (
+    id VARCHAR(20) PRIMARY KEY,
+    city_id VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    synced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_location_districts_city_id ON location_districts(city_id);
@@ -0,0 +1,21 @@
+-- Migration: Add warehouse_id to inventory_movements
+-- Purpose: Keep warehouse stock in the stock ledger so it can be audited and rebuilt like a variant's stock
+
+-- NULL for stock held outside any warehouse
+ALTER TABLE inventory_movements ADD COLUMN IF NOT EXISTS warehouse_id UUID REFERENCES warehouses(id);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_warehouse_id ON inventory_movements(warehouse_id);
+
+-- Open the ledger of existing warehouse stock. The units already count in the variant's stock,
+-- so each opening balance moves them out of the stock held outside warehouses and the variant's
+-- ledger sum does not change.
+INSERT INTO inventory_movements (product_variant_id, warehouse_id, reason, quantity_delta, reference_type, reference_id, note)
+SELECT ws.product_variant_id, moved.warehouse_id, 'adjustment', moved.quantity_delta, 'system', 'warehouse_opening_balance', 'Warehouse opening balance'
+FROM warehouse_stocks ws
+CROSS JOIN LATERAL (VALUES (ws.warehouse_id, ws.quantity), (NULL::UUID, -ws.quantity)) AS moved(warehouse_id, quantity_delta)
+WHERE ws.quantity <> 0
+  AND NOT EXISTS (
+      SELECT 1 FROM inventory_movements im
+      WHERE im.product_variant_id = ws.product_variant_id
+        AND im.warehouse_id = ws.warehouse_id
+  );
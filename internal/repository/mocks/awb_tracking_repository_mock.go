@@ -4,6 +4,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,24 @@ func (m *AWBTrackingRepositoryMock) GetOrderByInvoiceNumber(invoiceNumber string
 	}
 	return args.Get(0).(*entity.Order), args.Error(1)
 }
+
+// FindAWBTrackingsDueForRefresh is a mock implementation of AWBTrackingRepository.FindAWBTrackingsDueForRefresh
+func (m *AWBTrackingRepositoryMock) FindAWBTrackingsDueForRefresh(now, createdAfter time.Time, limit int) ([]*entity.AWBTracking, error) {
+	args := m.Called(now, createdAfter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AWBTracking), args.Error(1)
+}
+
+// ClaimWebhookEvent is a mock implementation of AWBTrackingRepository.ClaimWebhookEvent
+func (m *AWBTrackingRepositoryMock) ClaimWebhookEvent(event *entity.ShippingWebhookEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// ReleaseWebhookEvent is a mock implementation of AWBTrackingRepository.ReleaseWebhookEvent
+func (m *AWBTrackingRepositoryMock) ReleaseWebhookEvent(eventID string) error {
+	args := m.Called(eventID)
+	return args.Error(0)
+}
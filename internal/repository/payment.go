@@ -1,13 +1,33 @@
 package repository
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
 
 type PaymentRepository interface {
 	// Order operations
 	CreateOrder(order *entity.Order) error
 	FindOrderByID(orderID string) (*entity.Order, error)
-	UpdateOrderStatus(orderID, status string) error
+	// UpdateOrderStatus moves the order from change.FromStatus to change.ToStatus and saves change as
+	// its audit record in the same transaction. It reports false without changing anything when the
+	// order is no longer in change.FromStatus, so two concurrent changes cannot both apply.
+	// The order's reserved stock is released when it is cancelled, or refunded before it shipped.
+	UpdateOrderStatus(change *entity.OrderStatusChange) (bool, error)
+	// FindOrderStatusChanges returns the order's status changes, oldest first
+	FindOrderStatusChanges(orderID string) ([]entity.OrderStatusChange, error)
 	GetOrderWithItems(orderID string) (*entity.Order, error)
+	// FindOrderByNumber returns the order with its items, or nil, nil when no order has that number
+	FindOrderByNumber(orderNumber string) (*entity.Order, error)
+	// FindOrdersByCustomerID returns a page of the customer's orders with their items, newest first,
+	// and the total number of matching orders. An empty statuses slice matches every status.
+	FindOrdersByCustomerID(customerID string, statuses []string, offset, limit int) ([]entity.Order, int64, error)
+	// FindOrders returns a page of the orders matching the filter with their items, newest first,
+	// and the total number of matching orders
+	FindOrders(filter OrderFilter, offset, limit int) ([]entity.Order, int64, error)
 	GetSeq() (int64, error)
 
 	// Order item operations
@@ -20,8 +40,53 @@ type PaymentRepository interface {
 	FindPaymentByTransactionID(transactionID string) (*entity.Payment, error)
 	UpdatePaymentStatus(paymentID string, status entity.PaymentStatus) error
 	UpdatePayment(payment *entity.Payment) error
+	FindExpiredPendingPayments(before time.Time, limit int) ([]entity.Payment, error)
+	// ExpirePayment marks a still-pending payment expired. When its order is still pending too,
+	// the order is cancelled and its stock released; an order that moved on keeps its stock.
+	// It reports false when the payment was no longer pending, so it is safe to call repeatedly.
+	ExpirePayment(paymentID string) (bool, error)
 
 	// Transaction operations
+	// CreateOrderWithItems locks the ordered variants, decrements their stock and saves the order.
+	// It returns *InsufficientStockError when any variant, or the warehouse an item ships from,
+	// cannot cover the ordered quantity.
+	// When the order has a discount code applied, one use of it is claimed in the same transaction
+	// and ErrDiscountUsageLimitReached is returned if none is left.
 	CreateOrderWithItems(order *entity.Order, items []entity.OrderItem) error
+	// UpdatePaymentAndOrderStatus releases the order's reserved stock when orderStatus is cancelled.
+	// Stock is released at most once per order. A cancelled or refunded order keeps its status,
+	// except that a cancelled order being paid reserves its stock again and moves to processing.
+	// When that is not possible the payment is still saved and ErrPaidOrderClosed is returned.
 	UpdatePaymentAndOrderStatus(payment *entity.Payment, orderID, orderStatus string) error
+
+	// Audit operations
+	CreateNotificationAudit(audit *entity.PaymentNotificationAudit) error
+}
+
+// OrderFilter narrows the orders listed for the admin. Zero values match every order.
+type OrderFilter struct {
+	Statuses      []string
+	CreatedFrom   *time.Time // Inclusive
+	CreatedBefore *time.Time // Exclusive
+	Courier       string
+	SourceChannel string
+	// Search matches part of the order number, customer name, email or phone, ignoring case
+	Search string
+}
+
+// ErrDiscountUsageLimitReached is returned when a discount has no uses left at the time an order claims one
+var ErrDiscountUsageLimitReached = errors.New("discount usage limit reached")
+
+// ErrPaidOrderClosed is returned when a payment succeeds for an order that was refunded, or
+// cancelled with its stock sold since, so the order needs an admin to refund or fulfil it
+var ErrPaidOrderClosed = errors.New("order was paid after it was closed")
+
+// InsufficientStockError is returned when an order asks for more units than are in stock
+type InsufficientStockError struct {
+	SKUs []string // SKUs (or variant IDs when the variant no longer exists) that are short
+}
+
+// Error returns the string representation of the error
+func (e *InsufficientStockError) Error() string {
+	return "insufficient stock for: " + strings.Join(e.SKUs, ", ")
 }
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Admin user operations
+func (r *RepoDatabase) CreateAdminUser(user *entity.AdminUser) error {
+	err := r.DB.Create(user).Error
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateAdminEmail
+	}
+	return err
+}
+
+func (r *RepoDatabase) FindAdminUserByID(id string) (*entity.AdminUser, error) {
+	var user entity.AdminUser
+	if err := r.DB.Where("id = ?", id).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *RepoDatabase) FindAdminUserByEmail(email string) (*entity.AdminUser, error) {
+	var user entity.AdminUser
+	if err := r.DB.Where("email = ?", strings.ToLower(email)).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *RepoDatabase) FindAdminUsers() ([]entity.AdminUser, error) {
+	var users []entity.AdminUser
+	if err := r.DB.Order("created_at ASC").Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *RepoDatabase) UpdateAdminUser(user *entity.AdminUser) error {
+	return r.DB.Model(user).
+		Select("name", "password_hash", "role", "is_active", "updated_at").
+		Updates(user).Error
+}
+
+func (r *RepoDatabase) UpdateAdminLastLogin(id string, at time.Time) error {
+	return r.DB.Model(&entity.AdminUser{}).Where("id = ?", id).Update("last_login_at", at).Error
+}
+
+func (r *RepoDatabase) CountAdminUsers() (int64, error) {
+	var count int64
+	err := r.DB.Model(&entity.AdminUser{}).Count(&count).Error
+	return count, err
+}
+
+func (r *RepoDatabase) CountActiveAdminsByRole(role string) (int64, error) {
+	var count int64
+	err := r.DB.Model(&entity.AdminUser{}).Where("role = ? AND is_active = ?", role, true).Count(&count).Error
+	return count, err
+}
+
+// Audit log operations
+func (r *RepoDatabase) CreateAuditLog(log *entity.AdminAuditLog) error {
+	return r.DB.Create(log).Error
+}
+
+func (r *RepoDatabase) FindAuditLogs(logFilter repository.AuditLogFilter, offset, limit int) ([]entity.AdminAuditLog, int64, error) {
+	filter := func(db *gorm.DB) *gorm.DB {
+		if logFilter.ActorID != "" {
+			db = db.Where("actor_id = ?", logFilter.ActorID)
+		}
+		if logFilter.Action != "" {
+			db = db.Where("action = ?", logFilter.Action)
+		}
+		if logFilter.TargetType != "" {
+			db = db.Where("target_type = ?", logFilter.TargetType)
+		}
+		if logFilter.TargetID != "" {
+			db = db.Where("target_id = ?", logFilter.TargetID)
+		}
+		return db
+	}
+
+	var total int64
+	if err := r.DB.Model(&entity.AdminAuditLog{}).Scopes(filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []entity.AdminAuditLog
+	if err := r.DB.Scopes(filter).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
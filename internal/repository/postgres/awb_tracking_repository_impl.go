@@ -2,11 +2,13 @@ package postgres
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // AWBTrackingRepositoryImpl implements the AWBTrackingRepository interface
@@ -100,3 +102,51 @@ func (r *AWBTrackingRepositoryImpl) GetOrderByInvoiceNumber(invoiceNumber string
 	}
 	return &order, nil
 }
+
+// FindAWBTrackingsDueForRefresh retrieves AWB tracking records the refresher should check now
+func (r *AWBTrackingRepositoryImpl) FindAWBTrackingsDueForRefresh(now, createdAfter time.Time, limit int) ([]*entity.AWBTracking, error) {
+	var awbTrackings []*entity.AWBTracking
+	err := r.db.Where("deleted_at IS NULL AND is_validated = ? AND delivered_at IS NULL", true).
+		Where("created_at > ?", createdAfter).
+		Where("next_check_at IS NULL OR next_check_at <= ?", now).
+		Order("next_check_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&awbTrackings).Error
+	if err != nil {
+		return nil, &repository.AWBTrackingError{
+			Operation: "FindAWBTrackingsDueForRefresh",
+			Err:       err,
+		}
+	}
+	return awbTrackings, nil
+}
+
+// ClaimWebhookEvent records the webhook event unless it was recorded before
+func (r *AWBTrackingRepositoryImpl) ClaimWebhookEvent(event *entity.ShippingWebhookEvent) error {
+	// The unique index on provider and event ID decides which of two racing replays wins
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if result.Error != nil {
+		return &repository.AWBTrackingError{
+			Operation: "ClaimWebhookEvent",
+			Err:       result.Error,
+		}
+	}
+	if result.RowsAffected == 0 {
+		return &repository.AWBTrackingError{
+			Operation: "ClaimWebhookEvent",
+			Err:       repository.ErrWebhookEventSeen,
+		}
+	}
+	return nil
+}
+
+// ReleaseWebhookEvent deletes a claimed webhook event
+func (r *AWBTrackingRepositoryImpl) ReleaseWebhookEvent(eventID string) error {
+	if err := r.db.Where("id = ?", eventID).Delete(&entity.ShippingWebhookEvent{}).Error; err != nil {
+		return &repository.AWBTrackingError{
+			Operation: "ReleaseWebhookEvent",
+			Err:       err,
+		}
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package postgres
 
 import (
+	"errors"
+
 	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"gorm.io/gorm"
 )
 
 func (r *RepoDatabase) FindCartByID(cartID string) (*entity.Cart, error) {
@@ -17,6 +20,75 @@ func (r *RepoDatabase) CreateCart(cart *entity.Cart) error {
 	return r.DB.Create(cart).Error
 }
 
+func (r *RepoDatabase) UpdateCartDiscount(cartID string, discountCode *string) error {
+	return r.DB.Model(&entity.Cart{}).Where("id = ?", cartID).Update("discount_code", discountCode).Error
+}
+
+func (r *RepoDatabase) FindActiveCartByCustomerID(customerID string) (*entity.Cart, error) {
+	var cart entity.Cart
+	result := r.DB.
+		Preload("CartItems").
+		Preload("CartItems.ProductVariant").
+		Where("customer_id = ? AND is_active = true", customerID).
+		Order("updated_at DESC").
+		First(&cart)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &cart, nil
+}
+
+func (r *RepoDatabase) AssignCartToCustomer(cartID, customerID string) (bool, error) {
+	result := r.DB.Model(&entity.Cart{}).
+		Where("id = ? AND customer_id IS NULL AND is_active = true", cartID).
+		Update("customer_id", customerID)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *RepoDatabase) MergeCartItems(guestCartID, customerCartID string, items []entity.CartItem) (bool, error) {
+	merged := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		// Claim the guest cart first so two logins with the same cart cannot both merge it
+		result := tx.Model(&entity.Cart{}).
+			Where("id = ? AND customer_id IS NULL AND is_active = true", guestCartID).
+			Update("is_active", false)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		for i := range items {
+			item := items[i]
+			item.CartID = customerCartID
+			item.ProductVariant = nil
+			if err := tx.Save(&item).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&entity.Cart{}).Where("id = ?", customerCartID).Update("updated_at", gorm.Expr("NOW()")).Error; err != nil {
+			return err
+		}
+
+		merged = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return merged, nil
+}
+
 func (r *RepoDatabase) CreateCartItem(item *entity.CartItem) error {
 	return r.DB.Create(item).Error
 }
@@ -58,7 +130,11 @@ func (r *RepoDatabase) GetDiscountByCode(code string) (*entity.Discount, error)
 
 func (r *RepoDatabase) GetProductVariantByID(variantID string) (*entity.ProductVariant, error) {
 	var variant entity.ProductVariant
-	result := r.DB.Where("id = ? AND is_active = true", variantID).First(&variant)
+	// Variants of a deactivated product cannot be bought either
+	result := r.DB.
+		Joins("JOIN products ON products.id = product_variants.product_id AND products.is_active = true").
+		Where("product_variants.id = ? AND product_variants.is_active = true", variantID).
+		First(&variant)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
 	"gorm.io/gorm"
 )
 
@@ -21,3 +22,77 @@ func (repo *RepoDatabase) GetCategoryBySlug(slug string) (*entity.Category, erro
 
 	return &category, nil
 }
+
+func (repo *RepoDatabase) FindCategories(includeInactive bool) ([]entity.Category, error) {
+	var categories []entity.Category
+
+	query := repo.DB.Order("display_order ASC").Order("name ASC")
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch categories: %v", err)
+	}
+
+	return categories, nil
+}
+
+func (repo *RepoDatabase) FindCategoryByID(id string) (*entity.Category, error) {
+	var category entity.Category
+
+	result := repo.DB.Where("id = ?", id).First(&category)
+
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch category: %v", result.Error)
+	}
+
+	return &category, nil
+}
+
+func (repo *RepoDatabase) CreateCategory(category *entity.Category) error {
+	err := repo.DB.Create(category).Error
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateCategory
+	}
+	return err
+}
+
+func (repo *RepoDatabase) UpdateCategory(category *entity.Category) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(category).
+			Select("name", "slug", "hero_headline", "hero_subheadline", "hero_image_url",
+				"section3_headline", "section3_subheadline", "section3_image_url",
+				"meta_title", "meta_description", "display_order", "is_active", "updated_at").
+			Updates(category).Error
+		if err != nil {
+			return err
+		}
+
+		// Products are found through category_id; the slug copy is only kept for clients reading product.category
+		return tx.Model(&entity.Product{}).
+			Where("category_id = ? AND category <> ?", category.ID, category.Slug).
+			Update("category", category.Slug).Error
+	})
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateCategory
+	}
+	return err
+}
+
+func (repo *RepoDatabase) DeleteCategory(id string) (bool, error) {
+	result := repo.DB.Where("id = ?", id).Delete(&entity.Category{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *RepoDatabase) CountCategoryProducts(id string) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&entity.Product{}).Where("category_id = ?", id).Count(&count).Error
+	return count, err
+}
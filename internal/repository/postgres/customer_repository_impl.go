@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Customer operations
+func (r *RepoDatabase) CreateCustomer(customer *entity.Customer) error {
+	err := r.DB.Create(customer).Error
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateEmail
+	}
+	return err
+}
+
+func (r *RepoDatabase) FindCustomerByID(customerID string) (*entity.Customer, error) {
+	var customer entity.Customer
+	if err := r.DB.Where("id = ?", customerID).First(&customer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *RepoDatabase) FindCustomerByEmail(email string) (*entity.Customer, error) {
+	var customer entity.Customer
+	if err := r.DB.Where("email = ?", strings.ToLower(email)).First(&customer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *RepoDatabase) UpdateLastLogin(customerID string, at time.Time) error {
+	return r.DB.Model(&entity.Customer{}).Where("id = ?", customerID).Update("last_login_at", at).Error
+}
+
+func (r *RepoDatabase) MarkEmailVerified(customerID string) error {
+	return r.DB.Model(&entity.Customer{}).Where("id = ?", customerID).Update("is_email_verified", true).Error
+}
+
+// Refresh token operations
+func (r *RepoDatabase) CreateRefreshToken(token *entity.CustomerRefreshToken) error {
+	return r.DB.Create(token).Error
+}
+
+func (r *RepoDatabase) FindRefreshToken(tokenID string) (*entity.CustomerRefreshToken, error) {
+	var token entity.CustomerRefreshToken
+	if err := r.DB.Where("id = ?", tokenID).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *RepoDatabase) RevokeRefreshToken(tokenID string, at time.Time) (bool, error) {
+	result := r.DB.Model(&entity.CustomerRefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", tokenID).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *RepoDatabase) RevokeCustomerRefreshTokens(customerID string, at time.Time) error {
+	return r.DB.Model(&entity.CustomerRefreshToken{}).
+		Where("customer_id = ? AND revoked_at IS NULL", customerID).
+		Update("revoked_at", at).Error
+}
+
+// Email verification and password reset token operations
+func (r *RepoDatabase) CreateActionToken(token *entity.CustomerActionToken) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.CustomerActionToken{}).
+			Where("customer_id = ? AND purpose = ? AND used_at IS NULL", token.CustomerID, token.Purpose).
+			Update("used_at", token.CreatedAt).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+func (r *RepoDatabase) ConsumeActionToken(purpose, tokenHash string, at time.Time) (*entity.CustomerActionToken, error) {
+	var token *entity.CustomerActionToken
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		token, err = consumeActionToken(tx, purpose, tokenHash, at)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *RepoDatabase) ResetPassword(tokenHash, passwordHash string, at time.Time) (*entity.CustomerActionToken, error) {
+	var token *entity.CustomerActionToken
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		token, err = consumeActionToken(tx, entity.CustomerTokenPasswordReset, tokenHash, at)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&entity.Customer{}).Where("id = ?", token.CustomerID).
+			Update("password_hash", passwordHash).Error; err != nil {
+			return err
+		}
+		// Whoever knew the old password may still hold a session
+		return tx.Model(&entity.CustomerRefreshToken{}).
+			Where("customer_id = ? AND revoked_at IS NULL", token.CustomerID).
+			Update("revoked_at", at).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// consumeActionToken claims the token inside tx. It returns gorm.ErrRecordNotFound when
+// the token is unknown, used or expired.
+func consumeActionToken(tx *gorm.DB, purpose, tokenHash string, at time.Time) (*entity.CustomerActionToken, error) {
+	// The conditional update claims the token, so concurrent requests cannot both use it
+	result := tx.Model(&entity.CustomerActionToken{}).
+		Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, purpose, at).
+		Update("used_at", at)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	var token entity.CustomerActionToken
+	if err := tx.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Address operations
+func (r *RepoDatabase) CreateAddress(address *entity.CustomerAddress) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if !address.IsDefault {
+			var count int64
+			if err := tx.Model(&entity.CustomerAddress{}).Where("customer_id = ?", address.CustomerID).Count(&count).Error; err != nil {
+				return err
+			}
+			address.IsDefault = count == 0
+		}
+		if address.IsDefault {
+			if err := clearDefaultAddress(tx, address.CustomerID, ""); err != nil {
+				return err
+			}
+		}
+		return tx.Create(address).Error
+	})
+}
+
+func (r *RepoDatabase) FindAddressesByCustomerID(customerID string) ([]entity.CustomerAddress, error) {
+	var addresses []entity.CustomerAddress
+	if err := r.DB.Where("customer_id = ?", customerID).
+		Order("is_default DESC").
+		Order("created_at DESC").
+		Find(&addresses).Error; err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+func (r *RepoDatabase) FindAddressByID(customerID, addressID string) (*entity.CustomerAddress, error) {
+	var address entity.CustomerAddress
+	if err := r.DB.Where("id = ? AND customer_id = ?", addressID, customerID).First(&address).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (r *RepoDatabase) UpdateAddress(address *entity.CustomerAddress) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if address.IsDefault {
+			if err := clearDefaultAddress(tx, address.CustomerID, address.ID); err != nil {
+				return err
+			}
+		}
+		return tx.Save(address).Error
+	})
+}
+
+func (r *RepoDatabase) DeleteAddress(customerID, addressID string) (bool, error) {
+	deleted := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var address entity.CustomerAddress
+		if err := tx.Where("id = ? AND customer_id = ?", addressID, customerID).First(&address).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if err := tx.Delete(&address).Error; err != nil {
+			return err
+		}
+		deleted = true
+
+		if !address.IsDefault {
+			return nil
+		}
+
+		// Keep a default address as long as the customer has any address
+		var next entity.CustomerAddress
+		if err := tx.Where("customer_id = ?", customerID).Order("created_at DESC").First(&next).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		return tx.Model(&next).Update("is_default", true).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted, nil
+}
+
+// clearDefaultAddress removes the default flag from the customer's addresses other than exceptID
+func clearDefaultAddress(tx *gorm.DB, customerID, exceptID string) error {
+	query := tx.Model(&entity.CustomerAddress{}).Where("customer_id = ? AND is_default = true", customerID)
+	if exceptID != "" {
+		query = query.Where("id <> ?", exceptID)
+	}
+	return query.Update("is_default", false).Error
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation (SQLSTATE 23505)
+func isUniqueViolation(err error) bool {
+	return strings.Contains(err.Error(), "23505") || strings.Contains(err.Error(), "duplicate key")
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoDatabase_ResetPassword(t *testing.T) {
+	t.Run("Success - Token, password and sessions are saved together", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customer_action_tokens" SET "used_at"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "customer_action_tokens"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id"}).AddRow("token-123", "customer-123"))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customers" SET "password_hash"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customer_refresh_tokens" SET "revoked_at"`)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		token, err := repo.ResetPassword("token-hash", "password-hash", time.Now())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "customer-123", token.CustomerID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Unusable token changes nothing", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customer_action_tokens" SET "used_at"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		token, err := repo.ResetPassword("token-hash", "password-hash", time.Now())
+
+		assert.NoError(t, err)
+		assert.Nil(t, token)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error - Failed password update keeps the token usable", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customer_action_tokens" SET "used_at"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "customer_action_tokens"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "customer_id"}).AddRow("token-123", "customer-123"))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "customers" SET "password_hash"`)).
+			WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		token, err := repo.ResetPassword("token-hash", "password-hash", time.Now())
+
+		assert.EqualError(t, err, "database error")
+		assert.Nil(t, token)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
@@ -56,7 +57,12 @@ func getConnection(cfg *config.AppConfig) (*gorm.DB, error) {
 }
 
 func (repo *RepoDatabase) MigrateDB() error {
-	return repo.DB.AutoMigrate(
+	// Orders created before stock reservation never took stock, so they are marked released
+	// when the column is first added, as migration 004 does
+	migrator := repo.DB.Migrator()
+	backfillStockReleased := migrator.HasTable(&entity.Order{}) && !migrator.HasColumn(&entity.Order{}, "StockReleasedAt")
+
+	err := repo.DB.AutoMigrate(
 		&entity.Product{},
 		&entity.ProductVariant{},
 		&entity.Cart{},
@@ -66,5 +72,31 @@ func (repo *RepoDatabase) MigrateDB() error {
 		&entity.OrderItem{},
 		&entity.Payment{},
 		&entity.Category{},
+		&entity.PaymentNotificationAudit{},
+		&entity.OrderStatusChange{},
+		&entity.Customer{},
+		&entity.CustomerRefreshToken{},
+		&entity.CustomerActionToken{},
+		&entity.CustomerAddress{},
+		&entity.AdminUser{},
+		&entity.AdminAuditLog{},
+		&entity.InventoryMovement{},
+		&entity.ShippingWebhookEvent{},
+		&entity.ShippingRule{},
+		&entity.Warehouse{},
+		&entity.WarehouseStock{},
+		&entity.LocationProvince{},
+		&entity.LocationCity{},
+		&entity.LocationDistrict{},
 	)
+	if err != nil {
+		return err
+	}
+
+	if backfillStockReleased {
+		return repo.DB.Model(&entity.Order{}).
+			Where("stock_released_at IS NULL").
+			Update("stock_released_at", time.Now()).Error
+	}
+	return nil
 }
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Inventory operations
+func (r *RepoDatabase) AdjustStock(movement *entity.InventoryMovement) (int, error) {
+	var stock int
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := applyMovement(tx, movement); err != nil {
+			return err
+		}
+		return tx.Model(&entity.ProductVariant{}).
+			Where("id = ?", movement.ProductVariantID).
+			Select("stock_quantity").
+			Scan(&stock).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return stock, nil
+}
+
+func (r *RepoDatabase) FindInventoryMovements(variantID string, offset, limit int) ([]entity.InventoryMovement, int64, error) {
+	var total int64
+	if err := r.DB.Model(&entity.InventoryMovement{}).
+		Where("product_variant_id = ?", variantID).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var movements []entity.InventoryMovement
+	if err := r.DB.Where("product_variant_id = ?", variantID).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&movements).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return movements, total, nil
+}
+
+func (r *RepoDatabase) SumInventoryMovements(variantID string) (int, error) {
+	return sumMovements(r.DB, variantID)
+}
+
+func (r *RepoDatabase) RebuildStock(variantID string) (*repository.StockRebuild, error) {
+	rebuild := &repository.StockRebuild{}
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		// Lock the variant so no order or adjustment moves its stock while it is rebuilt
+		var variant entity.ProductVariant
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", variantID).
+			First(&variant).Error; err != nil {
+			return err
+		}
+
+		sum, err := sumMovements(tx, variantID)
+		if err != nil {
+			return err
+		}
+
+		rebuild.Before, rebuild.After = variant.StockQuantity, sum
+		if err := tx.Model(&entity.ProductVariant{}).
+			Where("id = ?", variantID).
+			Updates(map[string]interface{}{
+				"stock_quantity":       sum,
+				"low_stock_alerted_at": rearmLowStockAlert(sum),
+				"updated_at":           time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		rebuild.Warehouses, err = rebuildWarehouseStock(tx, variantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rebuild, nil
+}
+
+// applyMovement changes the variant's stock, and the warehouse's when the movement has
+// one, by the movement's delta and records the movement. The check against negative
+// stock is part of the UPDATE so concurrent movements can never push the stock below zero.
+func applyMovement(tx *gorm.DB, movement *entity.InventoryMovement) error {
+	now := time.Now()
+	result := tx.Model(&entity.ProductVariant{}).
+		Where("id = ? AND stock_quantity + ? >= 0", movement.ProductVariantID, movement.QuantityDelta).
+		Updates(map[string]interface{}{
+			"stock_quantity":       gorm.Expr("stock_quantity + ?", movement.QuantityDelta),
+			"low_stock_alerted_at": rearmLowStockAlert(gorm.Expr("stock_quantity + ?", movement.QuantityDelta)),
+			"updated_at":           now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNegativeStock
+	}
+
+	if movement.WarehouseID != nil {
+		if err := moveWarehouseStock(tx, *movement.WarehouseID, movement.ProductVariantID, movement.QuantityDelta, now); err != nil {
+			return err
+		}
+	}
+
+	if movement.CreatedAt.IsZero() {
+		movement.CreatedAt = now
+	}
+	return tx.Create(movement).Error
+}
+
+func (r *RepoDatabase) ClaimLowStockAlerts(variantIDs []string, at time.Time) ([]repository.LowStockVariant, error) {
+	var variants []repository.LowStockVariant
+	if len(variantIDs) == 0 {
+		return variants, nil
+	}
+
+	// Claim and read in one statement so two stock changes racing each other
+	// can never both send the alert
+	err := r.DB.Raw(`
+		WITH claimed AS (
+			UPDATE product_variants
+			SET low_stock_alerted_at = ?
+			WHERE id IN ?
+				AND low_stock_threshold > 0
+				AND stock_quantity <= low_stock_threshold
+				AND low_stock_alerted_at IS NULL
+			RETURNING id, product_id, name, sku, stock_quantity, low_stock_threshold
+		)
+		SELECT claimed.id AS variant_id, products.name AS product_name, claimed.name AS variant_name,
+			claimed.sku, claimed.stock_quantity, claimed.low_stock_threshold
+		FROM claimed
+		JOIN products ON products.id = claimed.product_id
+		ORDER BY claimed.sku`, at, variantIDs).
+		Scan(&variants).Error
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+func (r *RepoDatabase) ReleaseLowStockAlerts(variantIDs []string, at time.Time) error {
+	if len(variantIDs) == 0 {
+		return nil
+	}
+	return r.DB.Model(&entity.ProductVariant{}).
+		Where("id IN ? AND low_stock_alerted_at = ?", variantIDs, at).
+		Update("low_stock_alerted_at", nil).Error
+}
+
+// rearmLowStockAlert clears low_stock_alerted_at once the new stock is above the
+// threshold, so the next drop alerts again. Postgres evaluates it against the row
+// as it was before the update.
+func rearmLowStockAlert(newStock interface{}) clause.Expr {
+	return gorm.Expr("CASE WHEN ? > low_stock_threshold THEN NULL ELSE low_stock_alerted_at END", newStock)
+}
+
+func sumMovements(db *gorm.DB, variantID string) (int, error) {
+	var sum int
+	err := db.Model(&entity.InventoryMovement{}).
+		Where("product_variant_id = ?", variantID).
+		Select("COALESCE(SUM(quantity_delta), 0)").
+		Scan(&sum).Error
+	return sum, err
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoDatabase_AdjustStock(t *testing.T) {
+	warehouseID := "warehouse-1"
+
+	t.Run("Success - Restock into a warehouse moves its stock too", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_variants" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "warehouse_stocks"`) + `.*ON CONFLICT`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "inventory_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("movement-1"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "stock_quantity" FROM "product_variants"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"stock_quantity"}).AddRow(12))
+		mock.ExpectCommit()
+
+		stock, err := repo.AdjustStock(&entity.InventoryMovement{
+			ProductVariantID: "variant-1",
+			WarehouseID:      &warehouseID,
+			Reason:           entity.InventoryReasonRestock,
+			QuantityDelta:    5,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, stock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error - Warehouse cannot go below zero", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_variants" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// The warehouse holds fewer units than the variant as a whole
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "warehouse_stocks" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.AdjustStock(&entity.InventoryMovement{
+			ProductVariantID: "variant-1",
+			WarehouseID:      &warehouseID,
+			Reason:           entity.InventoryReasonAdjustment,
+			QuantityDelta:    -5,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrNegativeStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepoDatabase_RebuildStock(t *testing.T) {
+	t.Run("Success - Warehouse stock set to its ledger sum", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "stock_quantity"}).AddRow("variant-1", 9))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(quantity_delta), 0) FROM "inventory_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(8))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_variants" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "warehouse_stocks"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "product_variant_id", "quantity"}).
+				AddRow("warehouse-1", "variant-1", 5).
+				AddRow("warehouse-2", "variant-1", 2))
+		// warehouse-3 was restocked in the ledger but never got a row
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT warehouse_id, SUM(quantity_delta) AS quantity FROM "inventory_movements"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "quantity"}).
+				AddRow("warehouse-1", 5).
+				AddRow("warehouse-2", 0).
+				AddRow("warehouse-3", 3))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "warehouse_stocks"`)).
+			WithArgs("warehouse-2", "variant-1", 0, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "warehouse_stocks"`)).
+			WithArgs("warehouse-3", "variant-1", 3, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		rebuild, err := repo.RebuildStock("variant-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &repository.StockRebuild{
+			Before: 9,
+			After:  8,
+			Warehouses: []repository.WarehouseStockRebuild{
+				{WarehouseID: "warehouse-1", Before: 5, After: 5},
+				{WarehouseID: "warehouse-2", Before: 2, After: 0},
+				{WarehouseID: "warehouse-3", Before: 0, After: 3},
+			},
+		}, rebuild)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
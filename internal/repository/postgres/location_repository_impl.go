@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Location operations
+func (r *RepoDatabase) FindLocationProvinces(provinceID string) ([]entity.LocationProvince, error) {
+	var provinces []entity.LocationProvince
+	query := r.DB.Order("name ASC")
+	if provinceID != "" {
+		query = query.Where("id = ?", provinceID)
+	}
+	if err := query.Find(&provinces).Error; err != nil {
+		return nil, err
+	}
+	return provinces, nil
+}
+
+func (r *RepoDatabase) FindLocationCities(provinceID, cityID string) ([]entity.LocationCity, error) {
+	var cities []entity.LocationCity
+	query := r.DB.Order("name ASC")
+	if provinceID != "" {
+		query = query.Where("province_id = ?", provinceID)
+	}
+	if cityID != "" {
+		query = query.Where("id = ?", cityID)
+	}
+	if err := query.Find(&cities).Error; err != nil {
+		return nil, err
+	}
+	return cities, nil
+}
+
+func (r *RepoDatabase) FindLocationDistricts(cityID string) ([]entity.LocationDistrict, error) {
+	var districts []entity.LocationDistrict
+	if err := r.DB.Where("city_id = ?", cityID).
+		Order("name ASC").
+		Find(&districts).Error; err != nil {
+		return nil, err
+	}
+	return districts, nil
+}
+
+func (r *RepoDatabase) FindLocationDistrictProvinceID(districtID string) (string, error) {
+	var provinceIDs []string
+	if err := r.DB.Model(&entity.LocationDistrict{}).
+		Joins("JOIN location_cities ON location_cities.id = location_districts.city_id").
+		Where("location_districts.id = ?", districtID).
+		Pluck("location_cities.province_id", &provinceIDs).Error; err != nil {
+		return "", err
+	}
+	if len(provinceIDs) == 0 {
+		return "", nil
+	}
+	return provinceIDs[0], nil
+}
+
+func (r *RepoDatabase) ReplaceLocationProvinces(provinces []entity.ShippingProvince) error {
+	now := time.Now()
+	rows := make([]entity.LocationProvince, 0, len(provinces))
+	ids := make([]string, 0, len(provinces))
+	for _, province := range provinces {
+		rows = append(rows, entity.LocationProvince{ID: province.ID, Name: province.Name, SyncedAt: now})
+		ids = append(ids, province.ID)
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := upsertLocations(tx, &rows, "name", "synced_at"); err != nil {
+			return err
+		}
+		return tx.Where("id NOT IN ?", ids).Delete(&entity.LocationProvince{}).Error
+	})
+}
+
+func (r *RepoDatabase) ReplaceLocationCities(provinceID string, cities []entity.ShippingCity) error {
+	now := time.Now()
+	rows := make([]entity.LocationCity, 0, len(cities))
+	ids := make([]string, 0, len(cities))
+	for _, city := range cities {
+		rows = append(rows, entity.LocationCity{ID: city.ID, ProvinceID: provinceID, Name: city.Name, SyncedAt: now})
+		ids = append(ids, city.ID)
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if len(rows) > 0 {
+			if err := upsertLocations(tx, &rows, "province_id", "name", "synced_at"); err != nil {
+				return err
+			}
+			if err := tx.Where("province_id = ? AND id NOT IN ?", provinceID, ids).Delete(&entity.LocationCity{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&entity.LocationProvince{}).
+			Where("id = ?", provinceID).
+			Update("cities_synced_at", now).Error
+	})
+}
+
+func (r *RepoDatabase) ReplaceLocationDistricts(cityID string, districts []entity.ShippingDistrict) error {
+	now := time.Now()
+	rows := make([]entity.LocationDistrict, 0, len(districts))
+	ids := make([]string, 0, len(districts))
+	for _, district := range districts {
+		rows = append(rows, entity.LocationDistrict{ID: district.ID, CityID: cityID, Name: district.Name, SyncedAt: now})
+		ids = append(ids, district.ID)
+	}
+
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if len(rows) > 0 {
+			if err := upsertLocations(tx, &rows, "city_id", "name", "synced_at"); err != nil {
+				return err
+			}
+			if err := tx.Where("city_id = ? AND id NOT IN ?", cityID, ids).Delete(&entity.LocationDistrict{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&entity.LocationCity{}).
+			Where("id = ?", cityID).
+			Update("districts_synced_at", now).Error
+	})
+}
+
+func (r *RepoDatabase) FindProvincesDueForCitySync(before time.Time, limit int) ([]entity.LocationProvince, error) {
+	var provinces []entity.LocationProvince
+	if err := r.DB.Where("cities_synced_at IS NULL OR cities_synced_at < ?", before).
+		Order("cities_synced_at ASC NULLS FIRST, id ASC").
+		Limit(limit).
+		Find(&provinces).Error; err != nil {
+		return nil, err
+	}
+	return provinces, nil
+}
+
+func (r *RepoDatabase) FindCitiesDueForDistrictSync(before time.Time, limit int) ([]entity.LocationCity, error) {
+	var cities []entity.LocationCity
+	if err := r.DB.Where("districts_synced_at < ?", before).
+		Order("districts_synced_at ASC, id ASC").
+		Limit(limit).
+		Find(&cities).Error; err != nil {
+		return nil, err
+	}
+	return cities, nil
+}
+
+// upsertLocations inserts the rows, updating the given columns of the ones already stored
+func upsertLocations(tx *gorm.DB, rows interface{}, columns ...string) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(rows).Error
+}
@@ -1,8 +1,15 @@
 package postgres
 
 import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Order operations
@@ -18,8 +25,48 @@ func (r *RepoDatabase) FindOrderByID(orderID string) (*entity.Order, error) {
 	return &order, nil
 }
 
-func (r *RepoDatabase) UpdateOrderStatus(orderID, status string) error {
-	return r.DB.Model(&entity.Order{}).Where("id = ?", orderID).Update("order_status", status).Error
+func (r *RepoDatabase) UpdateOrderStatus(change *entity.OrderStatusChange) (bool, error) {
+	updated := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Order{}).
+			Where("id = ? AND order_status = ?", change.OrderID, change.FromStatus).
+			Update("order_status", change.ToStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		updated = true
+
+		// An order that never shipped gives its stock back when it is closed
+		if releasesStock(change.FromStatus, change.ToStatus) {
+			if err := releaseStock(tx, change.OrderID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
+}
+
+// releasesStock reports whether moving an order between the statuses gives its stock back.
+// A refund after delivery keeps it, since the units left the warehouse.
+func releasesStock(from, to string) bool {
+	return to == entity.OrderStatusCancelled ||
+		(to == entity.OrderStatusRefunded && from == entity.OrderStatusProcessing)
+}
+
+func (r *RepoDatabase) FindOrderStatusChanges(orderID string) ([]entity.OrderStatusChange, error) {
+	var changes []entity.OrderStatusChange
+	if err := r.DB.Where("order_id = ?", orderID).Order("created_at ASC").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
 }
 
 func (r *RepoDatabase) GetOrderWithItems(orderID string) (*entity.Order, error) {
@@ -30,6 +77,90 @@ func (r *RepoDatabase) GetOrderWithItems(orderID string) (*entity.Order, error)
 	return &order, nil
 }
 
+func (r *RepoDatabase) FindOrderByNumber(orderNumber string) (*entity.Order, error) {
+	var order entity.Order
+	if err := r.DB.Preload("OrderItems.ProductVariant").Where("order_number = ?", orderNumber).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *RepoDatabase) FindOrdersByCustomerID(customerID string, statuses []string, offset, limit int) ([]entity.Order, int64, error) {
+	filter := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("customer_id = ?", customerID)
+		if len(statuses) > 0 {
+			db = db.Where("order_status IN ?", statuses)
+		}
+		return db
+	}
+
+	var total int64
+	if err := r.DB.Model(&entity.Order{}).Scopes(filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orders []entity.Order
+	if err := r.DB.Scopes(filter).Preload("OrderItems.ProductVariant").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+func (r *RepoDatabase) FindOrders(orderFilter repository.OrderFilter, offset, limit int) ([]entity.Order, int64, error) {
+	filter := func(db *gorm.DB) *gorm.DB {
+		if len(orderFilter.Statuses) > 0 {
+			db = db.Where("order_status IN ?", orderFilter.Statuses)
+		}
+		if orderFilter.CreatedFrom != nil {
+			db = db.Where("created_at >= ?", *orderFilter.CreatedFrom)
+		}
+		if orderFilter.CreatedBefore != nil {
+			db = db.Where("created_at < ?", *orderFilter.CreatedBefore)
+		}
+		if orderFilter.Courier != "" {
+			db = db.Where("LOWER(shipping_courier) = LOWER(?)", orderFilter.Courier)
+		}
+		if orderFilter.SourceChannel != "" {
+			db = db.Where("source_channel = ?", orderFilter.SourceChannel)
+		}
+		if orderFilter.Search != "" {
+			pattern := "%" + escapeLike(orderFilter.Search) + "%"
+			db = db.Where("order_number ILIKE ? OR customer_name ILIKE ? OR customer_email ILIKE ? OR customer_phone ILIKE ?",
+				pattern, pattern, pattern, pattern)
+		}
+		return db
+	}
+
+	var total int64
+	if err := r.DB.Model(&entity.Order{}).Scopes(filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var orders []entity.Order
+	if err := r.DB.Scopes(filter).Preload("OrderItems").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // Order item operations
 func (r *RepoDatabase) CreateOrderItem(item *entity.OrderItem) error {
 	return r.DB.Create(item).Error
@@ -75,6 +206,17 @@ func (r *RepoDatabase) UpdatePayment(payment *entity.Payment) error {
 // Transaction operations
 func (r *RepoDatabase) CreateOrderWithItems(order *entity.Order, items []entity.OrderItem) error {
 	return r.DB.Transaction(func(tx *gorm.DB) error {
+		// Reserve stock first so the order is never saved without it
+		if err := reserveStock(tx, order.ID, items); err != nil {
+			return err
+		}
+
+		if order.DiscountCodeApplied != "" {
+			if err := claimDiscountUse(tx, order.DiscountCodeApplied); err != nil {
+				return err
+			}
+		}
+
 		// Create order
 		if err := tx.Create(order).Error; err != nil {
 			return err
@@ -82,7 +224,7 @@ func (r *RepoDatabase) CreateOrderWithItems(order *entity.Order, items []entity.
 
 		// Create order items
 		for i := range items {
-			if err := tx.Create(&items[i]).Error; err != nil {
+			if err := tx.Omit(clause.Associations).Create(&items[i]).Error; err != nil {
 				return err
 			}
 		}
@@ -91,20 +233,344 @@ func (r *RepoDatabase) CreateOrderWithItems(order *entity.Order, items []entity.
 	})
 }
 
+// reserveStock locks the variant rows of the given items (SELECT ... FOR UPDATE)
+// and records a sale movement for each. Rows are locked in ID order to avoid
+// deadlocks between concurrent orders. Items shipping from a warehouse take
+// their units out of that warehouse's stock as well.
+func reserveStock(tx *gorm.DB, orderID string, items []entity.OrderItem) error {
+	quantities, variantIDs := quantitiesByVariant(items)
+	stockQuantities, keys := quantitiesByStock(items)
+
+	var variants []entity.ProductVariant
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", variantIDs).
+		Order("id").
+		Find(&variants).Error; err != nil {
+		return err
+	}
+
+	found := make(map[string]entity.ProductVariant, len(variants))
+	for _, variant := range variants {
+		found[variant.ID] = variant
+	}
+
+	var short []string
+	for _, variantID := range variantIDs {
+		variant, ok := found[variantID]
+		if !ok || !variant.IsActive {
+			short = append(short, variantID)
+			continue
+		}
+		if variant.StockQuantity < quantities[variantID] {
+			short = append(short, variant.SKU)
+		}
+	}
+	if len(short) > 0 {
+		return &repository.InsufficientStockError{SKUs: short}
+	}
+
+	short, err := shortWarehouseStock(tx, stockQuantities, keys, found)
+	if err != nil {
+		return err
+	}
+	if len(short) > 0 {
+		return &repository.InsufficientStockError{SKUs: short}
+	}
+
+	for _, key := range keys {
+		if err := applyMovement(tx, orderMovement(key, entity.InventoryReasonSale, -stockQuantities[key], orderID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// claimDiscountUse increments the discount's uses_count. The limit is checked in the
+// same UPDATE so concurrent orders can never push it past usage_limit.
+func claimDiscountUse(tx *gorm.DB, code string) error {
+	result := tx.Model(&entity.Discount{}).
+		Where("code = ? AND (usage_limit = 0 OR uses_count < usage_limit)", code).
+		Updates(map[string]interface{}{"uses_count": gorm.Expr("uses_count + 1"), "updated_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrDiscountUsageLimitReached
+	}
+	return nil
+}
+
+// changeDiscountUses adds delta to the discount's uses_count without checking its limit.
+// It never takes the count below zero.
+func changeDiscountUses(tx *gorm.DB, code string, delta int) error {
+	return tx.Model(&entity.Discount{}).
+		Where("code = ? AND uses_count + ? >= 0", code, delta).
+		Updates(map[string]interface{}{"uses_count": gorm.Expr("uses_count + ?", delta), "updated_at": time.Now()}).Error
+}
+
+// releaseStock gives the stock reserved by an order back to its variants, and the use
+// of its discount code back to the discount. The order's stock_released_at is claimed
+// first, so releasing twice (duplicate notifications, sweeper racing a webhook) is a no-op.
+func releaseStock(tx *gorm.DB, orderID string) error {
+	result := tx.Model(&entity.Order{}).
+		Where("id = ? AND stock_released_at IS NULL", orderID).
+		Update("stock_released_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	var order entity.Order
+	if err := tx.Select("id", "discount_code_applied").Where("id = ?", orderID).First(&order).Error; err != nil {
+		return err
+	}
+	if order.DiscountCodeApplied != "" {
+		if err := changeDiscountUses(tx, order.DiscountCodeApplied, -1); err != nil {
+			return err
+		}
+	}
+
+	var items []entity.OrderItem
+	if err := tx.Where("order_id = ?", orderID).Find(&items).Error; err != nil {
+		return err
+	}
+
+	quantities, keys := quantitiesByStock(items)
+	for _, key := range keys {
+		if err := applyMovement(tx, orderMovement(key, entity.InventoryReasonCancellationRelease, quantities[key], orderID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orderMovement(key stockKey, reason string, delta int, orderID string) *entity.InventoryMovement {
+	movement := &entity.InventoryMovement{
+		ProductVariantID: key.variantID,
+		Reason:           reason,
+		QuantityDelta:    delta,
+		ReferenceType:    entity.InventoryReferenceOrder,
+		ReferenceID:      orderID,
+	}
+	if key.warehouseID != "" {
+		warehouseID := key.warehouseID
+		movement.WarehouseID = &warehouseID
+	}
+	return movement
+}
+
+// stockKey is where an order item's units are taken from: a variant's stock in a
+// warehouse, or outside any warehouse when warehouseID is empty
+type stockKey struct {
+	variantID, warehouseID string
+}
+
+// quantitiesByStock sums item quantities per variant and warehouse and returns the keys
+// sorted by variant, then warehouse, the order their rows are locked in
+func quantitiesByStock(items []entity.OrderItem) (map[stockKey]int, []stockKey) {
+	quantities := make(map[stockKey]int)
+	for _, item := range items {
+		key := stockKey{variantID: item.ProductVariantID}
+		if item.WarehouseID != nil {
+			key.warehouseID = *item.WarehouseID
+		}
+		quantities[key] += item.Quantity
+	}
+
+	keys := make([]stockKey, 0, len(quantities))
+	for key := range quantities {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].variantID != keys[j].variantID {
+			return keys[i].variantID < keys[j].variantID
+		}
+		return keys[i].warehouseID < keys[j].warehouseID
+	})
+
+	return quantities, keys
+}
+
+// quantitiesByVariant sums item quantities per variant and returns the variant IDs sorted
+func quantitiesByVariant(items []entity.OrderItem) (map[string]int, []string) {
+	quantities := make(map[string]int)
+	for _, item := range items {
+		quantities[item.ProductVariantID] += item.Quantity
+	}
+
+	variantIDs := make([]string, 0, len(quantities))
+	for variantID := range quantities {
+		variantIDs = append(variantIDs, variantID)
+	}
+	sort.Strings(variantIDs)
+
+	return quantities, variantIDs
+}
+
 func (r *RepoDatabase) UpdatePaymentAndOrderStatus(payment *entity.Payment, orderID, orderStatus string) error {
-	return r.DB.Transaction(func(tx *gorm.DB) error {
+	reopened := true
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
 		// Update payment
 		if err := tx.Save(payment).Error; err != nil {
 			return err
 		}
 
-		// Update order status
-		if err := tx.Model(&entity.Order{}).Where("id = ?", orderID).Update("order_status", orderStatus).Error; err != nil {
+		// A closed order has already given its stock back, so a late notification must not reopen it
+		result := tx.Model(&entity.Order{}).
+			Where("id = ? AND order_status NOT IN ?", orderID, []string{entity.OrderStatusCancelled, entity.OrderStatusRefunded}).
+			Update("order_status", orderStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			if orderStatus != entity.OrderStatusProcessing {
+				return nil
+			}
+			var err error
+			reopened, err = reopenPaidOrder(tx, orderID)
 			return err
 		}
 
+		// Give reserved stock back when the order is cancelled
+		if orderStatus == entity.OrderStatusCancelled {
+			return releaseStock(tx, orderID)
+		}
+
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	if !reopened {
+		return repository.ErrPaidOrderClosed
+	}
+	return nil
+}
+
+// paymentChangedBy is recorded as the author of order status changes made for a payment notification
+const paymentChangedBy = "midtrans"
+
+// reopenPaidOrder handles a payment that succeeded after its order was closed. A cancelled
+// order reserves its stock and its discount use again and moves to processing. When the stock is gone, or the
+// order was refunded, it stays closed and false is returned. Either way a status change
+// is recorded so the order history shows what happened.
+func reopenPaidOrder(tx *gorm.DB, orderID string) (bool, error) {
+	var order entity.Order
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "order_status", "stock_released_at", "discount_code_applied").
+		Where("id = ?", orderID).
+		First(&order).Error; err != nil {
+		return false, err
+	}
+
+	change := &entity.OrderStatusChange{
+		OrderID:    orderID,
+		FromStatus: order.OrderStatus,
+		ToStatus:   order.OrderStatus,
+		ChangedBy:  paymentChangedBy,
+	}
+	reopened := false
+	if order.OrderStatus == entity.OrderStatusCancelled {
+		// The savepoint undoes a partial reservation when a warehouse turns out short
+		err := tx.Transaction(func(tx *gorm.DB) error {
+			if order.StockReleasedAt != nil {
+				var items []entity.OrderItem
+				if err := tx.Where("order_id = ?", orderID).Find(&items).Error; err != nil {
+					return err
+				}
+				if err := reserveStock(tx, orderID, items); err != nil {
+					return err
+				}
+				// The customer paid with the code, so its use counts even past the limit
+				if order.DiscountCodeApplied != "" {
+					if err := changeDiscountUses(tx, order.DiscountCodeApplied, 1); err != nil {
+						return err
+					}
+				}
+			}
+			return tx.Model(&entity.Order{}).
+				Where("id = ?", orderID).
+				Updates(map[string]interface{}{"order_status": entity.OrderStatusProcessing, "stock_released_at": nil}).Error
+		})
+		var insufficient *repository.InsufficientStockError
+		switch {
+		case err == nil:
+			reopened = true
+		case !errors.As(err, &insufficient):
+			return false, err
+		}
+	}
+
+	if reopened {
+		change.ToStatus = entity.OrderStatusProcessing
+		change.Note = "Paid after it was cancelled, stock reserved again"
+	} else {
+		change.Note = "Paid after it was " + order.OrderStatus + ", needs a refund or manual fulfilment"
+	}
+	if err := tx.Create(change).Error; err != nil {
+		return false, err
+	}
+	return reopened, nil
+}
+
+func (r *RepoDatabase) FindExpiredPendingPayments(before time.Time, limit int) ([]entity.Payment, error) {
+	var payments []entity.Payment
+	err := r.DB.Where("status = ? AND expiry_time IS NOT NULL AND expiry_time < ?", entity.PaymentStatusPending, before).
+		Order("expiry_time").
+		Limit(limit).
+		Find(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (r *RepoDatabase) ExpirePayment(paymentID string) (bool, error) {
+	expired := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		// Only a payment that is still pending can expire; a webhook may have settled it meanwhile
+		result := tx.Model(&entity.Payment{}).
+			Where("id = ? AND status = ?", paymentID, entity.PaymentStatusPending).
+			Updates(map[string]interface{}{"status": entity.PaymentStatusExpired, "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var payment entity.Payment
+		if err := tx.Select("order_id").Where("id = ?", paymentID).First(&payment).Error; err != nil {
+			return err
+		}
+
+		expired = true
+
+		// An order that moved on without this payment, such as a bank transfer confirmed by
+		// an admin, keeps its stock
+		cancelled := tx.Model(&entity.Order{}).
+			Where("id = ? AND order_status = ?", payment.OrderID, entity.OrderStatusPending).
+			Update("order_status", entity.OrderStatusCancelled)
+		if cancelled.Error != nil {
+			return cancelled.Error
+		}
+		if cancelled.RowsAffected == 0 {
+			return nil
+		}
+
+		return releaseStock(tx, payment.OrderID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return expired, nil
+}
+
+// Audit operations
+func (r *RepoDatabase) CreateNotificationAudit(audit *entity.PaymentNotificationAudit) error {
+	return r.DB.Create(audit).Error
 }
 
 func (r *RepoDatabase) GetSeq() (int64, error) {
@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Helper function to create a repository on a mocked database
+func createTestRepoDatabase(t *testing.T) (*RepoDatabase, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return &RepoDatabase{DB: db}, mock
+}
+
+// Helper function to expect the release of an order holding 2 units of one variant
+func expectOrderRelease(mock sqlmock.Sqlmock, discountCode string) {
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "stock_released_at"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","discount_code_applied" FROM "orders"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "discount_code_applied"}).AddRow("order-123", discountCode))
+	if discountCode != "" {
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "discounts" SET "updated_at"=$1,"uses_count"=uses_count + $2 WHERE (code = $3 AND uses_count + $4 >= 0)`)).
+			WithArgs(sqlmock.AnyArg(), -1, discountCode, -1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "order_items"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "product_variant_id", "quantity"}).
+			AddRow("item-123", "order-123", "variant-123", 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "product_variants" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "inventory_movements"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("movement-123"))
+}
+
+func TestRepoDatabase_UpdateOrderStatus(t *testing.T) {
+	t.Run("Success - Refunding an unshipped order gives back its stock", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectOrderRelease(mock, "")
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_status_changes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("change-123"))
+		mock.ExpectCommit()
+
+		updated, err := repo.UpdateOrderStatus(&entity.OrderStatusChange{
+			OrderID: "order-123", FromStatus: entity.OrderStatusProcessing, ToStatus: entity.OrderStatusRefunded,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Refunding a delivered order keeps its stock out", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_status_changes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("change-123"))
+		mock.ExpectCommit()
+
+		updated, err := repo.UpdateOrderStatus(&entity.OrderStatusChange{
+			OrderID: "order-123", FromStatus: entity.OrderStatusDelivered, ToStatus: entity.OrderStatusRefunded,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepoDatabase_ExpirePayment(t *testing.T) {
+	t.Run("Success - Order no longer pending keeps its stock", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "order_id" FROM "payments"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id"}).AddRow("order-123"))
+		// An admin already moved the order to processing
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		expired, err := repo.ExpirePayment("payment-123")
+
+		assert.NoError(t, err)
+		assert.True(t, expired)
+		// No stock_released_at claim and no stock movement
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Cancelled order gives back its stock and discount use", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "order_id" FROM "payments"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"order_id"}).AddRow("order-123"))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectOrderRelease(mock, "HEMAT10")
+		mock.ExpectCommit()
+
+		expired, err := repo.ExpirePayment("payment-123")
+
+		assert.NoError(t, err)
+		assert.True(t, expired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Payment no longer pending changes nothing", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		expired, err := repo.ExpirePayment("payment-123")
+
+		assert.NoError(t, err)
+		assert.False(t, expired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepoDatabase_UpdatePaymentAndOrderStatus(t *testing.T) {
+	payment := func(status entity.PaymentStatus) *entity.Payment {
+		return &entity.Payment{ID: "payment-123", OrderID: "order-123", Status: status, Amount: 250}
+	}
+
+	t.Run("Success - Late cancellation leaves a closed order alone", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePaymentAndOrderStatus(payment(entity.PaymentStatusFailed), "order-123", entity.OrderStatusCancelled)
+
+		assert.NoError(t, err)
+		// No second stock release
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error - Payment for a refunded order needs manual handling", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","order_status","stock_released_at","discount_code_applied" FROM "orders"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_status", "stock_released_at", "discount_code_applied"}).
+				AddRow("order-123", entity.OrderStatusRefunded, time.Now(), ""))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_status_changes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("change-123"))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePaymentAndOrderStatus(payment(entity.PaymentStatusSuccess), "order-123", entity.OrderStatusProcessing)
+
+		assert.ErrorIs(t, err, repository.ErrPaidOrderClosed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error - Payment for a cancelled order whose stock is gone", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","order_status","stock_released_at","discount_code_applied" FROM "orders"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_status", "stock_released_at", "discount_code_applied"}).
+				AddRow("order-123", entity.OrderStatusCancelled, time.Now(), ""))
+		mock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "order_items"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "product_variant_id", "quantity"}).
+				AddRow("item-123", "order-123", "variant-123", 2))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "stock_quantity", "is_active"}).
+				AddRow("variant-123", "SKU-123", 1, true))
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_status_changes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("change-123"))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePaymentAndOrderStatus(payment(entity.PaymentStatusSuccess), "order-123", entity.OrderStatusProcessing)
+
+		assert.ErrorIs(t, err, repository.ErrPaidOrderClosed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success - Payment reopens a cancelled order that never held stock", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","order_status","stock_released_at","discount_code_applied" FROM "orders"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_status", "stock_released_at", "discount_code_applied"}).
+				AddRow("order-123", entity.OrderStatusCancelled, nil, ""))
+		mock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "orders" SET "order_status"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "order_status_changes"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("change-123"))
+		mock.ExpectCommit()
+
+		err := repo.UpdatePaymentAndOrderStatus(payment(entity.PaymentStatusSuccess), "order-123", entity.OrderStatusProcessing)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepoDatabase_CreateOrderWithItems(t *testing.T) {
+	t.Run("Error - Warehouse holds fewer units than ordered", func(t *testing.T) {
+		repo, mock := createTestRepoDatabase(t)
+
+		warehouseID := "warehouse-1"
+		items := []entity.OrderItem{
+			{OrderID: "order-123", ProductVariantID: "variant-1", Quantity: 2, WarehouseID: &warehouseID},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "product_variants"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "stock_quantity", "is_active"}).
+				AddRow("variant-1", "SKU-1", 10, true))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "warehouse_stocks"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "product_variant_id", "quantity"}).
+				AddRow(warehouseID, "variant-1", 1))
+		mock.ExpectRollback()
+
+		err := repo.CreateOrderWithItems(&entity.Order{ID: "order-123"}, items)
+
+		var stockErr *repository.InsufficientStockError
+		assert.ErrorAs(t, err, &stockErr)
+		assert.Equal(t, []string{"SKU-1"}, stockErr.SKUs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,9 +1,12 @@
 package postgres
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
 	"gorm.io/gorm"
 )
 
@@ -42,8 +45,8 @@ func (repo *RepoDatabase) GetAllProductsByCategory(category string) ([]entity.Pr
 	var products []entity.Product
 
 	result := repo.DB.Preload("Variants", "is_active = ?", true).
-		Where("is_active = ?", true).
-		Where("category = ?", category).
+		Scopes(inCategory(category)).
+		Where("products.is_active = ?", true).
 		Find(&products)
 
 	if result.Error != nil {
@@ -57,7 +60,8 @@ func (repo *RepoDatabase) GetAllProductsByCategorySlug(categorySlug string) ([]e
 	var products []entity.Product
 
 	result := repo.DB.Preload("Variants", "is_active = ?", true).
-		Where("category = ? AND is_active = ?", categorySlug, true).
+		Scopes(inCategory(categorySlug)).
+		Where("products.is_active = ?", true).
 		Find(&products)
 
 	if result.Error != nil {
@@ -66,3 +70,159 @@ func (repo *RepoDatabase) GetAllProductsByCategorySlug(categorySlug string) ([]e
 
 	return products, nil
 }
+
+// inCategory matches products through their category_id rather than the stored slug,
+// so products follow their category when its slug is renamed
+func inCategory(slug string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Joins("JOIN categories ON categories.id = products.category_id AND categories.deleted_at IS NULL").
+			Where("categories.slug = ?", slug)
+	}
+}
+
+// Admin operations
+
+func (repo *RepoDatabase) FindProducts(includeInactive bool) ([]entity.Product, error) {
+	var products []entity.Product
+
+	query := repo.DB.Preload("Variants", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Order("created_at DESC")
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Find(&products).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch products: %v", err)
+	}
+
+	return products, nil
+}
+
+func (repo *RepoDatabase) FindProductByID(id string) (*entity.Product, error) {
+	var product entity.Product
+
+	err := repo.DB.Preload("Variants", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Where("id = ?", id).First(&product).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch product: %v", err)
+	}
+
+	return &product, nil
+}
+
+func (repo *RepoDatabase) CreateProduct(product *entity.Product, movements []entity.InventoryMovement) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return err
+		}
+		return applyMovements(tx, movements)
+	})
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateSKU
+	}
+	return err
+}
+
+func (repo *RepoDatabase) UpdateProduct(product *entity.Product) error {
+	return repo.DB.Model(product).
+		Select("name", "description", "category_id", "category", "brand", "features", "in_box_items",
+			"image_urls", "tokopedia_url", "shopee_url", "updated_at").
+		Updates(product).Error
+}
+
+func (repo *RepoDatabase) SetProductActive(id string, active bool, at time.Time) (bool, error) {
+	result := repo.DB.Model(&entity.Product{}).Where("id = ?", id).Updates(activeColumns(active, at))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *RepoDatabase) FindVariantByID(productID, variantID string) (*entity.ProductVariant, error) {
+	var variant entity.ProductVariant
+
+	err := repo.DB.Where("id = ? AND product_id = ?", variantID, productID).First(&variant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch variant: %v", err)
+	}
+
+	return &variant, nil
+}
+
+func (repo *RepoDatabase) SKUExists(sku, exceptVariantID string) (bool, error) {
+	var count int64
+
+	query := repo.DB.Model(&entity.ProductVariant{}).Where("sku = ?", sku)
+	if exceptVariantID != "" {
+		query = query.Where("id <> ?", exceptVariantID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *RepoDatabase) CreateVariant(variant *entity.ProductVariant, movements []entity.InventoryMovement) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(variant).Error; err != nil {
+			return err
+		}
+		return applyMovements(tx, movements)
+	})
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateSKU
+	}
+	return err
+}
+
+func (repo *RepoDatabase) UpdateVariant(variant *entity.ProductVariant) error {
+	err := repo.DB.Model(variant).
+		Select("sku", "name", "price", "low_stock_threshold", "image_url", "weight", "dimensions",
+			"attribute_values", "specifications", "updated_at").
+		Updates(variant).Error
+	if err != nil && isUniqueViolation(err) {
+		return repository.ErrDuplicateSKU
+	}
+	return err
+}
+
+func (repo *RepoDatabase) SetVariantActive(productID, variantID string, active bool, at time.Time) (bool, error) {
+	result := repo.DB.Model(&entity.ProductVariant{}).
+		Where("id = ? AND product_id = ?", variantID, productID).
+		Updates(activeColumns(active, at))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// applyMovements records the opening stock of newly created variants
+func applyMovements(tx *gorm.DB, movements []entity.InventoryMovement) error {
+	for i := range movements {
+		if err := applyMovement(tx, &movements[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activeColumns sets is_active and deleted_at together so a soft-deleted row always
+// records when it was removed and a reactivated one no longer does
+func activeColumns(active bool, at time.Time) map[string]interface{} {
+	columns := map[string]interface{}{"is_active": active, "updated_at": at}
+	if active {
+		columns["deleted_at"] = nil
+	} else {
+		columns["deleted_at"] = at
+	}
+	return columns
+}
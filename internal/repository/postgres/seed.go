@@ -33,14 +33,13 @@ func (repo *RepoDatabase) SeedTestData() error {
 	// Create test variants
 	variants := []entity.ProductVariant{
 		{
-			ID:            uuid.New().String(),
-			ProductID:     product.ID,
-			SKU:           "PR-001-S",
-			Name:          "Small Black Robe",
-			Price:         99.99,
-			StockQuantity: 50,
-			ImageURL:      "https://example.com/robe1-small.jpg",
-			Weight:        0.5,
+			ID:        uuid.New().String(),
+			ProductID: product.ID,
+			SKU:       "PR-001-S",
+			Name:      "Small Black Robe",
+			Price:     99.99,
+			ImageURL:  "https://example.com/robe1-small.jpg",
+			Weight:    500,
 			Dimensions: &entity.Dimensions{
 				Length: 100,
 				Width:  60,
@@ -54,14 +53,13 @@ func (repo *RepoDatabase) SeedTestData() error {
 			IsActive: true,
 		},
 		{
-			ID:            uuid.New().String(),
-			ProductID:     product.ID,
-			SKU:           "PR-001-M",
-			Name:          "Medium Black Robe",
-			Price:         99.99,
-			StockQuantity: 40,
-			ImageURL:      "https://example.com/robe1-medium.jpg",
-			Weight:        0.6,
+			ID:        uuid.New().String(),
+			ProductID: product.ID,
+			SKU:       "PR-001-M",
+			Name:      "Medium Black Robe",
+			Price:     99.99,
+			ImageURL:  "https://example.com/robe1-medium.jpg",
+			Weight:    600,
 			Dimensions: &entity.Dimensions{
 				Length: 110,
 				Width:  65,
@@ -83,11 +81,22 @@ func (repo *RepoDatabase) SeedTestData() error {
 			return err
 		}
 
-		// Insert variants
-		for _, variant := range variants {
+		// Insert variants and give them their opening stock through the ledger
+		openingStock := []int{50, 40}
+		for i, variant := range variants {
 			if err := tx.Create(&variant).Error; err != nil {
 				return err
 			}
+			if err := applyMovement(tx, &entity.InventoryMovement{
+				ProductVariantID: variant.ID,
+				Reason:           entity.InventoryReasonRestock,
+				QuantityDelta:    openingStock[i],
+				ReferenceType:    entity.InventoryReferenceSystem,
+				ReferenceID:      "seed",
+				Note:             "Test data",
+			}); err != nil {
+				return err
+			}
 		}
 
 		return nil
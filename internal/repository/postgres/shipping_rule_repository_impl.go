@@ -0,0 +1,14 @@
+package postgres
+
+import "github.com/hanifbg/landing_backend/internal/model/entity"
+
+// Shipping rule operations
+func (r *RepoDatabase) FindActiveShippingRules() ([]entity.ShippingRule, error) {
+	var rules []entity.ShippingRule
+	if err := r.DB.Where("is_active = ?", true).
+		Order("priority ASC, created_at ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
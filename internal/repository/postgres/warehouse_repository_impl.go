@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Warehouse operations
+func (r *RepoDatabase) FindActiveWarehouses() ([]entity.Warehouse, error) {
+	var warehouses []entity.Warehouse
+	if err := r.DB.Where("is_active = ?", true).
+		Order("priority ASC, code ASC").
+		Find(&warehouses).Error; err != nil {
+		return nil, err
+	}
+	return warehouses, nil
+}
+
+func (r *RepoDatabase) FindWarehouseStocks(variantIDs []string) ([]entity.WarehouseStock, error) {
+	var stocks []entity.WarehouseStock
+	if len(variantIDs) == 0 {
+		return stocks, nil
+	}
+	if err := r.DB.Where("product_variant_id IN ?", variantIDs).
+		Find(&stocks).Error; err != nil {
+		return nil, err
+	}
+	return stocks, nil
+}
+
+func (r *RepoDatabase) FindWarehouseByID(id string) (*entity.Warehouse, error) {
+	var warehouse entity.Warehouse
+	if err := r.DB.Where("id = ?", id).First(&warehouse).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+// moveWarehouseStock changes what the warehouse holds of the variant by delta, creating
+// the row with the first units. Like a variant's stock it can never drop below zero, and
+// the check is part of the UPDATE so concurrent movements cannot push it there.
+func moveWarehouseStock(tx *gorm.DB, warehouseID, variantID string, delta int, at time.Time) error {
+	if delta >= 0 {
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "warehouse_id"}, {Name: "product_variant_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"quantity":   gorm.Expr("warehouse_stocks.quantity + ?", delta),
+				"updated_at": at,
+			}),
+		}).Create(&entity.WarehouseStock{
+			WarehouseID:      warehouseID,
+			ProductVariantID: variantID,
+			Quantity:         delta,
+			UpdatedAt:        at,
+		}).Error
+	}
+
+	result := tx.Model(&entity.WarehouseStock{}).
+		Where("warehouse_id = ? AND product_variant_id = ? AND quantity + ? >= 0", warehouseID, variantID, delta).
+		Updates(map[string]interface{}{"quantity": gorm.Expr("quantity + ?", delta), "updated_at": at})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNegativeStock
+	}
+	return nil
+}
+
+// shortWarehouseStock locks the warehouse stock the items ship from and returns the SKUs
+// a warehouse holds too few of. Rows are locked in key order to avoid deadlocks.
+func shortWarehouseStock(tx *gorm.DB, quantities map[stockKey]int, keys []stockKey, variants map[string]entity.ProductVariant) ([]string, error) {
+	var short []string
+	for _, key := range keys {
+		if key.warehouseID == "" {
+			continue
+		}
+
+		var stocks []entity.WarehouseStock
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("warehouse_id = ? AND product_variant_id = ?", key.warehouseID, key.variantID).
+			Find(&stocks).Error; err != nil {
+			return nil, err
+		}
+		if len(stocks) == 0 || stocks[0].Quantity < quantities[key] {
+			short = append(short, variants[key.variantID].SKU)
+		}
+	}
+	return short, nil
+}
+
+// rebuildWarehouseStock sets what every warehouse holds of the variant to the sum of the
+// variant's movements in it. The variant must be locked by the caller.
+func rebuildWarehouseStock(tx *gorm.DB, variantID string) ([]repository.WarehouseStockRebuild, error) {
+	var stocks []entity.WarehouseStock
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_variant_id = ?", variantID).
+		Find(&stocks).Error; err != nil {
+		return nil, err
+	}
+
+	var sums []struct {
+		WarehouseID string
+		Quantity    int
+	}
+	if err := tx.Model(&entity.InventoryMovement{}).
+		Where("product_variant_id = ? AND warehouse_id IS NOT NULL", variantID).
+		Group("warehouse_id").
+		Select("warehouse_id, SUM(quantity_delta) AS quantity").
+		Scan(&sums).Error; err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]*repository.WarehouseStockRebuild)
+	for _, stock := range stocks {
+		changes[stock.WarehouseID] = &repository.WarehouseStockRebuild{WarehouseID: stock.WarehouseID, Before: stock.Quantity}
+	}
+	for _, sum := range sums {
+		if changes[sum.WarehouseID] == nil {
+			changes[sum.WarehouseID] = &repository.WarehouseStockRebuild{WarehouseID: sum.WarehouseID}
+		}
+		changes[sum.WarehouseID].After = sum.Quantity
+	}
+
+	rebuilt := make([]repository.WarehouseStockRebuild, 0, len(changes))
+	for _, change := range changes {
+		rebuilt = append(rebuilt, *change)
+	}
+	sort.Slice(rebuilt, func(i, j int) bool { return rebuilt[i].WarehouseID < rebuilt[j].WarehouseID })
+
+	now := time.Now()
+	for _, change := range rebuilt {
+		if change.Before == change.After {
+			continue
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "warehouse_id"}, {Name: "product_variant_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"quantity", "updated_at"}),
+		}).Create(&entity.WarehouseStock{
+			WarehouseID:      change.WarehouseID,
+			ProductVariantID: variantID,
+			Quantity:         change.After,
+			UpdatedAt:        now,
+		}).Error; err != nil {
+			return nil, err
+		}
+	}
+	return rebuilt, nil
+}
@@ -2,11 +2,49 @@ package repository
 
 //go:generate mockgen -source=product.go -destination=../service/product/mocks/product_repository_mock.go -package=mocks
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"errors"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
 
 type ProductRepository interface {
 	GetAllProducts() ([]entity.Product, error)
 	GetProductByID(id string) (*entity.Product, error)
 	GetAllProductsByCategory(category string) ([]entity.Product, error)
 	GetAllProductsByCategorySlug(categorySlug string) ([]entity.Product, error)
+
+	// Admin operations. Unlike the storefront reads above they include inactive
+	// products and variants.
+	// FindProducts returns every product with all of its variants, newest first
+	FindProducts(includeInactive bool) ([]entity.Product, error)
+	// FindProductByID returns nil, nil when the product does not exist
+	FindProductByID(id string) (*entity.Product, error)
+	// CreateProduct saves the product together with its variants, which must start with
+	// no stock, then applies the movements that give them their opening stock.
+	// It returns ErrDuplicateSKU when a variant SKU is already taken.
+	CreateProduct(product *entity.Product, movements []entity.InventoryMovement) error
+	// UpdateProduct saves the product's own fields; variants are left untouched
+	UpdateProduct(product *entity.Product) error
+	// SetProductActive soft-deletes or reactivates a product and reports false when
+	// there is no such product. Its variants keep their own active flag.
+	SetProductActive(id string, active bool, at time.Time) (bool, error)
+
+	// FindVariantByID returns nil, nil when the variant does not exist
+	FindVariantByID(productID, variantID string) (*entity.ProductVariant, error)
+	// SKUExists reports whether any variant other than exceptVariantID uses the SKU,
+	// including inactive ones, since SKUs are unique across the whole table
+	SKUExists(sku, exceptVariantID string) (bool, error)
+	// CreateVariant and UpdateVariant return ErrDuplicateSKU when the SKU is already taken.
+	// CreateVariant applies the movements the same way CreateProduct does. UpdateVariant
+	// never changes the stock, see InventoryRepository.
+	CreateVariant(variant *entity.ProductVariant, movements []entity.InventoryMovement) error
+	UpdateVariant(variant *entity.ProductVariant) error
+	// SetVariantActive soft-deletes or reactivates a variant and reports false when
+	// there is no such variant
+	SetVariantActive(productID, variantID string, active bool, at time.Time) (bool, error)
 }
+
+// ErrDuplicateSKU is returned when a variant is saved with a SKU another variant already uses
+var ErrDuplicateSKU = errors.New("sku already exists")
@@ -10,21 +10,19 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/response"
 	"github.com/hanifbg/landing_backend/internal/repository"
 )
 
+// ProviderName identifies RajaOngkir in shipping quotes
+const ProviderName = "rajaongkir"
+
 // Config holds configuration for the RajaOngkir repository
 type Config struct {
 	APIKey  string       // API key for RajaOngkir service
 	BaseURL string       // Base URL for RajaOngkir API
 	Client  *http.Client // HTTP client timeout
-
-	// Cache configuration
-	CacheEnabled      bool // Whether caching is enabled
-	CacheTTLHours     int  // Cache TTL in hours
-	WarmupOnStartup   bool // Whether to warm up cache on startup
-	WarmupTimeoutSecs int  // Warmup timeout in seconds
 }
 
 // Repository implements the ShippingRepository interface for RajaOngkir
@@ -32,7 +30,6 @@ type Repository struct {
 	apiKey  string
 	baseURL string
 	client  *http.Client
-	cache   *Cache
 }
 
 // Option is a functional option for configuring the Repository
@@ -54,25 +51,11 @@ func WithCustomClient(client *http.Client) Option {
 
 // NewRepository creates a new RajaOngkir repository
 func NewRepository(cfg Config, opts ...Option) *Repository {
-	// Initialize cache if enabled
-	var cache *Cache
-	if cfg.CacheEnabled {
-		cacheTTL := time.Duration(cfg.CacheTTLHours) * time.Hour
-		if cacheTTL == 0 {
-			cacheTTL = 24 * time.Hour // Default to 24 hours
-		}
-		cache = NewCache(cacheTTL)
-		fmt.Printf("🔧 CACHE INIT: Cache enabled with TTL=%v\n", cacheTTL)
-	} else {
-		fmt.Printf("🔧 CACHE INIT: Cache disabled\n")
-	}
-
 	// Create repository with defaults
 	repo := &Repository{
 		apiKey:  cfg.APIKey,
 		baseURL: cfg.BaseURL,
 		client:  cfg.Client,
-		cache:   cache,
 	}
 
 	// Apply options
@@ -83,15 +66,18 @@ func NewRepository(cfg Config, opts ...Option) *Repository {
 	return repo
 }
 
-// GetProvinces retrieves a list of provinces from RajaOngkir API
-func (r *Repository) GetProvinces(provinceID string) ([]response.RajaOngkirProvince, error) {
-	// Check cache first if enabled and provinceID is empty (getting all provinces)
-	if r.cache != nil && provinceID == "" {
-		if cachedProvinces, found := r.cache.GetProvinces(); found {
-			return cachedProvinces, nil
-		}
-	}
+// Name returns the provider name shown on RajaOngkir quotes
+func (r *Repository) Name() string {
+	return ProviderName
+}
+
+// Couriers returns the couriers RajaOngkir can quote
+func (r *Repository) Couriers() []string {
+	return entity.ValidCouriers()
+}
 
+// GetProvinces retrieves a list of provinces from RajaOngkir API
+func (r *Repository) GetProvinces(provinceID string) ([]entity.ShippingProvince, error) {
 	requestURL := fmt.Sprintf("%s/destination/province", r.baseURL)
 	if provinceID != "" {
 		requestURL = fmt.Sprintf("%s/%s", requestURL, provinceID)
@@ -150,23 +136,11 @@ func (r *Repository) GetProvinces(provinceID string) ([]response.RajaOngkirProvi
 
 	fmt.Printf("🌐 API CALL: Fetched %d provinces from RajaOngkir API\n", len(rajaOngkirResp.Data))
 
-	// Cache the result if enabled and getting all provinces
-	if r.cache != nil && provinceID == "" {
-		r.cache.SetProvinces(rajaOngkirResp.Data)
-	}
-
-	return rajaOngkirResp.Data, nil
+	return toProvinces(rajaOngkirResp.Data), nil
 }
 
 // GetCities retrieves a list of cities from RajaOngkir API
-func (r *Repository) GetCities(provinceID, cityID string) ([]response.RajaOngkirCity, error) {
-	// Check cache first if enabled and getting all cities for a province (no specific cityID)
-	if r.cache != nil && provinceID != "" && cityID == "" {
-		if cachedCities, found := r.cache.GetCities(provinceID); found {
-			return cachedCities, nil
-		}
-	}
-
+func (r *Repository) GetCities(provinceID, cityID string) ([]entity.ShippingCity, error) {
 	// Construct the URL path - for province 1, it should be /destination/city/1
 	requestURL := fmt.Sprintf("%s/destination/city", r.baseURL)
 
@@ -254,16 +228,11 @@ func (r *Repository) GetCities(provinceID, cityID string) ([]response.RajaOngkir
 
 	fmt.Printf("🌐 API CALL: Fetched %d cities from RajaOngkir API for province %s\n", len(rajaOngkirResp.Data), provinceID)
 
-	// Cache the result if enabled and getting all cities for a province
-	if r.cache != nil && provinceID != "" && cityID == "" {
-		r.cache.SetCities(provinceID, rajaOngkirResp.Data)
-	}
-
-	return rajaOngkirResp.Data, nil
+	return toCities(rajaOngkirResp.Data), nil
 }
 
 // GetDistricts retrieves a list of districts from RajaOngkir API
-func (r *Repository) GetDistricts(cityID string) ([]response.RajaOngkirDistrict, error) {
+func (r *Repository) GetDistricts(cityID string) ([]entity.ShippingDistrict, error) {
 	// Validate input
 	if cityID == "" {
 		return nil, &repository.ShippingError{
@@ -272,13 +241,6 @@ func (r *Repository) GetDistricts(cityID string) ([]response.RajaOngkirDistrict,
 		}
 	}
 
-	// Check cache first if enabled
-	if r.cache != nil {
-		if cachedDistricts, found := r.cache.GetDistricts(cityID); found {
-			return cachedDistricts, nil
-		}
-	}
-
 	requestURL := fmt.Sprintf("%s/destination/district/%s", r.baseURL, cityID)
 
 	req, err := http.NewRequest("GET", requestURL, nil)
@@ -340,16 +302,11 @@ func (r *Repository) GetDistricts(cityID string) ([]response.RajaOngkirDistrict,
 
 	fmt.Printf("🌐 API CALL: Fetched %d districts from RajaOngkir API for city %s\n", len(result.Data), cityID)
 
-	// Cache the result if enabled
-	if r.cache != nil {
-		r.cache.SetDistricts(cityID, result.Data)
-	}
-
-	return result.Data, nil
+	return toDistricts(cityID, result.Data), nil
 }
 
 // CalculateShippingCost calculates shipping costs between origin and destination
-func (r *Repository) CalculateShippingCost(origin, destination string, weight int, courier string) ([]response.RajaOngkirCost, error) {
+func (r *Repository) CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error) {
 	// Validate input
 	if origin == "" || destination == "" || weight <= 0 || courier == "" {
 		return nil, &repository.ShippingError{
@@ -434,11 +391,11 @@ func (r *Repository) CalculateShippingCost(origin, destination string, weight in
 		}
 	}
 
-	return result.Data, nil
+	return toQuotes(result.Data), nil
 }
 
 // ValidateAWB validates AWB number with RajaOngkir tracking API
-func (r *Repository) ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*response.RajaOngkirTrackingResponse, error) {
+func (r *Repository) ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*entity.TrackingData, error) {
 	// Build the URL with query parameters
 	endpoint := fmt.Sprintf("%s/track/waybill", r.baseURL)
 	params := url.Values{}
@@ -500,5 +457,81 @@ func (r *Repository) ValidateAWB(awbNumber, courier string, lastPhoneNumber *str
 		}
 	}
 
-	return &result, nil
+	trackingData := parseTrackingData(&result)
+	if trackingData == nil {
+		return nil, &repository.ShippingError{
+			Operation: "ValidateAWB.ParseTrackingData",
+			Err:       fmt.Errorf("response holds no tracking data"),
+		}
+	}
+
+	return trackingData, nil
+}
+
+// parseTrackingData reads the tracking data out of a RajaOngkir tracking response.
+// It returns nil when the response holds none.
+func parseTrackingData(trackingResp *response.RajaOngkirTrackingResponse) *entity.TrackingData {
+	if trackingResp == nil || trackingResp.Data == nil {
+		return nil
+	}
+
+	// Data is decoded loosely, so it is re-encoded to read it into the tracking entity
+	trackingBytes, _ := json.Marshal(trackingResp.Data)
+	var trackingData entity.TrackingData
+	if json.Unmarshal(trackingBytes, &trackingData) != nil {
+		return nil
+	}
+	return &trackingData
+}
+
+func toProvinces(provinces []response.RajaOngkirProvince) []entity.ShippingProvince {
+	result := make([]entity.ShippingProvince, 0, len(provinces))
+	for _, province := range provinces {
+		result = append(result, entity.ShippingProvince{
+			ID:   strconv.Itoa(province.ProvinceID),
+			Name: province.Province,
+		})
+	}
+	return result
+}
+
+func toCities(cities []response.RajaOngkirCity) []entity.ShippingCity {
+	result := make([]entity.ShippingCity, 0, len(cities))
+	for _, city := range cities {
+		result = append(result, entity.ShippingCity{
+			ID:         strconv.Itoa(city.CityID),
+			ProvinceID: strconv.Itoa(city.ProvinceID),
+			Name:       city.CityName,
+		})
+	}
+	return result
+}
+
+// toDistricts takes the city ID from the request, as the API no longer returns it
+func toDistricts(cityID string, districts []response.RajaOngkirDistrict) []entity.ShippingDistrict {
+	result := make([]entity.ShippingDistrict, 0, len(districts))
+	for _, district := range districts {
+		result = append(result, entity.ShippingDistrict{
+			ID:     strconv.Itoa(district.DistrictID),
+			CityID: cityID,
+			Name:   district.DistrictName,
+		})
+	}
+	return result
+}
+
+func toQuotes(costs []response.RajaOngkirCost) []entity.ShippingQuote {
+	result := make([]entity.ShippingQuote, 0, len(costs))
+	for _, cost := range costs {
+		result = append(result, entity.ShippingQuote{
+			Provider:    ProviderName,
+			Courier:     strings.ToLower(cost.Code),
+			CourierName: cost.Name,
+			Service:     cost.Service,
+			Description: cost.Description,
+			Cost:        cost.Cost,
+			ETD:         cost.ETD,
+		})
+	}
+	return result
 }
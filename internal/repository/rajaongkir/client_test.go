@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/response"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,7 +20,7 @@ func TestRepository_GetProvinces(t *testing.T) {
 		provinceID     string
 		mockResponse   string
 		mockStatusCode int
-		expectedResult []response.RajaOngkirProvince
+		expectedResult []entity.ShippingProvince
 		expectError    bool
 	}{
 		{
@@ -33,9 +34,9 @@ func TestRepository_GetProvinces(t *testing.T) {
 				]
 			}`,
 			mockStatusCode: http.StatusOK,
-			expectedResult: []response.RajaOngkirProvince{
-				{ProvinceID: 1, Province: "Bali"},
-				{ProvinceID: 2, Province: "Jawa Barat"},
+			expectedResult: []entity.ShippingProvince{
+				{ID: "1", Name: "Bali"},
+				{ID: "2", Name: "Jawa Barat"},
 			},
 			expectError: false,
 		},
@@ -47,8 +48,8 @@ func TestRepository_GetProvinces(t *testing.T) {
 				"data": [{"id": 1, "name": "Bali"}]
 			}`,
 			mockStatusCode: http.StatusOK,
-			expectedResult: []response.RajaOngkirProvince{
-				{ProvinceID: 1, Province: "Bali"},
+			expectedResult: []entity.ShippingProvince{
+				{ID: "1", Name: "Bali"},
 			},
 			expectError: false,
 		},
@@ -131,7 +132,7 @@ func TestRepository_GetCities(t *testing.T) {
 		cityID         string
 		mockResponse   string
 		mockStatusCode int
-		expectedResult []response.RajaOngkirCity
+		expectedResult []entity.ShippingCity
 		expectError    bool
 	}{
 		{
@@ -146,9 +147,9 @@ func TestRepository_GetCities(t *testing.T) {
 				]
 			}`,
 			mockStatusCode: http.StatusOK,
-			expectedResult: []response.RajaOngkirCity{
-				{CityID: 1, ProvinceID: 1, Province: "Bali", Type: "Kabupaten", CityName: "Badung", PostalCode: "80351"},
-				{CityID: 2, ProvinceID: 1, Province: "Bali", Type: "Kabupaten", CityName: "Bangli", PostalCode: "80619"},
+			expectedResult: []entity.ShippingCity{
+				{ID: "1", ProvinceID: "1", Name: "Badung"},
+				{ID: "2", ProvinceID: "1", Name: "Bangli"},
 			},
 			expectError: false,
 		},
@@ -164,9 +165,9 @@ func TestRepository_GetCities(t *testing.T) {
 				]
 			}`,
 			mockStatusCode: http.StatusOK,
-			expectedResult: []response.RajaOngkirCity{
-				{CityID: 1, ProvinceID: 1, Province: "Bali", Type: "Kabupaten", CityName: "Badung", PostalCode: "80351"},
-				{CityID: 2, ProvinceID: 1, Province: "Bali", Type: "Kabupaten", CityName: "Bangli", PostalCode: "80619"},
+			expectedResult: []entity.ShippingCity{
+				{ID: "1", ProvinceID: "1", Name: "Badung"},
+				{ID: "2", ProvinceID: "1", Name: "Bangli"},
 			},
 			expectError: false,
 		},
@@ -181,8 +182,8 @@ func TestRepository_GetCities(t *testing.T) {
 				]
 			}`,
 			mockStatusCode: http.StatusOK,
-			expectedResult: []response.RajaOngkirCity{
-				{CityID: 1, ProvinceID: 1, Province: "Bali", Type: "Kabupaten", CityName: "Badung", PostalCode: "80351"},
+			expectedResult: []entity.ShippingCity{
+				{ID: "1", ProvinceID: "1", Name: "Badung"},
 			},
 			expectError: false,
 		},
@@ -292,15 +293,12 @@ func TestRepository_GetCities(t *testing.T) {
 				assert.Equal(t, len(tt.expectedResult), len(cities), "Should have the same number of cities")
 
 				for i, expectedCity := range tt.expectedResult {
-					assert.Equal(t, expectedCity.CityID, cities[i].CityID, "CityID should match")
-					assert.Equal(t, expectedCity.CityName, cities[i].CityName, "CityName should match")
+					assert.Equal(t, expectedCity.ID, cities[i].ID, "ID should match")
+					assert.Equal(t, expectedCity.Name, cities[i].Name, "Name should match")
 
 					// Check provinceID if we specifically passed one
 					if tt.provinceID != "" {
-						provinceID, err := strconv.Atoi(tt.provinceID)
-						if err == nil {
-							assert.Equal(t, provinceID, cities[i].ProvinceID, "ProvinceID should match the path parameter")
-						}
+						assert.Equal(t, tt.provinceID, cities[i].ProvinceID, "ProvinceID should match the path parameter")
 					}
 				}
 			}
@@ -308,4 +306,78 @@ func TestRepository_GetCities(t *testing.T) {
 	}
 }
 
-// Additional tests for GetDistricts and CalculateShippingCost methods would follow the same pattern
+func TestRepository_CalculateShippingCost(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		expectedResult []entity.ShippingQuote
+		expectError    bool
+	}{
+		{
+			name: "success_quotes",
+			mockResponse: `{
+				"meta": {"code": 200, "message": "OK", "status": "success"},
+				"data": [
+					{"code": "JNE", "name": "Jalur Nugraha Ekakurir (JNE)", "service": "REG", "description": "Layanan Reguler", "cost": 18000, "etd": "2-3"}
+				]
+			}`,
+			mockStatusCode: http.StatusOK,
+			expectedResult: []entity.ShippingQuote{
+				{Provider: "rajaongkir", Courier: "jne", CourierName: "Jalur Nugraha Ekakurir (JNE)", Service: "REG", Description: "Layanan Reguler", Cost: 18000, ETD: "2-3"},
+			},
+			expectError: false,
+		},
+		{
+			name:           "error_api_failure",
+			mockResponse:   `{"meta": {"code": 400, "message": "Bad Request", "status": "error"}}`,
+			mockStatusCode: http.StatusOK,
+			expectedResult: nil,
+			expectError:    true,
+		},
+		{
+			name:           "error_http_error",
+			mockResponse:   ``,
+			mockStatusCode: http.StatusInternalServerError,
+			expectedResult: nil,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create test server
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/calculate/district/domestic-cost", r.URL.Path)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "jne:pos", r.PostForm.Get("courier"))
+
+				w.WriteHeader(tt.mockStatusCode)
+				_, err := w.Write([]byte(tt.mockResponse))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			repo := NewRepository(Config{
+				APIKey:  "test-api-key",
+				BaseURL: server.URL,
+				Client:  server.Client(),
+			})
+
+			// Execute test
+			quotes, err := repo.CalculateShippingCost("1391", "1376", 1000, "JNE:POS")
+
+			// Check results
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, quotes)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, quotes)
+			}
+		})
+	}
+}
+
+// Additional tests for GetDistricts would follow the same pattern
@@ -0,0 +1,129 @@
+package ratetable
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// ProviderName identifies the rate table in shipping quotes
+const ProviderName = "ratetable"
+
+// Rate is the fixed price of one courier service, such as a local courier the store
+// has negotiated its own prices with
+type Rate struct {
+	Courier      string   `json:"courier"` // Courier code, such as jne
+	CourierName  string   `json:"courier_name"`
+	Service      string   `json:"service"`
+	Description  string   `json:"description"`
+	Destinations []string `json:"destinations"` // District IDs served; empty serves every district
+	FirstKgCost  int      `json:"first_kg_cost"`
+	NextKgCost   int      `json:"next_kg_cost"` // Added for every started kilogram after the first
+	ETD          string   `json:"etd"`
+}
+
+// Repository quotes shipping costs from a fixed table of rates instead of a shipping API
+type Repository struct {
+	rates []Rate
+}
+
+// NewRepository creates a rate table from the given rates
+func NewRepository(rates []Rate) *Repository {
+	for i := range rates {
+		rates[i].Courier = strings.ToLower(strings.TrimSpace(rates[i].Courier))
+	}
+	return &Repository{rates: rates}
+}
+
+// LoadRepository reads a rate table from a JSON file holding a list of rates
+func LoadRepository(path string) (*Repository, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate table: %w", err)
+	}
+
+	var rates []Rate
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("failed to parse rate table %s: %w", path, err)
+	}
+	for _, rate := range rates {
+		if rate.Courier == "" || rate.Service == "" || rate.FirstKgCost <= 0 || rate.NextKgCost < 0 {
+			return nil, fmt.Errorf("rate table %s: every rate needs a courier, a service and a positive first kg cost", path)
+		}
+	}
+
+	return NewRepository(rates), nil
+}
+
+// Name returns the provider name shown on rate table quotes
+func (r *Repository) Name() string {
+	return ProviderName
+}
+
+// Couriers returns the couriers that have at least one rate
+func (r *Repository) Couriers() []string {
+	seen := map[string]bool{}
+	var couriers []string
+	for _, rate := range r.rates {
+		if !seen[rate.Courier] {
+			seen[rate.Courier] = true
+			couriers = append(couriers, rate.Courier)
+		}
+	}
+	sort.Strings(couriers)
+	return couriers
+}
+
+// CalculateShippingCost quotes every rate of the requested couriers that serves the
+// destination. The table has one price per destination, so the origin is not used.
+func (r *Repository) CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error) {
+	if destination == "" || weight <= 0 || courier == "" {
+		return nil, &repository.ShippingError{
+			Operation: "CalculateShippingCost.ValidateInput",
+			Err:       fmt.Errorf("invalid input: destination, weight and courier are required"),
+		}
+	}
+
+	requested := map[string]bool{}
+	for _, code := range strings.Split(courier, ":") {
+		requested[strings.ToLower(strings.TrimSpace(code))] = true
+	}
+
+	// Every started kilogram is charged, with at least one
+	kilograms := (weight + 999) / 1000
+
+	quotes := []entity.ShippingQuote{}
+	for _, rate := range r.rates {
+		if !requested[rate.Courier] || !servesDestination(rate, destination) {
+			continue
+		}
+		quotes = append(quotes, entity.ShippingQuote{
+			Provider:    ProviderName,
+			Courier:     rate.Courier,
+			CourierName: rate.CourierName,
+			Service:     rate.Service,
+			Description: rate.Description,
+			Cost:        rate.FirstKgCost + rate.NextKgCost*(kilograms-1),
+			ETD:         rate.ETD,
+		})
+	}
+
+	return quotes, nil
+}
+
+func servesDestination(rate Rate, destination string) bool {
+	if len(rate.Destinations) == 0 {
+		return true
+	}
+	for _, district := range rate.Destinations {
+		if district == destination {
+			return true
+		}
+	}
+	return false
+}
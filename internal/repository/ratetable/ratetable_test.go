@@ -0,0 +1,123 @@
+package ratetable
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestRates() []Rate {
+	return []Rate{
+		{Courier: "Lokal", CourierName: "Kurir Lokal", Service: "SAMEDAY", Description: "Same day", Destinations: []string{"501", "502"}, FirstKgCost: 10000, NextKgCost: 4000, ETD: "0-1"},
+		{Courier: "jne", CourierName: "JNE", Service: "FLAT", Description: "Flat rate", FirstKgCost: 15000, NextKgCost: 5000, ETD: "2-3"},
+	}
+}
+
+func TestRepository_CalculateShippingCost(t *testing.T) {
+	tests := []struct {
+		name           string
+		destination    string
+		weight         int
+		courier        string
+		expectedResult []entity.ShippingQuote
+		expectError    bool
+	}{
+		{
+			name:        "success_started_kilograms_are_charged",
+			destination: "501",
+			weight:      2100,
+			courier:     "lokal",
+			expectedResult: []entity.ShippingQuote{
+				{Provider: "ratetable", Courier: "lokal", CourierName: "Kurir Lokal", Service: "SAMEDAY", Description: "Same day", Cost: 18000, ETD: "0-1"},
+			},
+		},
+		{
+			name:        "success_several_couriers",
+			destination: "502",
+			weight:      500,
+			courier:     "LOKAL:jne",
+			expectedResult: []entity.ShippingQuote{
+				{Provider: "ratetable", Courier: "lokal", CourierName: "Kurir Lokal", Service: "SAMEDAY", Description: "Same day", Cost: 10000, ETD: "0-1"},
+				{Provider: "ratetable", Courier: "jne", CourierName: "JNE", Service: "FLAT", Description: "Flat rate", Cost: 15000, ETD: "2-3"},
+			},
+		},
+		{
+			name:           "success_destination_not_served",
+			destination:    "999",
+			weight:         1000,
+			courier:        "lokal",
+			expectedResult: []entity.ShippingQuote{},
+		},
+		{
+			name:        "error_invalid_weight",
+			destination: "501",
+			weight:      0,
+			courier:     "lokal",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewRepository(createTestRates())
+
+			quotes, err := repo.CalculateShippingCost("100", tt.destination, tt.weight, tt.courier)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, quotes)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, quotes)
+			}
+		})
+	}
+}
+
+func TestRepository_Couriers(t *testing.T) {
+	repo := NewRepository(createTestRates())
+
+	assert.Equal(t, []string{"jne", "lokal"}, repo.Couriers())
+	assert.Equal(t, "ratetable", repo.Name())
+}
+
+func TestLoadRepository(t *testing.T) {
+	t.Run("Success - Rates are read from the file", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "rates.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"courier": "lokal", "service": "SAMEDAY", "first_kg_cost": 10000, "next_kg_cost": 4000}]`), 0o600))
+
+		// Act
+		repo, err := LoadRepository(path)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lokal"}, repo.Couriers())
+	})
+
+	t.Run("Error - Rate without a cost", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "rates.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"courier": "lokal", "service": "SAMEDAY"}]`), 0o600))
+
+		// Act
+		repo, err := LoadRepository(path)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, repo)
+	})
+
+	t.Run("Error - Missing file", func(t *testing.T) {
+		// Act
+		repo, err := LoadRepository(filepath.Join(t.TempDir(), "missing.json"))
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, repo)
+	})
+}
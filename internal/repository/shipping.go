@@ -1,34 +1,46 @@
 package repository
 
-import "github.com/hanifbg/landing_backend/internal/model/response"
+import "github.com/hanifbg/landing_backend/internal/model/entity"
 
 //go:generate mockgen -source=shipping.go -destination=../service/shipping/mocks/shipping_repository_mock.go -package=mocks
 
+// ShippingRateProvider quotes shipping costs. Several providers can quote the same
+// parcel; the shipping service merges their quotes.
+type ShippingRateProvider interface {
+	// Name identifies the provider in quotes and logs
+	Name() string
+
+	// Couriers returns the lowercase codes of the couriers the provider can quote
+	Couriers() []string
+
+	// CalculateShippingCost calculates shipping costs between origin and destination
+	// origin and destination are district IDs
+	// weight is in grams
+	// courier is a courier code (e.g., "jne") or several joined by ':' (e.g., "jne:pos")
+	CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error)
+}
+
 // ShippingRepository defines the interface for shipping data operations
 // It abstracts the actual implementation details for retrieving shipping data
 type ShippingRepository interface {
+	ShippingRateProvider
+
 	// GetProvinces retrieves a list of provinces from the shipping provider
 	// If provinceID is provided, it retrieves a specific province
-	GetProvinces(provinceID string) ([]response.RajaOngkirProvince, error)
+	GetProvinces(provinceID string) ([]entity.ShippingProvince, error)
 
 	// GetCities retrieves a list of cities from the shipping provider
 	// If provinceID is provided, it retrieves cities in that province
 	// If cityID is also provided, it retrieves a specific city
-	GetCities(provinceID, cityID string) ([]response.RajaOngkirCity, error)
+	GetCities(provinceID, cityID string) ([]entity.ShippingCity, error)
 
 	// GetDistricts retrieves a list of districts from the shipping provider
 	// cityID is required to specify which city's districts to retrieve
-	GetDistricts(cityID string) ([]response.RajaOngkirDistrict, error)
-
-	// CalculateShippingCost calculates shipping costs between origin and destination
-	// origin and destination are location IDs
-	// weight is in grams
-	// courier is the shipping provider code (e.g., "jne", "pos", "tiki")
-	CalculateShippingCost(origin, destination string, weight int, courier string) ([]response.RajaOngkirCost, error)
+	GetDistricts(cityID string) ([]entity.ShippingDistrict, error)
 
-	// ValidateAWB validates AWB number with RajaOngkir API
+	// ValidateAWB validates AWB number with the shipping provider
 	// Returns tracking data if AWB is valid, error if invalid
-	ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*response.RajaOngkirTrackingResponse, error)
+	ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*entity.TrackingData, error)
 }
 
 // ShippingError represents errors from the shipping repository
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/hanifbg/landing_backend/internal/model/entity"
+
+//go:generate mockgen -source=shipping_rule.go -destination=../service/shipping/mocks/shipping_rule_repository_mock.go -package=mocks
+
+// ShippingRuleRepository reads the rules that adjust courier quotes
+type ShippingRuleRepository interface {
+	// FindActiveShippingRules returns the active rules by priority, lowest number first
+	FindActiveShippingRules() ([]entity.ShippingRule, error)
+}
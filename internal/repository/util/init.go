@@ -11,18 +11,29 @@ import (
 	"github.com/hanifbg/landing_backend/internal/repository/mail"
 	db "github.com/hanifbg/landing_backend/internal/repository/postgres"
 	"github.com/hanifbg/landing_backend/internal/repository/rajaongkir"
+	"github.com/hanifbg/landing_backend/internal/repository/ratetable"
 )
 
 type RepoWrapper struct {
-	ProductRepo     repository.ProductRepository
-	CartRepo        repository.CartRepository
-	PaymentRepo     repository.PaymentRepository
-	CategoryRepo    repository.CategoryRepository
-	ShippingRepo    repository.ShippingRepository
-	AWBTrackingRepo repository.AWBTrackingRepository
-	MailRepo        repository.Mailer
-	WhatsAppRepo    repository.WhatsApp
-	TelegramRepo    repository.TelegramAPI
+	ProductRepo      repository.ProductRepository
+	CartRepo         repository.CartRepository
+	PaymentRepo      repository.PaymentRepository
+	CategoryRepo     repository.CategoryRepository
+	CustomerRepo     repository.CustomerRepository
+	ShippingRepo     repository.ShippingRepository
+	ShippingRuleRepo repository.ShippingRuleRepository
+	WarehouseRepo    repository.WarehouseRepository
+	LocationRepo     repository.LocationRepository
+	AWBTrackingRepo  repository.AWBTrackingRepository
+	AdminUserRepo    repository.AdminUserRepository
+	AuditLogRepo     repository.AuditLogRepository
+	InventoryRepo    repository.InventoryRepository
+	MailRepo         repository.Mailer
+	WhatsAppRepo     repository.WhatsApp
+	TelegramRepo     repository.TelegramAPI
+
+	// ShippingRateProviders are all providers asked for shipping quotes, ShippingRepo first
+	ShippingRateProviders []repository.ShippingRateProvider
 }
 
 func New(cfg *config.AppConfig) (repoWrapper *RepoWrapper, err error) {
@@ -43,30 +54,43 @@ func New(cfg *config.AppConfig) (repoWrapper *RepoWrapper, err error) {
 		Timeout: time.Duration(cfg.HttpTimeout) * time.Second,
 	}
 
-	// Initialize RajaOngkir repository with caching configuration
+	// Initialize RajaOngkir repository; the locations it lists are stored by the shipping service
 	rajaOngkirRepo := rajaongkir.NewRepository(rajaongkir.Config{
 		APIKey:  cfg.RajaOngkirAPIKey,
 		BaseURL: cfg.RajaOngkirBaseURL,
 		Client:  httpClient,
-		// Cache configuration
-		CacheEnabled:      cfg.RajaOngkirCacheEnabled,
-		CacheTTLHours:     cfg.RajaOngkirCacheTTLHours,
-		WarmupOnStartup:   cfg.RajaOngkirWarmupOnStartup,
-		WarmupTimeoutSecs: cfg.RajaOngkirWarmupTimeoutSecs,
 	})
 
+	shippingRateProviders := []repository.ShippingRateProvider{rajaOngkirRepo}
+	if cfg.ShippingRateTablePath != "" {
+		rateTable, err := ratetable.LoadRepository(cfg.ShippingRateTablePath)
+		if err != nil {
+			return nil, err
+		}
+		shippingRateProviders = append(shippingRateProviders, rateTable)
+	}
+
 	externalRepo := external.New(cfg, httpClient)
 
 	repoWrapper = &RepoWrapper{
-		ProductRepo:     dbConnection,
-		CartRepo:        dbConnection,
-		PaymentRepo:     dbConnection,
-		CategoryRepo:    dbConnection,
-		ShippingRepo:    rajaOngkirRepo,
-		AWBTrackingRepo: db.NewAWBTrackingRepository(dbConnection.DB),
-		MailRepo:        mailer,
-		WhatsAppRepo:    externalRepo.WAApi,
-		TelegramRepo:    externalRepo.TelegramAPI,
+		ProductRepo:      dbConnection,
+		CartRepo:         dbConnection,
+		PaymentRepo:      dbConnection,
+		CategoryRepo:     dbConnection,
+		CustomerRepo:     dbConnection,
+		ShippingRepo:     rajaOngkirRepo,
+		ShippingRuleRepo: dbConnection,
+		WarehouseRepo:    dbConnection,
+		LocationRepo:     dbConnection,
+		AWBTrackingRepo:  db.NewAWBTrackingRepository(dbConnection.DB),
+		AdminUserRepo:    dbConnection,
+		AuditLogRepo:     dbConnection,
+		InventoryRepo:    dbConnection,
+		MailRepo:         mailer,
+		WhatsAppRepo:     externalRepo.WAApi,
+		TelegramRepo:     externalRepo.TelegramAPI,
+
+		ShippingRateProviders: shippingRateProviders,
 	}
 
 	return repoWrapper, nil
@@ -0,0 +1,15 @@
+package repository
+
+import "github.com/hanifbg/landing_backend/internal/model/entity"
+
+//go:generate mockgen -source=warehouse.go -destination=../service/shipping/mocks/warehouse_repository_mock.go -package=mocks
+
+// WarehouseRepository reads the warehouses orders ship from and what they hold
+type WarehouseRepository interface {
+	// FindActiveWarehouses returns the active warehouses by priority, lowest number first
+	FindActiveWarehouses() ([]entity.Warehouse, error)
+	// FindWarehouseStocks returns the stock every warehouse holds of the given variants
+	FindWarehouseStocks(variantIDs []string) ([]entity.WarehouseStock, error)
+	// FindWarehouseByID returns the warehouse, active or not, or nil when there is none
+	FindWarehouseByID(id string) (*entity.Warehouse, error)
+}
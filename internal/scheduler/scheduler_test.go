@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("Success - Job runs on start and on every tick", func(t *testing.T) {
+		// Arrange
+		var runs int32
+		s := New(Job{
+			Name:     "counter",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+				return nil
+			},
+		})
+
+		// Act
+		s.Start(context.Background())
+		time.Sleep(55 * time.Millisecond)
+		s.Stop()
+
+		// Assert
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(3))
+	})
+
+	t.Run("Success - Stop cancels the job context and waits", func(t *testing.T) {
+		// Arrange
+		started := make(chan struct{})
+		var finished int32
+		s := New(Job{
+			Name:     "blocking",
+			Interval: time.Hour,
+			Run: func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				atomic.StoreInt32(&finished, 1)
+				return ctx.Err()
+			},
+		})
+
+		// Act
+		s.Start(context.Background())
+		<-started
+		s.Stop()
+
+		// Assert
+		assert.Equal(t, int32(1), atomic.LoadInt32(&finished))
+	})
+
+	t.Run("Success - Failing job keeps running", func(t *testing.T) {
+		// Arrange
+		var runs int32
+		s := New(Job{
+			Name:     "failing",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&runs, 1)
+				return errors.New("boom")
+			},
+		})
+
+		// Act
+		s.Start(context.Background())
+		time.Sleep(35 * time.Millisecond)
+		s.Stop()
+
+		// Assert
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(2))
+	})
+
+	t.Run("Success - Invalid jobs are skipped", func(t *testing.T) {
+		// Arrange
+		s := New(Job{Name: "no-interval", Run: func(ctx context.Context) error { return nil }}, Job{Name: "no-run", Interval: time.Second})
+
+		// Act
+		s.Start(context.Background())
+		s.Stop()
+
+		// Assert
+		assert.Len(t, s.jobs, 2)
+	})
+}
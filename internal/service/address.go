@@ -0,0 +1,28 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+)
+
+// AddressService manages a customer's saved addresses. Every method is scoped to the
+// customer, so another customer's address behaves as if it did not exist.
+type AddressService interface {
+	ListAddresses(customerID string) ([]response.AddressResponse, error)
+	GetAddress(customerID, addressID string) (*response.AddressResponse, error)
+	CreateAddress(customerID string, req request.AddressRequest) (*response.AddressResponse, error)
+	// UpdateAddress replaces the address. The default moves only by marking another
+	// address as the default, so is_default false keeps a default address the default.
+	UpdateAddress(customerID, addressID string, req request.AddressRequest) (*response.AddressResponse, error)
+	DeleteAddress(customerID, addressID string) error
+}
+
+var (
+	// ErrAddressNotFound is returned when an address does not exist or belongs to another customer
+	ErrAddressNotFound = errors.New("address not found")
+
+	// ErrAddressRequiresLogin is returned when an order names a saved address without a logged in customer
+	ErrAddressRequiresLogin = errors.New("saved addresses require a logged in customer")
+)
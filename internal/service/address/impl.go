@@ -0,0 +1,131 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+func (s *AddressService) ListAddresses(customerID string) ([]response.AddressResponse, error) {
+	addresses, err := s.customerRepo.FindAddressesByCustomerID(customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %v", err)
+	}
+
+	responses := make([]response.AddressResponse, 0, len(addresses))
+	for i := range addresses {
+		responses = append(responses, toAddressResponse(&addresses[i]))
+	}
+
+	return responses, nil
+}
+
+func (s *AddressService) GetAddress(customerID, addressID string) (*response.AddressResponse, error) {
+	address, err := s.findAddress(customerID, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	addressResponse := toAddressResponse(address)
+	return &addressResponse, nil
+}
+
+func (s *AddressService) CreateAddress(customerID string, req request.AddressRequest) (*response.AddressResponse, error) {
+	now := time.Now()
+	address := &entity.CustomerAddress{
+		ID:         uuid.New().String(),
+		CustomerID: customerID,
+		CreatedAt:  now,
+	}
+	applyAddressRequest(address, req, now)
+
+	if err := s.customerRepo.CreateAddress(address); err != nil {
+		return nil, fmt.Errorf("failed to create address: %v", err)
+	}
+
+	addressResponse := toAddressResponse(address)
+	return &addressResponse, nil
+}
+
+func (s *AddressService) UpdateAddress(customerID, addressID string, req request.AddressRequest) (*response.AddressResponse, error) {
+	address, err := s.findAddress(customerID, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	wasDefault := address.IsDefault
+	applyAddressRequest(address, req, time.Now())
+	address.IsDefault = address.IsDefault || wasDefault
+
+	if err := s.customerRepo.UpdateAddress(address); err != nil {
+		return nil, fmt.Errorf("failed to update address: %v", err)
+	}
+
+	addressResponse := toAddressResponse(address)
+	return &addressResponse, nil
+}
+
+func (s *AddressService) DeleteAddress(customerID, addressID string) error {
+	deleted, err := s.customerRepo.DeleteAddress(customerID, addressID)
+	if err != nil {
+		return fmt.Errorf("failed to delete address: %v", err)
+	}
+	if !deleted {
+		return service.ErrAddressNotFound
+	}
+
+	return nil
+}
+
+func (s *AddressService) findAddress(customerID, addressID string) (*entity.CustomerAddress, error) {
+	address, err := s.customerRepo.FindAddressByID(customerID, addressID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address: %v", err)
+	}
+	if address == nil {
+		return nil, service.ErrAddressNotFound
+	}
+	return address, nil
+}
+
+func applyAddressRequest(address *entity.CustomerAddress, req request.AddressRequest, now time.Time) {
+	address.Label = strings.TrimSpace(req.Label)
+	address.RecipientName = strings.TrimSpace(req.RecipientName)
+	address.PhoneNumber = strings.TrimSpace(req.PhoneNumber)
+	address.StreetAddress = strings.TrimSpace(req.StreetAddress)
+	address.ProvinceID = req.ProvinceID
+	address.ProvinceName = req.ProvinceName
+	address.CityID = req.CityID
+	address.CityName = req.CityName
+	address.DistrictID = req.DistrictID
+	address.DistrictName = req.DistrictName
+	address.PostalCode = strings.TrimSpace(req.PostalCode)
+	address.IsDefault = req.IsDefault
+	address.UpdatedAt = now
+}
+
+func toAddressResponse(address *entity.CustomerAddress) response.AddressResponse {
+	return response.AddressResponse{
+		ID:            address.ID,
+		Label:         address.Label,
+		RecipientName: address.RecipientName,
+		PhoneNumber:   address.PhoneNumber,
+		StreetAddress: address.StreetAddress,
+		ProvinceID:    address.ProvinceID,
+		ProvinceName:  address.ProvinceName,
+		CityID:        address.CityID,
+		CityName:      address.CityName,
+		DistrictID:    address.DistrictID,
+		DistrictName:  address.DistrictName,
+		PostalCode:    address.PostalCode,
+		IsDefault:     address.IsDefault,
+		CreatedAt:     address.CreatedAt,
+		UpdatedAt:     address.UpdatedAt,
+	}
+}
@@ -0,0 +1,262 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/address/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function to create a test saved address
+func createTestAddress(isDefault bool) *entity.CustomerAddress {
+	return &entity.CustomerAddress{
+		ID:            "address-123",
+		CustomerID:    "customer-123",
+		Label:         "Home",
+		RecipientName: "Test Customer",
+		PhoneNumber:   "081234567890",
+		StreetAddress: "Jl. Test No. 1",
+		ProvinceID:    "6",
+		ProvinceName:  "DKI Jakarta",
+		CityID:        "153",
+		CityName:      "Jakarta Selatan",
+		DistrictID:    "1234",
+		DistrictName:  "Kebayoran Baru",
+		PostalCode:    "12120",
+		IsDefault:     isDefault,
+	}
+}
+
+// Helper function to create a test address request
+func createTestAddressRequest() request.AddressRequest {
+	return request.AddressRequest{
+		Label:         " Office ",
+		RecipientName: "Test Customer",
+		PhoneNumber:   "081234567890",
+		StreetAddress: "Jl. Kantor No. 2",
+		ProvinceID:    "6",
+		ProvinceName:  "DKI Jakarta",
+		CityID:        "153",
+		CityName:      "Jakarta Selatan",
+		DistrictID:    "1235",
+		DistrictName:  "Setiabudi",
+		PostalCode:    "12910",
+	}
+}
+
+func TestAddressService_ListAddresses(t *testing.T) {
+	t.Run("Success - Addresses in repository order", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		second := *createTestAddress(false)
+		second.ID = "address-456"
+		mockRepo.EXPECT().FindAddressesByCustomerID("customer-123").
+			Return([]entity.CustomerAddress{*createTestAddress(true), second}, nil)
+
+		// Act
+		result, err := svc.ListAddresses("customer-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "address-123", result[0].ID)
+		assert.True(t, result[0].IsDefault)
+		assert.Equal(t, "address-456", result[1].ID)
+	})
+
+	t.Run("Success - No addresses returns an empty list", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().FindAddressesByCustomerID("customer-123").Return(nil, nil)
+
+		// Act
+		result, err := svc.ListAddresses("customer-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
+
+func TestAddressService_GetAddress(t *testing.T) {
+	t.Run("Error - Another customer's address is not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().FindAddressByID("customer-999", "address-123").Return(nil, nil)
+
+		// Act
+		result, err := svc.GetAddress("customer-999", "address-123")
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrAddressNotFound)
+	})
+}
+
+func TestAddressService_CreateAddress(t *testing.T) {
+	t.Run("Success - Address is stored for the customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		var stored *entity.CustomerAddress
+		mockRepo.EXPECT().CreateAddress(gomock.Any()).DoAndReturn(func(address *entity.CustomerAddress) error {
+			stored = address
+			return nil
+		})
+
+		// Act
+		result, err := svc.CreateAddress("customer-123", createTestAddressRequest())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, stored.ID)
+		assert.Equal(t, "customer-123", stored.CustomerID)
+		assert.Equal(t, "Office", stored.Label)
+		assert.Equal(t, "1235", stored.DistrictID)
+		assert.Equal(t, stored.ID, result.ID)
+	})
+
+	t.Run("Error - Repository failure", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().CreateAddress(gomock.Any()).Return(errors.New("database error"))
+
+		// Act
+		result, err := svc.CreateAddress("customer-123", createTestAddressRequest())
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestAddressService_UpdateAddress(t *testing.T) {
+	t.Run("Success - Default address stays the default", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().FindAddressByID("customer-123", "address-123").Return(createTestAddress(true), nil)
+		mockRepo.EXPECT().UpdateAddress(gomock.Any()).DoAndReturn(func(address *entity.CustomerAddress) error {
+			assert.True(t, address.IsDefault)
+			assert.Equal(t, "Jl. Kantor No. 2", address.StreetAddress)
+			return nil
+		})
+
+		// Act
+		result, err := svc.UpdateAddress("customer-123", "address-123", createTestAddressRequest())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.IsDefault)
+		assert.Equal(t, "Setiabudi", result.DistrictName)
+	})
+
+	t.Run("Success - Address can be made the default", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		req := createTestAddressRequest()
+		req.IsDefault = true
+		mockRepo.EXPECT().FindAddressByID("customer-123", "address-123").Return(createTestAddress(false), nil)
+		mockRepo.EXPECT().UpdateAddress(gomock.Any()).Return(nil)
+
+		// Act
+		result, err := svc.UpdateAddress("customer-123", "address-123", req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.IsDefault)
+	})
+
+	t.Run("Error - Address not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().FindAddressByID("customer-123", "address-404").Return(nil, nil)
+
+		// Act
+		result, err := svc.UpdateAddress("customer-123", "address-404", createTestAddressRequest())
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrAddressNotFound)
+	})
+}
+
+func TestAddressService_DeleteAddress(t *testing.T) {
+	t.Run("Success - Address deleted", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().DeleteAddress("customer-123", "address-123").Return(true, nil)
+
+		// Act
+		err := svc.DeleteAddress("customer-123", "address-123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error - Address not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := &AddressService{customerRepo: mockRepo}
+
+		mockRepo.EXPECT().DeleteAddress("customer-123", "address-404").Return(false, nil)
+
+		// Act
+		err := svc.DeleteAddress("customer-123", "address-404")
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrAddressNotFound)
+	})
+}
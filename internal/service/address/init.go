@@ -0,0 +1,17 @@
+package address
+
+import (
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/repository/util"
+)
+
+type AddressService struct {
+	customerRepo repository.CustomerRepository
+}
+
+func New(cfg *config.AppConfig, repo *util.RepoWrapper) *AddressService {
+	return &AddressService{
+		customerRepo: repo.CustomerRepo,
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+)
+
+// AdminActor is the signed-in admin making a request. Admin services record it in the audit log.
+type AdminActor struct {
+	ID    string
+	Email string
+	Role  string
+}
+
+type AdminUserService interface {
+	Login(req request.AdminLoginRequest) (*response.AdminAuthResponse, error)
+	// Authenticate validates an admin access token and returns the admin it was issued to,
+	// with their current role. Tokens of deactivated admins are rejected.
+	Authenticate(token string) (*AdminActor, error)
+
+	ListAdminUsers() ([]entity.AdminUser, error)
+	GetAdminUser(id string) (*entity.AdminUser, error)
+	CreateAdminUser(actor AdminActor, req request.CreateAdminUserRequest) (*entity.AdminUser, error)
+	// UpdateAdminUser refuses to demote or deactivate the last active owner
+	UpdateAdminUser(actor AdminActor, id string, req request.UpdateAdminUserRequest) (*entity.AdminUser, error)
+}
+
+type AuditLogService interface {
+	ListAuditLogs(req request.AuditLogListRequest) (*response.AuditLogListResponse, error)
+}
+
+var (
+	// ErrAdminUserNotFound is returned when an admin user does not exist
+	ErrAdminUserNotFound = errors.New("admin user not found")
+
+	// ErrDuplicateAdminEmail is returned when an admin is created with an email that is already used
+	ErrDuplicateAdminEmail = errors.New("admin email already used")
+
+	// ErrLastOwner is returned when a change would leave no active owner
+	ErrLastOwner = errors.New("the last active owner cannot be demoted or deactivated")
+)
@@ -0,0 +1,220 @@
+package adminuser
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (s *AdminUserService) Login(req request.AdminLoginRequest) (*response.AdminAuthResponse, error) {
+	admin, err := s.adminUserRepo.FindAdminUserByEmail(normalizeEmail(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %v", err)
+	}
+
+	if admin == nil || !admin.IsActive {
+		// Spend the same time as a real check so response times don't reveal admin emails
+		_ = bcrypt.CompareHashAndPassword(s.getDummyHash(), []byte(req.Password))
+		return nil, service.ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.PasswordHash), []byte(req.Password)); err != nil {
+		return nil, service.ErrInvalidCredentials
+	}
+
+	now := time.Now()
+	token, err := s.signToken(admin.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign admin token: %v", err)
+	}
+
+	if err := s.adminUserRepo.UpdateAdminLastLogin(admin.ID, now); err != nil {
+		log.Printf("failed to update last login for admin %s: %v", admin.ID, err)
+	}
+	admin.LastLoginAt = &now
+
+	audit.Record(s.auditLogRepo, toActor(admin), "admin_user.login", entity.AuditTargetAdminUser, admin.ID, nil, nil)
+
+	return &response.AdminAuthResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.tokenTTL.Seconds()),
+		Admin:       *admin,
+	}, nil
+}
+
+func (s *AdminUserService) Authenticate(token string) (*service.AdminActor, error) {
+	adminID, err := s.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := s.adminUserRepo.FindAdminUserByID(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %v", err)
+	}
+	if admin == nil || !admin.IsActive {
+		return nil, service.ErrInvalidToken
+	}
+
+	actor := toActor(admin)
+	return &actor, nil
+}
+
+func (s *AdminUserService) ListAdminUsers() ([]entity.AdminUser, error) {
+	return s.adminUserRepo.FindAdminUsers()
+}
+
+func (s *AdminUserService) GetAdminUser(id string) (*entity.AdminUser, error) {
+	admin, err := s.adminUserRepo.FindAdminUserByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, service.ErrAdminUserNotFound
+	}
+
+	return admin, nil
+}
+
+func (s *AdminUserService) CreateAdminUser(actor service.AdminActor, req request.CreateAdminUserRequest) (*entity.AdminUser, error) {
+	admin, err := s.createAdminUser(req)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Record(s.auditLogRepo, actor, "admin_user.create", entity.AuditTargetAdminUser, admin.ID, nil, admin)
+
+	return admin, nil
+}
+
+func (s *AdminUserService) UpdateAdminUser(actor service.AdminActor, id string, req request.UpdateAdminUserRequest) (*entity.AdminUser, error) {
+	admin, err := s.GetAdminUser(id)
+	if err != nil {
+		return nil, err
+	}
+	before := *admin
+
+	admin.Name = strings.TrimSpace(req.Name)
+	admin.Role = req.Role
+	if req.IsActive != nil {
+		admin.IsActive = *req.IsActive
+	}
+	if req.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), s.bcryptCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %v", err)
+		}
+		admin.PasswordHash = string(passwordHash)
+	}
+	admin.UpdatedAt = time.Now()
+
+	if before.IsActive && before.Role == entity.AdminRoleOwner && (!admin.IsActive || admin.Role != entity.AdminRoleOwner) {
+		owners, err := s.adminUserRepo.CountActiveAdminsByRole(entity.AdminRoleOwner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count owners: %v", err)
+		}
+		if owners <= 1 {
+			return nil, service.ErrLastOwner
+		}
+	}
+
+	if err := s.adminUserRepo.UpdateAdminUser(admin); err != nil {
+		return nil, fmt.Errorf("failed to update admin: %v", err)
+	}
+
+	changes := interface{}(admin)
+	if req.Password != "" {
+		// The hash is never written to the log, only that the password changed
+		changes = struct {
+			*entity.AdminUser
+			PasswordChanged bool `json:"password_changed"`
+		}{admin, true}
+	}
+	audit.Record(s.auditLogRepo, actor, "admin_user.update", entity.AuditTargetAdminUser, admin.ID, &before, changes)
+
+	return admin, nil
+}
+
+// BootstrapOwner creates the first owner account while there are no admins at all, so a
+// new installation can be signed in to. It does nothing when email or password is empty.
+func (s *AdminUserService) BootstrapOwner(email, password string) error {
+	if email == "" || password == "" {
+		return nil
+	}
+
+	count, err := s.adminUserRepo.CountAdminUsers()
+	if err != nil {
+		return fmt.Errorf("failed to count admins: %v", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	admin, err := s.createAdminUser(request.CreateAdminUserRequest{
+		Name:     "Owner",
+		Email:    email,
+		Password: password,
+		Role:     entity.AdminRoleOwner,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("created owner admin %s", admin.Email)
+	return nil
+}
+
+func (s *AdminUserService) createAdminUser(req request.CreateAdminUserRequest) (*entity.AdminUser, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), s.bcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %v", err)
+	}
+
+	now := time.Now()
+	admin := &entity.AdminUser{
+		ID:           uuid.New().String(),
+		Name:         strings.TrimSpace(req.Name),
+		Email:        normalizeEmail(req.Email),
+		PasswordHash: string(passwordHash),
+		Role:         req.Role,
+		IsActive:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.adminUserRepo.CreateAdminUser(admin); err != nil {
+		if errors.Is(err, repository.ErrDuplicateAdminEmail) {
+			return nil, service.ErrDuplicateAdminEmail
+		}
+		return nil, fmt.Errorf("failed to create admin: %v", err)
+	}
+
+	return admin, nil
+}
+
+func (s *AdminUserService) getDummyHash() []byte {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not-a-real-password"), s.bcryptCost)
+	})
+	return s.dummyHash
+}
+
+func toActor(admin *entity.AdminUser) service.AdminActor {
+	return service.AdminActor{ID: admin.ID, Email: admin.Email, Role: admin.Role}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
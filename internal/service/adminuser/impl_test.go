@@ -0,0 +1,301 @@
+package adminuser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/adminuser/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testSecret = "test-secret"
+
+var testActor = service.AdminActor{ID: "owner-1", Email: "owner@example.com", Role: entity.AdminRoleOwner}
+
+// Helper function to create a test admin user service with a cheap bcrypt cost
+func createTestAdminUserService(ctrl *gomock.Controller) (*AdminUserService, *mocks.MockAdminUserRepository, *mocks.MockAuditLogRepository) {
+	adminUserRepo := mocks.NewMockAdminUserRepository(ctrl)
+	auditLogRepo := mocks.NewMockAuditLogRepository(ctrl)
+	s := NewAdminUserService(adminUserRepo, auditLogRepo, testSecret, time.Hour)
+	s.bcryptCost = bcrypt.MinCost
+	return s, adminUserRepo, auditLogRepo
+}
+
+// Helper function to create a test admin with the given role and password
+func createTestAdmin(t *testing.T, role, password string) *entity.AdminUser {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return &entity.AdminUser{
+		ID:           "admin-123",
+		Name:         "Ops",
+		Email:        "ops@example.com",
+		PasswordHash: string(hash),
+		Role:         role,
+		IsActive:     true,
+	}
+}
+
+func TestAdminUserService_Login(t *testing.T) {
+	t.Run("Success - Token authenticates the admin with their role", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, auditLogRepo := createTestAdminUserService(ctrl)
+		admin := createTestAdmin(t, entity.AdminRoleOps, "password123")
+		adminUserRepo.EXPECT().FindAdminUserByEmail("ops@example.com").Return(admin, nil)
+		adminUserRepo.EXPECT().UpdateAdminLastLogin("admin-123", gomock.Any()).Return(nil)
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).Return(nil)
+		adminUserRepo.EXPECT().FindAdminUserByID("admin-123").Return(admin, nil)
+
+		// Act
+		resp, err := s.Login(request.AdminLoginRequest{Email: " OPS@example.com ", Password: "password123"})
+		assert.NoError(t, err)
+		actor, err := s.Authenticate(resp.AccessToken)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer", resp.TokenType)
+		assert.Equal(t, int64(3600), resp.ExpiresIn)
+		assert.Equal(t, service.AdminActor{ID: "admin-123", Email: "ops@example.com", Role: entity.AdminRoleOps}, *actor)
+	})
+
+	t.Run("Error - Wrong password", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		adminUserRepo.EXPECT().FindAdminUserByEmail("ops@example.com").
+			Return(createTestAdmin(t, entity.AdminRoleOps, "password123"), nil)
+
+		// Act
+		resp, err := s.Login(request.AdminLoginRequest{Email: "ops@example.com", Password: "wrong-password"})
+
+		// Assert
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrInvalidCredentials, err)
+	})
+
+	t.Run("Error - Deactivated admin", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		admin := createTestAdmin(t, entity.AdminRoleOps, "password123")
+		admin.IsActive = false
+		adminUserRepo.EXPECT().FindAdminUserByEmail("ops@example.com").Return(admin, nil)
+
+		// Act
+		resp, err := s.Login(request.AdminLoginRequest{Email: "ops@example.com", Password: "password123"})
+
+		// Assert
+		assert.Nil(t, resp)
+		assert.Equal(t, service.ErrInvalidCredentials, err)
+	})
+}
+
+func TestAdminUserService_Authenticate(t *testing.T) {
+	t.Run("Error - Admin deactivated after signing in", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		token, err := s.signToken("admin-123", time.Now())
+		assert.NoError(t, err)
+
+		admin := createTestAdmin(t, entity.AdminRoleOps, "password123")
+		admin.IsActive = false
+		adminUserRepo.EXPECT().FindAdminUserByID("admin-123").Return(admin, nil)
+
+		// Act
+		actor, err := s.Authenticate(token)
+
+		// Assert
+		assert.Nil(t, actor)
+		assert.Equal(t, service.ErrInvalidToken, err)
+	})
+
+	t.Run("Error - Token signed with another secret", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		other := NewAdminUserService(nil, nil, "other-secret", time.Hour)
+		token, err := other.signToken("admin-123", time.Now())
+		assert.NoError(t, err)
+
+		s, _, _ := createTestAdminUserService(ctrl)
+
+		// Act
+		actor, err := s.Authenticate(token)
+
+		// Assert
+		assert.Nil(t, actor)
+		assert.Equal(t, service.ErrInvalidToken, err)
+	})
+}
+
+func TestAdminUserService_CreateAdminUser(t *testing.T) {
+	t.Run("Success - Admin created and recorded in the audit log", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, auditLogRepo := createTestAdminUserService(ctrl)
+		var auditLog *entity.AdminAuditLog
+		adminUserRepo.EXPECT().CreateAdminUser(gomock.Any()).Return(nil)
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).Do(func(log *entity.AdminAuditLog) { auditLog = log }).Return(nil)
+
+		// Act
+		admin, err := s.CreateAdminUser(testActor, request.CreateAdminUserRequest{
+			Name:     "Finance",
+			Email:    "Finance@Example.com",
+			Password: "password123",
+			Role:     entity.AdminRoleFinance,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "finance@example.com", admin.Email)
+		assert.True(t, admin.IsActive)
+		assert.Equal(t, "admin_user.create", auditLog.Action)
+		assert.Equal(t, "owner-1", auditLog.ActorID)
+		assert.NotContains(t, auditLog.Changes, "password_hash")
+	})
+
+	t.Run("Error - Email already used", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		adminUserRepo.EXPECT().CreateAdminUser(gomock.Any()).Return(repository.ErrDuplicateAdminEmail)
+
+		// Act
+		admin, err := s.CreateAdminUser(testActor, request.CreateAdminUserRequest{
+			Name:     "Ops",
+			Email:    "ops@example.com",
+			Password: "password123",
+			Role:     entity.AdminRoleOps,
+		})
+
+		// Assert
+		assert.Nil(t, admin)
+		assert.Equal(t, service.ErrDuplicateAdminEmail, err)
+	})
+}
+
+func TestAdminUserService_UpdateAdminUser(t *testing.T) {
+	t.Run("Success - Role change is recorded without the password hash", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, auditLogRepo := createTestAdminUserService(ctrl)
+		admin := createTestAdmin(t, entity.AdminRoleOps, "password123")
+		var auditLog *entity.AdminAuditLog
+		adminUserRepo.EXPECT().FindAdminUserByID("admin-123").Return(admin, nil)
+		adminUserRepo.EXPECT().UpdateAdminUser(admin).Return(nil)
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).Do(func(log *entity.AdminAuditLog) { auditLog = log }).Return(nil)
+
+		// Act
+		result, err := s.UpdateAdminUser(testActor, "admin-123", request.UpdateAdminUserRequest{
+			Name:     "Ops",
+			Role:     entity.AdminRoleCatalogEditor,
+			Password: "new-password",
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.AdminRoleCatalogEditor, result.Role)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(result.PasswordHash), []byte("new-password")))
+		assert.Equal(t, map[string]interface{}{"before": "ops", "after": "catalog_editor"}, auditLog.Changes["role"])
+		assert.Equal(t, map[string]interface{}{"before": nil, "after": true}, auditLog.Changes["password_changed"])
+		assert.NotContains(t, auditLog.Changes, "password_hash")
+	})
+
+	t.Run("Error - Last active owner cannot be demoted", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		adminUserRepo.EXPECT().FindAdminUserByID("admin-123").
+			Return(createTestAdmin(t, entity.AdminRoleOwner, "password123"), nil)
+		adminUserRepo.EXPECT().CountActiveAdminsByRole(entity.AdminRoleOwner).Return(int64(1), nil)
+
+		// Act
+		result, err := s.UpdateAdminUser(testActor, "admin-123", request.UpdateAdminUserRequest{
+			Name: "Owner",
+			Role: entity.AdminRoleOps,
+		})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, service.ErrLastOwner, err)
+	})
+
+	t.Run("Error - Admin not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		adminUserRepo.EXPECT().FindAdminUserByID("missing").Return(nil, nil)
+
+		// Act
+		result, err := s.UpdateAdminUser(testActor, "missing", request.UpdateAdminUserRequest{
+			Name: "Ops",
+			Role: entity.AdminRoleOps,
+		})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, service.ErrAdminUserNotFound, err)
+	})
+}
+
+func TestAdminUserService_BootstrapOwner(t *testing.T) {
+	t.Run("Success - Owner created when there are no admins", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		var created *entity.AdminUser
+		adminUserRepo.EXPECT().CountAdminUsers().Return(int64(0), nil)
+		adminUserRepo.EXPECT().CreateAdminUser(gomock.Any()).Do(func(admin *entity.AdminUser) { created = admin }).Return(nil)
+
+		// Act
+		err := s.BootstrapOwner("owner@example.com", "password123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.AdminRoleOwner, created.Role)
+		assert.Equal(t, "owner@example.com", created.Email)
+	})
+
+	t.Run("Success - Nothing happens once admins exist", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, adminUserRepo, _ := createTestAdminUserService(ctrl)
+		adminUserRepo.EXPECT().CountAdminUsers().Return(int64(2), nil)
+
+		// Act
+		err := s.BootstrapOwner("owner@example.com", "password123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,49 @@
+package adminuser
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/repository/util"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const defaultTokenTTL = 8 * time.Hour
+
+type AdminUserService struct {
+	adminUserRepo repository.AdminUserRepository
+	auditLogRepo  repository.AuditLogRepository
+	jwtSecret     []byte
+	tokenTTL      time.Duration
+	bcryptCost    int
+
+	// dummyHash is compared against when an email is unknown so login takes
+	// the same time whether or not the account exists
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+}
+
+// New creates an AdminUserService following the same pattern as other services.
+// Admin tokens are signed with the same secret as customer tokens but carry their
+// own type, so neither can be used in place of the other.
+func New(cfg *config.AppConfig, repo *util.RepoWrapper) *AdminUserService {
+	return NewAdminUserService(repo.AdminUserRepo, repo.AuditLogRepo, cfg.JWTSecret,
+		time.Duration(cfg.AdminTokenTTLMinutes)*time.Minute)
+}
+
+// NewAdminUserService creates an AdminUserService. A zero TTL falls back to eight hours.
+func NewAdminUserService(adminUserRepo repository.AdminUserRepository, auditLogRepo repository.AuditLogRepository, jwtSecret string, tokenTTL time.Duration) *AdminUserService {
+	if tokenTTL <= 0 {
+		tokenTTL = defaultTokenTTL
+	}
+
+	return &AdminUserService{
+		adminUserRepo: adminUserRepo,
+		auditLogRepo:  auditLogRepo,
+		jwtSecret:     []byte(jwtSecret),
+		tokenTTL:      tokenTTL,
+		bcryptCost:    bcrypt.DefaultCost,
+	}
+}
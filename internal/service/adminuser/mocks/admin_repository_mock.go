@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/admin.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+	repository "github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// MockAdminUserRepository is a mock of AdminUserRepository interface.
+type MockAdminUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminUserRepositoryMockRecorder
+}
+
+// MockAdminUserRepositoryMockRecorder is the mock recorder for MockAdminUserRepository.
+type MockAdminUserRepositoryMockRecorder struct {
+	mock *MockAdminUserRepository
+}
+
+// NewMockAdminUserRepository creates a new mock instance.
+func NewMockAdminUserRepository(ctrl *gomock.Controller) *MockAdminUserRepository {
+	mock := &MockAdminUserRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminUserRepository) EXPECT() *MockAdminUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActiveAdminsByRole mocks base method.
+func (m *MockAdminUserRepository) CountActiveAdminsByRole(role string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveAdminsByRole", role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveAdminsByRole indicates an expected call of CountActiveAdminsByRole.
+func (mr *MockAdminUserRepositoryMockRecorder) CountActiveAdminsByRole(role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveAdminsByRole", reflect.TypeOf((*MockAdminUserRepository)(nil).CountActiveAdminsByRole), role)
+}
+
+// CountAdminUsers mocks base method.
+func (m *MockAdminUserRepository) CountAdminUsers() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAdminUsers")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAdminUsers indicates an expected call of CountAdminUsers.
+func (mr *MockAdminUserRepositoryMockRecorder) CountAdminUsers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAdminUsers", reflect.TypeOf((*MockAdminUserRepository)(nil).CountAdminUsers))
+}
+
+// CreateAdminUser mocks base method.
+func (m *MockAdminUserRepository) CreateAdminUser(user *entity.AdminUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminUser", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAdminUser indicates an expected call of CreateAdminUser.
+func (mr *MockAdminUserRepositoryMockRecorder) CreateAdminUser(user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminUser", reflect.TypeOf((*MockAdminUserRepository)(nil).CreateAdminUser), user)
+}
+
+// FindAdminUserByEmail mocks base method.
+func (m *MockAdminUserRepository) FindAdminUserByEmail(email string) (*entity.AdminUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminUserByEmail", email)
+	ret0, _ := ret[0].(*entity.AdminUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminUserByEmail indicates an expected call of FindAdminUserByEmail.
+func (mr *MockAdminUserRepositoryMockRecorder) FindAdminUserByEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminUserByEmail", reflect.TypeOf((*MockAdminUserRepository)(nil).FindAdminUserByEmail), email)
+}
+
+// FindAdminUserByID mocks base method.
+func (m *MockAdminUserRepository) FindAdminUserByID(id string) (*entity.AdminUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminUserByID", id)
+	ret0, _ := ret[0].(*entity.AdminUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminUserByID indicates an expected call of FindAdminUserByID.
+func (mr *MockAdminUserRepositoryMockRecorder) FindAdminUserByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminUserByID", reflect.TypeOf((*MockAdminUserRepository)(nil).FindAdminUserByID), id)
+}
+
+// FindAdminUsers mocks base method.
+func (m *MockAdminUserRepository) FindAdminUsers() ([]entity.AdminUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdminUsers")
+	ret0, _ := ret[0].([]entity.AdminUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdminUsers indicates an expected call of FindAdminUsers.
+func (mr *MockAdminUserRepositoryMockRecorder) FindAdminUsers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdminUsers", reflect.TypeOf((*MockAdminUserRepository)(nil).FindAdminUsers))
+}
+
+// UpdateAdminLastLogin mocks base method.
+func (m *MockAdminUserRepository) UpdateAdminLastLogin(id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdminLastLogin", id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAdminLastLogin indicates an expected call of UpdateAdminLastLogin.
+func (mr *MockAdminUserRepositoryMockRecorder) UpdateAdminLastLogin(id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdminLastLogin", reflect.TypeOf((*MockAdminUserRepository)(nil).UpdateAdminLastLogin), id, at)
+}
+
+// UpdateAdminUser mocks base method.
+func (m *MockAdminUserRepository) UpdateAdminUser(user *entity.AdminUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdminUser", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAdminUser indicates an expected call of UpdateAdminUser.
+func (mr *MockAdminUserRepositoryMockRecorder) UpdateAdminUser(user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdminUser", reflect.TypeOf((*MockAdminUserRepository)(nil).UpdateAdminUser), user)
+}
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogRepositoryMockRecorder
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+	mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockAuditLogRepository) CreateAuditLog(log *entity.AdminAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockAuditLogRepositoryMockRecorder) CreateAuditLog(log interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditLogRepository)(nil).CreateAuditLog), log)
+}
+
+// FindAuditLogs mocks base method.
+func (m *MockAuditLogRepository) FindAuditLogs(filter repository.AuditLogFilter, offset, limit int) ([]entity.AdminAuditLog, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuditLogs", filter, offset, limit)
+	ret0, _ := ret[0].([]entity.AdminAuditLog)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAuditLogs indicates an expected call of FindAuditLogs.
+func (mr *MockAuditLogRepositoryMockRecorder) FindAuditLogs(filter, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuditLogs", reflect.TypeOf((*MockAuditLogRepository)(nil).FindAuditLogs), filter, offset, limit)
+}
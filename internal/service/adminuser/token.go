@@ -0,0 +1,57 @@
+package adminuser
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+const tokenTypeAdmin = "admin"
+
+// tokenClaims are the claims of an admin access token. The admin ID is the subject.
+type tokenClaims struct {
+	TokenType string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+func (s *AdminUserService) signToken(adminID string, issuedAt time.Time) (string, error) {
+	if len(s.jwtSecret) == 0 {
+		return "", fmt.Errorf("jwt secret is not configured")
+	}
+
+	claims := tokenClaims{
+		TokenType: tokenTypeAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   adminID,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(s.tokenTTL)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtSecret)
+}
+
+// parseToken verifies the signature, expiry and type of an admin token and returns the admin ID
+func (s *AdminUserService) parseToken(token string) (string, error) {
+	if len(s.jwtSecret) == 0 || token == "" {
+		return "", service.ErrInvalidToken
+	}
+
+	claims := &tokenClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return s.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !parsed.Valid {
+		return "", service.ErrInvalidToken
+	}
+
+	if claims.TokenType != tokenTypeAdmin || claims.Subject == "" {
+		return "", service.ErrInvalidToken
+	}
+
+	return claims.Subject, nil
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func (s *AuditLogService) ListAuditLogs(req request.AuditLogListRequest) (*response.AuditLogListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	filter := repository.AuditLogFilter{
+		ActorID:    strings.TrimSpace(req.ActorID),
+		Action:     strings.TrimSpace(req.Action),
+		TargetType: strings.TrimSpace(req.TargetType),
+		TargetID:   strings.TrimSpace(req.TargetID),
+	}
+
+	logs, total, err := s.auditLogRepo.FindAuditLogs(filter, (page-1)*limit, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %v", err)
+	}
+	if logs == nil {
+		logs = []entity.AdminAuditLog{}
+	}
+
+	return &response.AuditLogListResponse{
+		Logs:       logs,
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+	}, nil
+}
@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/repository/util"
+)
+
+type AuditLogService struct {
+	auditLogRepo repository.AuditLogRepository
+}
+
+func New(cfg *config.AppConfig, repo *util.RepoWrapper) *AuditLogService {
+	return &AuditLogService{
+		auditLogRepo: repo.AuditLogRepo,
+	}
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+// ignoredFields change on every save and would only add noise to the diff
+var ignoredFields = map[string]bool{"updated_at": true}
+
+// Record saves an audit log entry for an admin action. before and after are the target as
+// it was and as it is now, nil for creates and deletes; only the fields that differ are kept.
+// A failure is logged rather than returned because the action itself has already been saved.
+func Record(repo repository.AuditLogRepository, actor service.AdminActor, action, targetType, targetID string, before, after interface{}) {
+	changes, err := Diff(before, after)
+	if err != nil {
+		log.Printf("failed to diff %s %s for audit log: %v", targetType, targetID, err)
+	}
+
+	entry := &entity.AdminAuditLog{
+		ID:         uuid.New().String(),
+		ActorID:    actor.ID,
+		ActorEmail: actor.Email,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	}
+	if err := repo.CreateAuditLog(entry); err != nil {
+		log.Printf("failed to record audit log %s for %s %s: %v", action, targetType, targetID, err)
+	}
+}
+
+// Diff compares the JSON form of before and after field by field and returns the fields
+// that differ as {"field": {"before": ..., "after": ...}}, or nil when nothing changed.
+func Diff(before, after interface{}) (entity.JSONMap, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := entity.JSONMap{}
+	for field := range beforeFields {
+		if _, ok := afterFields[field]; !ok {
+			afterFields[field] = nil
+		}
+	}
+	for field, value := range afterFields {
+		if ignoredFields[field] || reflect.DeepEqual(beforeFields[field], value) {
+			continue
+		}
+		changes[field] = map[string]interface{}{"before": beforeFields[field], "after": value}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return changes, nil
+}
+
+func toFields(v interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
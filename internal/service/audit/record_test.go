@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("Success - Only changed fields are kept", func(t *testing.T) {
+		// Arrange
+		before := &entity.Category{ID: "category-1", Name: "Jood", Slug: "jood", DisplayOrder: 1}
+		after := &entity.Category{ID: "category-1", Name: "Jood Pro", Slug: "jood", DisplayOrder: 1}
+
+		// Act
+		changes, err := Diff(before, after)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.JSONMap{"name": map[string]interface{}{"before": "Jood", "after": "Jood Pro"}}, changes)
+	})
+
+	t.Run("Success - Create has no before values", func(t *testing.T) {
+		// Act
+		changes, err := Diff(nil, map[string]string{"slug": "jood"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.JSONMap{"slug": map[string]interface{}{"before": nil, "after": "jood"}}, changes)
+	})
+
+	t.Run("Success - Delete has no after values", func(t *testing.T) {
+		// Act
+		changes, err := Diff(map[string]string{"slug": "jood"}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.JSONMap{"slug": map[string]interface{}{"before": "jood", "after": nil}}, changes)
+	})
+
+	t.Run("Success - Nothing changed", func(t *testing.T) {
+		// Act
+		changes, err := Diff(map[string]bool{"is_active": true}, map[string]bool{"is_active": true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, changes)
+	})
+}
+
+func TestRecord(t *testing.T) {
+	t.Run("Success - Entry names the actor, action and target", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockAuditLogRepository(ctrl)
+		var entry *entity.AdminAuditLog
+		repo.EXPECT().CreateAuditLog(gomock.Any()).Do(func(log *entity.AdminAuditLog) { entry = log }).Return(nil)
+
+		// Act
+		Record(repo, service.AdminActor{ID: "admin-1", Email: "ops@example.com"}, "order.status_change",
+			entity.AuditTargetOrder, "order-1", map[string]string{"order_status": "processing"}, map[string]string{"order_status": "shipped"})
+
+		// Assert
+		assert.NotEmpty(t, entry.ID)
+		assert.Equal(t, "admin-1", entry.ActorID)
+		assert.Equal(t, "ops@example.com", entry.ActorEmail)
+		assert.Equal(t, "order.status_change", entry.Action)
+		assert.Equal(t, "order", entry.TargetType)
+		assert.Equal(t, "order-1", entry.TargetID)
+		assert.Len(t, entry.Changes, 1)
+	})
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+)
+
+type AuthService interface {
+	Register(req request.RegisterRequest) (*response.AuthResponse, error)
+	Login(req request.LoginRequest) (*response.AuthResponse, error)
+	// Refresh rotates a refresh token: the old one is revoked and a new pair is issued
+	Refresh(req request.RefreshTokenRequest) (*response.AuthResponse, error)
+	// Logout revokes the refresh token. Unknown or already revoked tokens are ignored.
+	Logout(req request.LogoutRequest) error
+
+	// VerifyEmail marks the customer's email as verified using a mailed token
+	VerifyEmail(req request.VerifyEmailRequest) error
+	// ResendEmailVerification mails a new verification link to a signed-in customer
+	ResendEmailVerification(customerID string) error
+	// ForgotPassword mails a reset link when the email is registered. It succeeds
+	// either way so callers cannot learn which emails have accounts.
+	ForgotPassword(req request.ForgotPasswordRequest) error
+	// ResetPassword sets a new password using a mailed token and ends every session
+	ResetPassword(req request.ResetPasswordRequest) error
+
+	// ParseAccessToken validates an access token and returns the customer ID it was issued to
+	ParseAccessToken(token string) (string, error)
+}
+
+var (
+	// ErrEmailAlreadyRegistered is returned when registering with an email that already has an account
+	ErrEmailAlreadyRegistered = errors.New("email already registered")
+
+	// ErrInvalidCredentials is returned when the email or password is wrong
+	ErrInvalidCredentials = errors.New("invalid email or password")
+
+	// ErrInvalidToken is returned when a token is malformed, expired, revoked or of the wrong type
+	ErrInvalidToken = errors.New("invalid or expired token")
+
+	// ErrEmailAlreadyVerified is returned when asking for a verification link for a verified email
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
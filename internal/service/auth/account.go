@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (s *AuthService) VerifyEmail(req request.VerifyEmailRequest) error {
+	token, err := s.customerRepo.ConsumeActionToken(entity.CustomerTokenEmailVerification, hashToken(req.Token), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to consume verification token: %v", err)
+	}
+	if token == nil {
+		return service.ErrInvalidToken
+	}
+
+	if err := s.customerRepo.MarkEmailVerified(token.CustomerID); err != nil {
+		return fmt.Errorf("failed to mark email verified: %v", err)
+	}
+
+	return nil
+}
+
+func (s *AuthService) ResendEmailVerification(customerID string) error {
+	customer, err := s.customerRepo.FindCustomerByID(customerID)
+	if err != nil {
+		return fmt.Errorf("failed to find customer: %v", err)
+	}
+	if customer == nil {
+		return service.ErrInvalidToken
+	}
+	if customer.IsEmailVerified {
+		return service.ErrEmailAlreadyVerified
+	}
+
+	return s.sendEmailVerification(customer)
+}
+
+func (s *AuthService) ForgotPassword(req request.ForgotPasswordRequest) error {
+	customer, err := s.customerRepo.FindCustomerByEmail(normalizeEmail(req.Email))
+	if err != nil {
+		return fmt.Errorf("failed to find customer: %v", err)
+	}
+	if customer == nil {
+		// Report success so the response doesn't reveal whether the email is registered
+		return nil
+	}
+
+	token, err := s.createActionToken(customer.ID, entity.CustomerTokenPasswordReset, s.resetTTL)
+	if err != nil {
+		return err
+	}
+
+	s.runAsync(func() {
+		if err := s.mailer.SendPasswordReset(customer, token, s.resetTTL); err != nil {
+			log.Printf("failed to send password reset email to customer %s: %v", customer.ID, err)
+		}
+	})
+
+	return nil
+}
+
+func (s *AuthService) ResetPassword(req request.ResetPasswordRequest) error {
+	// Hash first so a bcrypt failure doesn't burn the token
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), s.bcryptCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %v", err)
+	}
+
+	// The token, the new password and the revoked sessions are saved together
+	token, err := s.customerRepo.ResetPassword(hashToken(req.Token), string(passwordHash), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %v", err)
+	}
+	if token == nil {
+		return service.ErrInvalidToken
+	}
+
+	return nil
+}
+
+// sendEmailVerification issues a verification token and mails it in the background
+func (s *AuthService) sendEmailVerification(customer *entity.Customer) error {
+	token, err := s.createActionToken(customer.ID, entity.CustomerTokenEmailVerification, s.verificationTTL)
+	if err != nil {
+		return err
+	}
+
+	s.runAsync(func() {
+		if err := s.mailer.SendEmailVerification(customer, token, s.verificationTTL); err != nil {
+			log.Printf("failed to send verification email to customer %s: %v", customer.ID, err)
+		}
+	})
+
+	return nil
+}
+
+// createActionToken stores the hash of a new single-use token and returns the raw token for the email
+func (s *AuthService) createActionToken(customerID, purpose string, ttl time.Duration) (string, error) {
+	token, err := newActionToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %v", err)
+	}
+
+	now := time.Now()
+	if err := s.customerRepo.CreateActionToken(&entity.CustomerActionToken{
+		ID:         uuid.New().String(),
+		CustomerID: customerID,
+		Purpose:    purpose,
+		TokenHash:  hashToken(token),
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+	}); err != nil {
+		return "", fmt.Errorf("failed to store token: %v", err)
+	}
+
+	return token, nil
+}
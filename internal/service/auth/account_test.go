@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/auth/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	t.Run("Success - Email is marked verified", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().ConsumeActionToken(entity.CustomerTokenEmailVerification, hashToken("raw-token"), gomock.Any()).
+			Return(&entity.CustomerActionToken{CustomerID: "customer-123"}, nil)
+		mockRepo.EXPECT().MarkEmailVerified("customer-123").Return(nil)
+
+		// Act
+		err := s.VerifyEmail(request.VerifyEmailRequest{Token: "raw-token"})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error - Token is unknown, used or expired", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().ConsumeActionToken(entity.CustomerTokenEmailVerification, hashToken("raw-token"), gomock.Any()).Return(nil, nil)
+
+		// Act
+		err := s.VerifyEmail(request.VerifyEmailRequest{Token: "raw-token"})
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
+func TestAuthService_ResendEmailVerification(t *testing.T) {
+	t.Run("Success - New link is mailed", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+		customer := &entity.Customer{ID: "customer-123", Email: "john@example.com"}
+
+		mockRepo.EXPECT().FindCustomerByID("customer-123").Return(customer, nil)
+		mockRepo.EXPECT().CreateActionToken(gomock.Any()).Return(nil)
+		mockMailer.EXPECT().SendEmailVerification(customer, gomock.Any(), 24*time.Hour).Return(nil)
+
+		// Act
+		err := s.ResendEmailVerification("customer-123")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error - Email already verified", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByID("customer-123").Return(&entity.Customer{ID: "customer-123", IsEmailVerified: true}, nil)
+
+		// Act
+		err := s.ResendEmailVerification("customer-123")
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrEmailAlreadyVerified)
+	})
+}
+
+func TestAuthService_ForgotPassword(t *testing.T) {
+	t.Run("Success - Reset link is mailed to a registered email", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+		customer := &entity.Customer{ID: "customer-123", Email: "john@example.com"}
+
+		var stored *entity.CustomerActionToken
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(customer, nil)
+		mockRepo.EXPECT().CreateActionToken(gomock.Any()).Do(func(token *entity.CustomerActionToken) { stored = token }).Return(nil)
+		mockMailer.EXPECT().SendPasswordReset(customer, gomock.Any(), time.Hour).
+			Do(func(_ *entity.Customer, token string, _ time.Duration) {
+				assert.Len(t, token, 64)
+				assert.Equal(t, hashToken(token), stored.TokenHash)
+			}).Return(nil)
+
+		// Act
+		err := s.ForgotPassword(request.ForgotPasswordRequest{Email: "John@Example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.CustomerTokenPasswordReset, stored.Purpose)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+	})
+
+	t.Run("Success - Unknown email gets the same response without an email", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+
+		mockRepo.EXPECT().FindCustomerByEmail("nobody@example.com").Return(nil, nil)
+
+		// Act
+		err := s.ForgotPassword(request.ForgotPasswordRequest{Email: "nobody@example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Mail failure is not reported to the caller", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(&entity.Customer{ID: "customer-123"}, nil)
+		mockRepo.EXPECT().CreateActionToken(gomock.Any()).Return(nil)
+		mockMailer.EXPECT().SendPasswordReset(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+
+		// Act
+		err := s.ForgotPassword(request.ForgotPasswordRequest{Email: "john@example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	t.Run("Success - Password is changed and sessions are revoked", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		var newHash string
+		mockRepo.EXPECT().ResetPassword(hashToken("raw-token"), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_, hash string, _ time.Time) (*entity.CustomerActionToken, error) {
+				newHash = hash
+				return &entity.CustomerActionToken{CustomerID: "customer-123"}, nil
+			})
+
+		// Act
+		err := s.ResetPassword(request.ResetPasswordRequest{Token: "raw-token", Password: "new-password"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new-password")))
+	})
+
+	t.Run("Error - Token is unknown, used or expired", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().ResetPassword(hashToken("raw-token"), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		// Act
+		err := s.ResetPassword(request.ResetPasswordRequest{Token: "raw-token", Password: "new-password"})
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Failed save is returned", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().ResetPassword(hashToken("raw-token"), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+
+		// Act
+		err := s.ResetPassword(request.ResetPasswordRequest{Token: "raw-token", Password: "new-password"})
+
+		// Assert
+		assert.EqualError(t, err, "failed to reset password: database error")
+	})
+}
@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (s *AuthService) Register(req request.RegisterRequest) (*response.AuthResponse, error) {
+	email := normalizeEmail(req.Email)
+
+	existing, err := s.customerRepo.FindCustomerByEmail(email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email: %v", err)
+	}
+	if existing != nil {
+		return nil, service.ErrEmailAlreadyRegistered
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), s.bcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %v", err)
+	}
+
+	now := time.Now()
+	customer := &entity.Customer{
+		ID:           uuid.New().String(),
+		FirstName:    strings.TrimSpace(req.FirstName),
+		LastName:     strings.TrimSpace(req.LastName),
+		Email:        email,
+		PhoneNumber:  strings.TrimSpace(req.PhoneNumber),
+		PasswordHash: string(passwordHash),
+		LastLoginAt:  &now,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := s.customerRepo.CreateCustomer(customer); err != nil {
+		if errors.Is(err, repository.ErrDuplicateEmail) {
+			return nil, service.ErrEmailAlreadyRegistered
+		}
+		return nil, fmt.Errorf("failed to create customer: %v", err)
+	}
+
+	// The account is usable right away; a failed verification email can be resent later
+	if err := s.sendEmailVerification(customer); err != nil {
+		log.Printf("failed to start email verification for customer %s: %v", customer.ID, err)
+	}
+
+	return s.issueTokens(customer)
+}
+
+func (s *AuthService) Login(req request.LoginRequest) (*response.AuthResponse, error) {
+	customer, err := s.customerRepo.FindCustomerByEmail(normalizeEmail(req.Email))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer: %v", err)
+	}
+
+	if customer == nil || customer.PasswordHash == "" {
+		// Spend the same time as a real check so response times don't reveal registered emails
+		_ = bcrypt.CompareHashAndPassword(s.getDummyHash(), []byte(req.Password))
+		return nil, service.ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(customer.PasswordHash), []byte(req.Password)); err != nil {
+		return nil, service.ErrInvalidCredentials
+	}
+
+	now := time.Now()
+	if err := s.customerRepo.UpdateLastLogin(customer.ID, now); err != nil {
+		log.Printf("failed to update last login for customer %s: %v", customer.ID, err)
+	}
+	customer.LastLoginAt = &now
+
+	return s.issueTokens(customer)
+}
+
+func (s *AuthService) Refresh(req request.RefreshTokenRequest) (*response.AuthResponse, error) {
+	claims, err := s.parseToken(req.RefreshToken, tokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.customerRepo.FindRefreshToken(claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refresh token: %v", err)
+	}
+	if stored == nil || stored.CustomerID != claims.Subject ||
+		subtle.ConstantTimeCompare([]byte(stored.TokenHash), []byte(hashToken(req.RefreshToken))) != 1 {
+		return nil, service.ErrInvalidToken
+	}
+
+	now := time.Now()
+	if stored.RevokedAt != nil {
+		// A rotated token being used again means it was copied; end every session of the customer
+		log.Printf("revoked refresh token %s reused, revoking all sessions of customer %s", stored.ID, stored.CustomerID)
+		if err := s.customerRepo.RevokeCustomerRefreshTokens(stored.CustomerID, now); err != nil {
+			log.Printf("failed to revoke refresh tokens of customer %s: %v", stored.CustomerID, err)
+		}
+		return nil, service.ErrInvalidToken
+	}
+	if now.After(stored.ExpiresAt) {
+		return nil, service.ErrInvalidToken
+	}
+
+	revoked, err := s.customerRepo.RevokeRefreshToken(stored.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke refresh token: %v", err)
+	}
+	if !revoked {
+		// Another request rotated this token first
+		return nil, service.ErrInvalidToken
+	}
+
+	customer, err := s.customerRepo.FindCustomerByID(stored.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer: %v", err)
+	}
+	if customer == nil {
+		return nil, service.ErrInvalidToken
+	}
+
+	return s.issueTokens(customer)
+}
+
+func (s *AuthService) Logout(req request.LogoutRequest) error {
+	claims, err := s.parseToken(req.RefreshToken, tokenTypeRefresh)
+	if err != nil {
+		return nil
+	}
+
+	if _, err := s.customerRepo.RevokeRefreshToken(claims.ID, time.Now()); err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %v", err)
+	}
+
+	return nil
+}
+
+func (s *AuthService) ParseAccessToken(token string) (string, error) {
+	claims, err := s.parseToken(token, tokenTypeAccess)
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+// issueTokens signs a new access and refresh token pair and stores the refresh token hash
+func (s *AuthService) issueTokens(customer *entity.Customer) (*response.AuthResponse, error) {
+	now := time.Now()
+
+	accessToken, err := s.signToken(customer.ID, uuid.New().String(), tokenTypeAccess, now, now.Add(s.accessTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %v", err)
+	}
+
+	refreshID := uuid.New().String()
+	refreshExpiresAt := now.Add(s.refreshTTL)
+	refreshToken, err := s.signToken(customer.ID, refreshID, tokenTypeRefresh, now, refreshExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign refresh token: %v", err)
+	}
+
+	if err := s.customerRepo.CreateRefreshToken(&entity.CustomerRefreshToken{
+		ID:         refreshID,
+		CustomerID: customer.ID,
+		TokenHash:  hashToken(refreshToken),
+		ExpiresAt:  refreshExpiresAt,
+		CreatedAt:  now,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %v", err)
+	}
+
+	return &response.AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.accessTTL.Seconds()),
+		Customer:     toCustomerResponse(customer),
+	}, nil
+}
+
+func (s *AuthService) getDummyHash() []byte {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not-a-real-password"), s.bcryptCost)
+	})
+	return s.dummyHash
+}
+
+func toCustomerResponse(customer *entity.Customer) response.CustomerResponse {
+	return response.CustomerResponse{
+		ID:              customer.ID,
+		FirstName:       customer.FirstName,
+		LastName:        customer.LastName,
+		Email:           customer.Email,
+		PhoneNumber:     customer.PhoneNumber,
+		IsEmailVerified: customer.IsEmailVerified,
+		LastLoginAt:     customer.LastLoginAt,
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
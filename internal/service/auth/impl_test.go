@@ -0,0 +1,443 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/auth/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testSecret = "test-secret"
+
+// Helper function to create a test auth service with a cheap bcrypt cost that sends emails synchronously
+func createTestAuthService(customerRepo repository.CustomerRepository, mailer repository.Mailer) *AuthService {
+	s := NewAuthService(customerRepo, testSecret, time.Minute, time.Hour)
+	s.bcryptCost = bcrypt.MinCost
+	s.mailer = mailer
+	s.runAsync = func(f func()) { f() }
+	return s
+}
+
+// Helper function to create a test customer with the given password
+func createTestCustomer(t *testing.T, password string) *entity.Customer {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return &entity.Customer{
+		ID:           "customer-123",
+		FirstName:    "John",
+		Email:        "john@example.com",
+		PasswordHash: string(hash),
+	}
+}
+
+func TestAuthService_Register(t *testing.T) {
+	t.Run("Success - Register new customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+
+		var created *entity.Customer
+		var verification *entity.CustomerActionToken
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(nil, nil)
+		mockRepo.EXPECT().CreateCustomer(gomock.Any()).Do(func(c *entity.Customer) { created = c }).Return(nil)
+		mockRepo.EXPECT().CreateActionToken(gomock.Any()).Do(func(token *entity.CustomerActionToken) { verification = token }).Return(nil)
+		mockMailer.EXPECT().SendEmailVerification(gomock.Any(), gomock.Any(), 24*time.Hour).
+			Do(func(_ *entity.Customer, token string, _ time.Duration) {
+				assert.Equal(t, hashToken(token), verification.TokenHash)
+			}).Return(nil)
+		mockRepo.EXPECT().CreateRefreshToken(gomock.Any()).Return(nil)
+
+		// Act
+		result, err := s.Register(request.RegisterRequest{
+			FirstName: "John",
+			Email:     " John@Example.com ",
+			Password:  "secret-password",
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "john@example.com", created.Email)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(created.PasswordHash), []byte("secret-password")))
+		assert.Equal(t, "Bearer", result.TokenType)
+		assert.Equal(t, int64(60), result.ExpiresIn)
+		assert.Equal(t, created.ID, result.Customer.ID)
+		assert.False(t, result.Customer.IsEmailVerified)
+		assert.Equal(t, entity.CustomerTokenEmailVerification, verification.Purpose)
+
+		customerID, err := s.ParseAccessToken(result.AccessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, customerID)
+	})
+
+	t.Run("Error - Email already registered", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(&entity.Customer{ID: "customer-123"}, nil)
+
+		// Act
+		result, err := s.Register(request.RegisterRequest{FirstName: "John", Email: "john@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrEmailAlreadyRegistered)
+	})
+
+	t.Run("Error - Email registered concurrently", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(nil, nil)
+		mockRepo.EXPECT().CreateCustomer(gomock.Any()).Return(repository.ErrDuplicateEmail)
+
+		// Act
+		result, err := s.Register(request.RegisterRequest{FirstName: "John", Email: "john@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrEmailAlreadyRegistered)
+	})
+
+	t.Run("Error - Missing JWT secret", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		s := createTestAuthService(mockRepo, mockMailer)
+		s.jwtSecret = nil
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(nil, nil)
+		mockRepo.EXPECT().CreateCustomer(gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateActionToken(gomock.Any()).Return(nil)
+		mockMailer.EXPECT().SendEmailVerification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		// Act
+		result, err := s.Register(request.RegisterRequest{FirstName: "John", Email: "john@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "jwt secret is not configured")
+	})
+}
+
+func TestAuthService_Login(t *testing.T) {
+	t.Run("Success - Valid credentials", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		customer := createTestCustomer(t, "secret-password")
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(customer, nil)
+		mockRepo.EXPECT().UpdateLastLogin("customer-123", gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateRefreshToken(gomock.Any()).Return(nil)
+
+		// Act
+		result, err := s.Login(request.LoginRequest{Email: "JOHN@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.AccessToken)
+		assert.NotEmpty(t, result.RefreshToken)
+		assert.NotNil(t, result.Customer.LastLoginAt)
+	})
+
+	t.Run("Error - Wrong password", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(createTestCustomer(t, "secret-password"), nil)
+
+		// Act
+		result, err := s.Login(request.LoginRequest{Email: "john@example.com", Password: "wrong-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	})
+
+	t.Run("Error - Unknown email gets the same error", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByEmail("nobody@example.com").Return(nil, nil)
+
+		// Act
+		result, err := s.Login(request.LoginRequest{Email: "nobody@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	})
+
+	t.Run("Error - Repository failure", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		mockRepo.EXPECT().FindCustomerByEmail("john@example.com").Return(nil, errors.New("database error"))
+
+		// Act
+		result, err := s.Login(request.LoginRequest{Email: "john@example.com", Password: "secret-password"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to find customer")
+	})
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	// issue signs a refresh token and returns it with the record the repository would hold
+	issue := func(t *testing.T, s *AuthService, expiresAt time.Time) (string, *entity.CustomerRefreshToken) {
+		token, err := s.signToken("customer-123", "token-1", tokenTypeRefresh, time.Now(), expiresAt)
+		assert.NoError(t, err)
+		return token, &entity.CustomerRefreshToken{
+			ID:         "token-1",
+			CustomerID: "customer-123",
+			TokenHash:  hashToken(token),
+			ExpiresAt:  expiresAt,
+		}
+	}
+
+	t.Run("Success - Token is rotated", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, stored := issue(t, s, time.Now().Add(time.Hour))
+
+		mockRepo.EXPECT().FindRefreshToken("token-1").Return(stored, nil)
+		mockRepo.EXPECT().RevokeRefreshToken("token-1", gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().FindCustomerByID("customer-123").Return(&entity.Customer{ID: "customer-123"}, nil)
+		mockRepo.EXPECT().CreateRefreshToken(gomock.Any()).Return(nil)
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: token})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEqual(t, token, result.RefreshToken)
+	})
+
+	t.Run("Error - Reused token revokes every session", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, stored := issue(t, s, time.Now().Add(time.Hour))
+		revokedAt := time.Now().Add(-time.Minute)
+		stored.RevokedAt = &revokedAt
+
+		mockRepo.EXPECT().FindRefreshToken("token-1").Return(stored, nil)
+		mockRepo.EXPECT().RevokeCustomerRefreshTokens("customer-123", gomock.Any()).Return(nil)
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: token})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Concurrent refresh loses the race", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, stored := issue(t, s, time.Now().Add(time.Hour))
+
+		mockRepo.EXPECT().FindRefreshToken("token-1").Return(stored, nil)
+		mockRepo.EXPECT().RevokeRefreshToken("token-1", gomock.Any()).Return(false, nil)
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: token})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Unknown token", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, _ := issue(t, s, time.Now().Add(time.Hour))
+
+		mockRepo.EXPECT().FindRefreshToken("token-1").Return(nil, nil)
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: token})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Access token cannot be used to refresh", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		accessToken, err := s.signToken("customer-123", "token-1", tokenTypeAccess, time.Now(), time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: accessToken})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Expired token", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, _ := issue(t, s, time.Now().Add(-time.Minute))
+
+		// Act
+		result, err := s.Refresh(request.RefreshTokenRequest{RefreshToken: token})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	t.Run("Success - Refresh token is revoked", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+		token, err := s.signToken("customer-123", "token-1", tokenTypeRefresh, time.Now(), time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		mockRepo.EXPECT().RevokeRefreshToken("token-1", gomock.Any()).Return(true, nil)
+
+		// Act
+		err = s.Logout(request.LogoutRequest{RefreshToken: token})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Invalid token is ignored", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockCustomerRepository(ctrl)
+		s := createTestAuthService(mockRepo, nil)
+
+		// Act
+		err := s.Logout(request.LogoutRequest{RefreshToken: "not-a-token"})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthService_ParseAccessToken(t *testing.T) {
+	t.Run("Error - Token signed with another secret", func(t *testing.T) {
+		// Arrange
+		other := NewAuthService(nil, "other-secret", time.Minute, time.Hour)
+		token, err := other.signToken("customer-123", "token-1", tokenTypeAccess, time.Now(), time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		s := createTestAuthService(nil, nil)
+
+		// Act
+		customerID, err := s.ParseAccessToken(token)
+
+		// Assert
+		assert.Empty(t, customerID)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Token signed with another method", func(t *testing.T) {
+		// Arrange
+		s := createTestAuthService(nil, nil)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS512, tokenClaims{
+			TokenType: tokenTypeAccess,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "token-1",
+				Subject:   "customer-123",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}).SignedString([]byte(testSecret))
+		assert.NoError(t, err)
+
+		// Act
+		customerID, err := s.ParseAccessToken(token)
+
+		// Assert
+		assert.Empty(t, customerID)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("Error - Refresh token is not an access token", func(t *testing.T) {
+		// Arrange
+		s := createTestAuthService(nil, nil)
+		token, err := s.signToken("customer-123", "token-1", tokenTypeRefresh, time.Now(), time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		// Act
+		customerID, err := s.ParseAccessToken(token)
+
+		// Assert
+		assert.Empty(t, customerID)
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/repository/util"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	defaultAccessTTL       = 15 * time.Minute
+	defaultRefreshTTL      = 30 * 24 * time.Hour
+	defaultVerificationTTL = 24 * time.Hour
+	defaultResetTTL        = time.Hour
+)
+
+type AuthService struct {
+	customerRepo repository.CustomerRepository
+	jwtSecret    []byte
+	accessTTL    time.Duration
+	refreshTTL   time.Duration
+	bcryptCost   int
+
+	mailer          repository.Mailer
+	verificationTTL time.Duration
+	resetTTL        time.Duration
+	// runAsync sends emails off the request path, so a reset request for a
+	// registered email takes no longer than one for an unknown email
+	runAsync func(func())
+
+	// dummyHash is compared against when an email is unknown so login takes
+	// the same time whether or not the account exists
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+}
+
+// New creates an AuthService following the same pattern as other services
+func New(cfg *config.AppConfig, repo *util.RepoWrapper) *AuthService {
+	s := NewAuthService(repo.CustomerRepo, cfg.JWTSecret,
+		time.Duration(cfg.JWTAccessTTLMinutes)*time.Minute,
+		time.Duration(cfg.JWTRefreshTTLHours)*time.Hour)
+	s.mailer = repo.MailRepo
+	if cfg.EmailVerificationTTLHours > 0 {
+		s.verificationTTL = time.Duration(cfg.EmailVerificationTTLHours) * time.Hour
+	}
+	if cfg.PasswordResetTTLMinutes > 0 {
+		s.resetTTL = time.Duration(cfg.PasswordResetTTLMinutes) * time.Minute
+	}
+	return s
+}
+
+// NewAuthService creates an AuthService. Zero TTLs fall back to 15 minutes for
+// access tokens and 30 days for refresh tokens. Verification links last 24 hours
+// and reset links one hour.
+func NewAuthService(customerRepo repository.CustomerRepository, jwtSecret string, accessTTL, refreshTTL time.Duration) *AuthService {
+	if accessTTL <= 0 {
+		accessTTL = defaultAccessTTL
+	}
+	if refreshTTL <= 0 {
+		refreshTTL = defaultRefreshTTL
+	}
+
+	return &AuthService{
+		customerRepo: customerRepo,
+		jwtSecret:    []byte(jwtSecret),
+		accessTTL:    accessTTL,
+		refreshTTL:   refreshTTL,
+		bcryptCost:   bcrypt.DefaultCost,
+
+		verificationTTL: defaultVerificationTTL,
+		resetTTL:        defaultResetTTL,
+		runAsync:        func(f func()) { go f() },
+	}
+}
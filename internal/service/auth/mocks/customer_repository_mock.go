@@ -0,0 +1,390 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/hanifbg/landing_backend/internal/repository (interfaces: CustomerRepository,Mailer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockCustomerRepository is a mock of CustomerRepository interface.
+type MockCustomerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerRepositoryMockRecorder
+}
+
+// MockCustomerRepositoryMockRecorder is the mock recorder for MockCustomerRepository.
+type MockCustomerRepositoryMockRecorder struct {
+	mock *MockCustomerRepository
+}
+
+// NewMockCustomerRepository creates a new mock instance.
+func NewMockCustomerRepository(ctrl *gomock.Controller) *MockCustomerRepository {
+	mock := &MockCustomerRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerRepository) EXPECT() *MockCustomerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeActionToken mocks base method.
+func (m *MockCustomerRepository) ConsumeActionToken(arg0, arg1 string, arg2 time.Time) (*entity.CustomerActionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeActionToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.CustomerActionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeActionToken indicates an expected call of ConsumeActionToken.
+func (mr *MockCustomerRepositoryMockRecorder) ConsumeActionToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeActionToken", reflect.TypeOf((*MockCustomerRepository)(nil).ConsumeActionToken), arg0, arg1, arg2)
+}
+
+// CreateActionToken mocks base method.
+func (m *MockCustomerRepository) CreateActionToken(arg0 *entity.CustomerActionToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActionToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateActionToken indicates an expected call of CreateActionToken.
+func (mr *MockCustomerRepositoryMockRecorder) CreateActionToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActionToken", reflect.TypeOf((*MockCustomerRepository)(nil).CreateActionToken), arg0)
+}
+
+// CreateAddress mocks base method.
+func (m *MockCustomerRepository) CreateAddress(arg0 *entity.CustomerAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAddress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAddress indicates an expected call of CreateAddress.
+func (mr *MockCustomerRepositoryMockRecorder) CreateAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddress", reflect.TypeOf((*MockCustomerRepository)(nil).CreateAddress), arg0)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockCustomerRepository) CreateCustomer(arg0 *entity.Customer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) CreateCustomer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).CreateCustomer), arg0)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockCustomerRepository) CreateRefreshToken(arg0 *entity.CustomerRefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockCustomerRepositoryMockRecorder) CreateRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockCustomerRepository)(nil).CreateRefreshToken), arg0)
+}
+
+// DeleteAddress mocks base method.
+func (m *MockCustomerRepository) DeleteAddress(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockCustomerRepositoryMockRecorder) DeleteAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockCustomerRepository)(nil).DeleteAddress), arg0, arg1)
+}
+
+// FindAddressByID mocks base method.
+func (m *MockCustomerRepository) FindAddressByID(arg0, arg1 string) (*entity.CustomerAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAddressByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.CustomerAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAddressByID indicates an expected call of FindAddressByID.
+func (mr *MockCustomerRepositoryMockRecorder) FindAddressByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAddressByID", reflect.TypeOf((*MockCustomerRepository)(nil).FindAddressByID), arg0, arg1)
+}
+
+// FindAddressesByCustomerID mocks base method.
+func (m *MockCustomerRepository) FindAddressesByCustomerID(arg0 string) ([]entity.CustomerAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAddressesByCustomerID", arg0)
+	ret0, _ := ret[0].([]entity.CustomerAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAddressesByCustomerID indicates an expected call of FindAddressesByCustomerID.
+func (mr *MockCustomerRepositoryMockRecorder) FindAddressesByCustomerID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAddressesByCustomerID", reflect.TypeOf((*MockCustomerRepository)(nil).FindAddressesByCustomerID), arg0)
+}
+
+// FindCustomerByEmail mocks base method.
+func (m *MockCustomerRepository) FindCustomerByEmail(arg0 string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomerByEmail", arg0)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomerByEmail indicates an expected call of FindCustomerByEmail.
+func (mr *MockCustomerRepositoryMockRecorder) FindCustomerByEmail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomerByEmail", reflect.TypeOf((*MockCustomerRepository)(nil).FindCustomerByEmail), arg0)
+}
+
+// FindCustomerByID mocks base method.
+func (m *MockCustomerRepository) FindCustomerByID(arg0 string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomerByID", arg0)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomerByID indicates an expected call of FindCustomerByID.
+func (mr *MockCustomerRepositoryMockRecorder) FindCustomerByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomerByID", reflect.TypeOf((*MockCustomerRepository)(nil).FindCustomerByID), arg0)
+}
+
+// FindRefreshToken mocks base method.
+func (m *MockCustomerRepository) FindRefreshToken(arg0 string) (*entity.CustomerRefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRefreshToken", arg0)
+	ret0, _ := ret[0].(*entity.CustomerRefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRefreshToken indicates an expected call of FindRefreshToken.
+func (mr *MockCustomerRepositoryMockRecorder) FindRefreshToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefreshToken", reflect.TypeOf((*MockCustomerRepository)(nil).FindRefreshToken), arg0)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockCustomerRepository) MarkEmailVerified(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockCustomerRepositoryMockRecorder) MarkEmailVerified(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockCustomerRepository)(nil).MarkEmailVerified), arg0)
+}
+
+// ResetPassword mocks base method.
+func (m *MockCustomerRepository) ResetPassword(arg0, arg1 string, arg2 time.Time) (*entity.CustomerActionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.CustomerActionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockCustomerRepositoryMockRecorder) ResetPassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockCustomerRepository)(nil).ResetPassword), arg0, arg1, arg2)
+}
+
+// RevokeCustomerRefreshTokens mocks base method.
+func (m *MockCustomerRepository) RevokeCustomerRefreshTokens(arg0 string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCustomerRefreshTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCustomerRefreshTokens indicates an expected call of RevokeCustomerRefreshTokens.
+func (mr *MockCustomerRepositoryMockRecorder) RevokeCustomerRefreshTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCustomerRefreshTokens", reflect.TypeOf((*MockCustomerRepository)(nil).RevokeCustomerRefreshTokens), arg0, arg1)
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *MockCustomerRepository) RevokeRefreshToken(arg0 string, arg1 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MockCustomerRepositoryMockRecorder) RevokeRefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MockCustomerRepository)(nil).RevokeRefreshToken), arg0, arg1)
+}
+
+// UpdateAddress mocks base method.
+func (m *MockCustomerRepository) UpdateAddress(arg0 *entity.CustomerAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAddress indicates an expected call of UpdateAddress.
+func (mr *MockCustomerRepositoryMockRecorder) UpdateAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddress", reflect.TypeOf((*MockCustomerRepository)(nil).UpdateAddress), arg0)
+}
+
+// UpdateLastLogin mocks base method.
+func (m *MockCustomerRepository) UpdateLastLogin(arg0 string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastLogin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastLogin indicates an expected call of UpdateLastLogin.
+func (mr *MockCustomerRepositoryMockRecorder) UpdateLastLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastLogin", reflect.TypeOf((*MockCustomerRepository)(nil).UpdateLastLogin), arg0, arg1)
+}
+
+// MockMailer is a mock of Mailer interface.
+type MockMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailerMockRecorder
+}
+
+// MockMailerMockRecorder is the mock recorder for MockMailer.
+type MockMailerMockRecorder struct {
+	mock *MockMailer
+}
+
+// NewMockMailer creates a new mock instance.
+func NewMockMailer(ctrl *gomock.Controller) *MockMailer {
+	mock := &MockMailer{ctrl: ctrl}
+	mock.recorder = &MockMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailer) Send(arg0, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerMockRecorder) Send(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), arg0, arg1, arg2, arg3)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockMailer) SendEmailVerification(arg0 *entity.Customer, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockMailerMockRecorder) SendEmailVerification(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockMailer)(nil).SendEmailVerification), arg0, arg1, arg2)
+}
+
+// SendOrderConfirmation mocks base method.
+func (m *MockMailer) SendOrderConfirmation(arg0 *entity.Order, arg1 []entity.OrderItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOrderConfirmation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOrderConfirmation indicates an expected call of SendOrderConfirmation.
+func (mr *MockMailerMockRecorder) SendOrderConfirmation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderConfirmation", reflect.TypeOf((*MockMailer)(nil).SendOrderConfirmation), arg0, arg1)
+}
+
+// SendPasswordReset mocks base method.
+func (m *MockMailer) SendPasswordReset(arg0 *entity.Customer, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordReset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordReset indicates an expected call of SendPasswordReset.
+func (mr *MockMailerMockRecorder) SendPasswordReset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordReset", reflect.TypeOf((*MockMailer)(nil).SendPasswordReset), arg0, arg1, arg2)
+}
+
+// SendShipmentDelivered mocks base method.
+func (m *MockMailer) SendShipmentDelivered(arg0 *entity.Order, arg1 *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendShipmentDelivered", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendShipmentDelivered indicates an expected call of SendShipmentDelivered.
+func (mr *MockMailerMockRecorder) SendShipmentDelivered(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendShipmentDelivered", reflect.TypeOf((*MockMailer)(nil).SendShipmentDelivered), arg0, arg1)
+}
+
+// SendShipmentDispatched mocks base method.
+func (m *MockMailer) SendShipmentDispatched(arg0 *entity.Order, arg1 *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendShipmentDispatched", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendShipmentDispatched indicates an expected call of SendShipmentDispatched.
+func (mr *MockMailerMockRecorder) SendShipmentDispatched(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendShipmentDispatched", reflect.TypeOf((*MockMailer)(nil).SendShipmentDispatched), arg0, arg1)
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+// tokenClaims are the claims of both access and refresh tokens.
+// The customer ID is the subject and the token ID is the jti.
+type tokenClaims struct {
+	TokenType string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+func (s *AuthService) signToken(customerID, tokenID, tokenType string, issuedAt, expiresAt time.Time) (string, error) {
+	if len(s.jwtSecret) == 0 {
+		return "", fmt.Errorf("jwt secret is not configured")
+	}
+
+	claims := tokenClaims{
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Subject:   customerID,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtSecret)
+}
+
+// parseToken verifies the signature, expiry and type of a token
+func (s *AuthService) parseToken(token, tokenType string) (*tokenClaims, error) {
+	if len(s.jwtSecret) == 0 || token == "" {
+		return nil, service.ErrInvalidToken
+	}
+
+	claims := &tokenClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return s.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !parsed.Valid {
+		return nil, service.ErrInvalidToken
+	}
+
+	if claims.TokenType != tokenType || claims.Subject == "" || claims.ID == "" {
+		return nil, service.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// hashToken returns the hex SHA-256 of a token, which is what gets stored
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// newActionToken returns a random token for email verification and password reset links
+func newActionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -11,4 +11,9 @@ type CartService interface {
 	RemoveItem(req request.RemoveItemRequest) (*response.CartResponse, error)
 	GetCart(cartID string) (*response.CartResponse, error)
 	ApplyDiscount(req request.ApplyDiscountRequest) (*response.CartResponse, error)
+	RemoveDiscount(req request.RemoveDiscountRequest) (*response.CartResponse, error)
+	// MergeGuestCart moves a guest cart's items into the customer's active cart when they
+	// log in and returns that cart. guestCartID may be empty. It returns nil when the
+	// customer has no cart.
+	MergeGuestCart(customerID, guestCartID string) (*response.CartResponse, error)
 }
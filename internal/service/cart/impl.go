@@ -1,6 +1,7 @@
 package cart
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
+	"gorm.io/gorm"
 )
 
 func (s *CartService) calculateCartTotals(cart *entity.Cart, discount *entity.Discount) (*response.CartResponse, error) {
@@ -46,12 +48,7 @@ func (s *CartService) calculateCartTotals(cart *entity.Cart, discount *entity.Di
 	}
 
 	if discount != nil {
-		var discountAmount float64
-		if discount.Type == "percentage" {
-			discountAmount = subtotalAmount * (discount.Value / 100)
-		} else { // fixed_amount
-			discountAmount = discount.Value
-		}
+		discountAmount := discount.AmountFor(subtotalAmount)
 		response.DiscountAmount = &discountAmount
 		response.DiscountCodeApplied = &discount.Code
 	}
@@ -63,13 +60,27 @@ func (s *CartService) AddItem(req request.AddItemRequest) (*response.CartRespons
 	var cart *entity.Cart
 	var err error
 
+	if req.CartID == "" && req.CustomerID != "" {
+		// Logged in customers keep one cart across devices
+		cart, err = s.cartRepo.FindActiveCartByCustomerID(req.CustomerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find cart: %v", err)
+		}
+		if cart != nil {
+			req.CartID = cart.ID
+		}
+	}
+
 	if req.CartID == "" {
 		cart = &entity.Cart{ID: uuid.New().String()}
+		if req.CustomerID != "" {
+			cart.CustomerID = &req.CustomerID
+		}
 		if err := s.cartRepo.CreateCart(cart); err != nil {
 			return nil, fmt.Errorf("failed to create cart: %v", err)
 		}
 		req.CartID = cart.ID
-	} else {
+	} else if cart == nil {
 		cart, err = s.cartRepo.FindCartByID(req.CartID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find cart: %v", err)
@@ -112,7 +123,7 @@ func (s *CartService) AddItem(req request.AddItemRequest) (*response.CartRespons
 		return nil, fmt.Errorf("failed to get updated cart: %v", err)
 	}
 
-	return s.calculateCartTotals(updatedCart, nil)
+	return s.buildCartResponse(updatedCart)
 }
 
 func (s *CartService) UpdateItemQuantity(req request.UpdateItemRequest) (*response.CartResponse, error) {
@@ -145,7 +156,7 @@ func (s *CartService) UpdateItemQuantity(req request.UpdateItemRequest) (*respon
 		return nil, fmt.Errorf("failed to get updated cart: %v", err)
 	}
 
-	return s.calculateCartTotals(updatedCart, nil)
+	return s.buildCartResponse(updatedCart)
 }
 
 func (s *CartService) RemoveItem(req request.RemoveItemRequest) (*response.CartResponse, error) {
@@ -163,7 +174,7 @@ func (s *CartService) RemoveItem(req request.RemoveItemRequest) (*response.CartR
 		return nil, fmt.Errorf("failed to get updated cart: %v", err)
 	}
 
-	return s.calculateCartTotals(updatedCart, nil)
+	return s.buildCartResponse(updatedCart)
 }
 
 func (s *CartService) GetCart(cartID string) (*response.CartResponse, error) {
@@ -172,7 +183,7 @@ func (s *CartService) GetCart(cartID string) (*response.CartResponse, error) {
 		return nil, fmt.Errorf("failed to get cart: %v", err)
 	}
 
-	return s.calculateCartTotals(cart, nil)
+	return s.buildCartResponse(cart)
 }
 
 func (s *CartService) ApplyDiscount(req request.ApplyDiscountRequest) (*response.CartResponse, error) {
@@ -186,35 +197,193 @@ func (s *CartService) ApplyDiscount(req request.ApplyDiscountRequest) (*response
 		return nil, fmt.Errorf("discount not found: %v", err)
 	}
 
+	// Calculate current subtotal
+	subtotal := 0.0
+	for _, item := range cart.CartItems {
+		subtotal += float64(item.Quantity) * item.ProductVariant.Price
+	}
+
 	// Validate discount
-	if !discount.IsActive {
-		return nil, fmt.Errorf("discount is not active")
+	if err := discount.Validate(subtotal, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Remember the discount so later cart changes keep it
+	if err := s.cartRepo.UpdateCartDiscount(cart.ID, &discount.Code); err != nil {
+		return nil, fmt.Errorf("failed to save discount: %v", err)
+	}
+	cart.DiscountCode = &discount.Code
+
+	return s.calculateCartTotals(cart, discount)
+}
+
+func (s *CartService) RemoveDiscount(req request.RemoveDiscountRequest) (*response.CartResponse, error) {
+	cart, err := s.cartRepo.GetCartWithItems(req.CartID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart: %v", err)
+	}
+
+	if cart.DiscountCode != nil {
+		if err := s.cartRepo.UpdateCartDiscount(cart.ID, nil); err != nil {
+			return nil, fmt.Errorf("failed to remove discount: %v", err)
+		}
+		cart.DiscountCode = nil
+	}
+
+	return s.calculateCartTotals(cart, nil)
+}
+
+func (s *CartService) MergeGuestCart(customerID, guestCartID string) (*response.CartResponse, error) {
+	customerCart, err := s.cartRepo.FindActiveCartByCustomerID(customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer cart: %v", err)
 	}
 
-	now := time.Now()
-	if now.Before(discount.StartsAt) {
-		return nil, fmt.Errorf("discount has not started yet")
+	var guestCart *entity.Cart
+	if guestCartID != "" && (customerCart == nil || customerCart.ID != guestCartID) {
+		guestCart, err = s.cartRepo.GetCartWithItems(guestCartID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cart: %v", err)
+		}
+		// Only an active cart that no customer owns can be merged
+		if !guestCart.IsActive || guestCart.CustomerID != nil {
+			guestCart = nil
+		}
 	}
 
-	if discount.ExpiresAt != nil {
-		if now.After(*discount.ExpiresAt) {
-			return nil, fmt.Errorf("discount has expired")
+	if guestCart == nil {
+		if customerCart == nil {
+			return nil, nil
 		}
+		return s.buildCartResponse(customerCart)
 	}
 
-	if discount.UsageLimit != 0 && discount.UsesCount >= discount.UsageLimit {
-		return nil, fmt.Errorf("discount usage limit reached")
+	if customerCart == nil {
+		// Nothing to merge into: the guest cart becomes the customer's cart
+		assigned, err := s.cartRepo.AssignCartToCustomer(guestCart.ID, customerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign cart: %v", err)
+		}
+		if !assigned {
+			return nil, nil
+		}
+		guestCart.CustomerID = &customerID
+		return s.buildCartResponse(guestCart)
+	}
+
+	merged, err := s.cartRepo.MergeCartItems(guestCart.ID, customerCart.ID, mergeCartItems(customerCart, guestCart))
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge cart: %v", err)
+	}
+
+	// Keep the customer's own discount; otherwise carry over the one applied as a guest
+	if merged && customerCart.DiscountCode == nil && guestCart.DiscountCode != nil {
+		if err := s.cartRepo.UpdateCartDiscount(customerCart.ID, guestCart.DiscountCode); err != nil {
+			return nil, fmt.Errorf("failed to save discount: %v", err)
+		}
+	}
+
+	updatedCart, err := s.cartRepo.GetCartWithItems(customerCart.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated cart: %v", err)
+	}
+
+	return s.buildCartResponse(updatedCart)
+}
+
+// mergeCartItems returns the customer cart items that change when the guest cart is
+// merged in. Quantities of the same variant are summed and capped at the variant's stock;
+// inactive or out of stock variants are left out.
+func mergeCartItems(customerCart, guestCart *entity.Cart) []entity.CartItem {
+	existing := make(map[string]entity.CartItem, len(customerCart.CartItems))
+	for _, item := range customerCart.CartItems {
+		existing[item.ProductVariantID] = item
+	}
+
+	changed := make([]entity.CartItem, 0, len(guestCart.CartItems))
+	for _, guestItem := range guestCart.CartItems {
+		variant := guestItem.ProductVariant
+		if variant == nil || !variant.IsActive {
+			continue
+		}
+
+		item, found := existing[guestItem.ProductVariantID]
+		if !found {
+			item = entity.CartItem{
+				CartID:           customerCart.ID,
+				ProductVariantID: guestItem.ProductVariantID,
+			}
+		}
+
+		quantity := item.Quantity + guestItem.Quantity
+		if quantity > variant.StockQuantity {
+			quantity = variant.StockQuantity
+		}
+		if quantity <= 0 || quantity == item.Quantity {
+			continue
+		}
+
+		item.Quantity = quantity
+		item.ProductVariant = nil
+		changed = append(changed, item)
+	}
+
+	return changed
+}
+
+// buildCartResponse calculates the cart totals with the cart's applied discount.
+// The discount is re-validated against the current cart first; when it no longer
+// applies it is cleared from the cart and the reason is reported in the response.
+func (s *CartService) buildCartResponse(cart *entity.Cart) (*response.CartResponse, error) {
+	if cart == nil || cart.DiscountCode == nil {
+		return s.calculateCartTotals(cart, nil)
+	}
+
+	code := *cart.DiscountCode
+	discount, reason, err := s.validateCartDiscount(cart, code)
+	if err != nil {
+		return nil, err
+	}
+	if discount != nil {
+		return s.calculateCartTotals(cart, discount)
+	}
+
+	if err := s.cartRepo.UpdateCartDiscount(cart.ID, nil); err != nil {
+		return nil, fmt.Errorf("failed to remove discount: %v", err)
+	}
+	cart.DiscountCode = nil
+
+	cartResponse, err := s.calculateCartTotals(cart, nil)
+	if err != nil {
+		return nil, err
+	}
+	cartResponse.DiscountRemoved = &response.RemovedDiscount{Code: code, Reason: reason}
+
+	return cartResponse, nil
+}
+
+// validateCartDiscount returns the discount when it still applies to the cart,
+// otherwise the reason it does not. An error means the discount could not be read,
+// and says nothing about whether it applies.
+func (s *CartService) validateCartDiscount(cart *entity.Cart, code string) (*entity.Discount, string, error) {
+	discount, err := s.cartRepo.GetDiscountByCode(code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "discount is no longer available", nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get discount: %v", err)
 	}
 
-	// Calculate current subtotal
 	subtotal := 0.0
 	for _, item := range cart.CartItems {
-		subtotal += float64(item.Quantity) * item.ProductVariant.Price
+		if item.ProductVariant != nil {
+			subtotal += float64(item.Quantity) * item.ProductVariant.Price
+		}
 	}
 
-	if discount.MinimumOrderAmount != 0 && subtotal < discount.MinimumOrderAmount {
-		return nil, fmt.Errorf("cart subtotal does not meet minimum order amount for discount")
+	if err := discount.Validate(subtotal, time.Now()); err != nil {
+		return nil, err.Error(), nil
 	}
 
-	return s.calculateCartTotals(cart, discount)
+	return discount, "", nil
 }
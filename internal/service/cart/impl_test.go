@@ -10,6 +10,7 @@ import (
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/service/cart/mocks"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 func createTestCartService(cartRepo *mocks.MockCartRepository) *CartService {
@@ -27,7 +28,7 @@ func createTestProductVariant() *entity.ProductVariant {
 		Price:         100.00,
 		StockQuantity: 10,
 		ImageURL:      "https://example.com/image.jpg",
-		Weight:        0.5,
+		Weight:        500,
 		AttributeValues: entity.JSONMap{
 			"color": "red",
 			"size":  "M",
@@ -264,6 +265,69 @@ func TestCartService_AddItem(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "failed to get product variant")
 	})
+
+	t.Run("Success - Logged in customer adds to their active cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		req := request.AddItemRequest{
+			CustomerID: "customer-123",
+			VariantID:  "variant-123",
+			Quantity:   1,
+		}
+
+		customerID := "customer-123"
+		cart := createTestCart()
+		cart.CustomerID = &customerID
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID("customer-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetProductVariantByID("variant-123").Return(createTestProductVariant(), nil)
+		mockCartRepo.EXPECT().FindCartItem("cart-123", "variant-123").Return(nil, errors.New("not found"))
+		mockCartRepo.EXPECT().CreateCartItem(gomock.Any()).Return(nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+
+		// Act
+		result, err := service.AddItem(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "cart-123", result.CartID)
+	})
+
+	t.Run("Success - Logged in customer without a cart gets a new cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		req := request.AddItemRequest{
+			CustomerID: "customer-123",
+			VariantID:  "variant-123",
+			Quantity:   1,
+		}
+
+		var created *entity.Cart
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID("customer-123").Return(nil, nil)
+		mockCartRepo.EXPECT().CreateCart(gomock.Any()).Do(func(cart *entity.Cart) { created = cart }).Return(nil)
+		mockCartRepo.EXPECT().GetProductVariantByID("variant-123").Return(createTestProductVariant(), nil)
+		mockCartRepo.EXPECT().FindCartItem(gomock.Any(), "variant-123").Return(nil, errors.New("not found"))
+		mockCartRepo.EXPECT().CreateCartItem(gomock.Any()).Return(nil)
+		mockCartRepo.EXPECT().GetCartWithItems(gomock.Any()).Return(createTestCartWithItems(), nil)
+
+		// Act
+		_, err := service.AddItem(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, created.CustomerID)
+		assert.Equal(t, "customer-123", *created.CustomerID)
+	})
 }
 
 func TestCartService_UpdateItemQuantity(t *testing.T) {
@@ -517,6 +581,7 @@ func TestCartService_ApplyDiscount(t *testing.T) {
 
 		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
 		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(discount, nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", gomock.Any()).Return(nil)
 
 		// Act
 		result, err := service.ApplyDiscount(req)
@@ -550,6 +615,7 @@ func TestCartService_ApplyDiscount(t *testing.T) {
 
 		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
 		mockCartRepo.EXPECT().GetDiscountByCode("FIXED20").Return(discount, nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", gomock.Any()).Return(nil)
 
 		// Act
 		result, err := service.ApplyDiscount(req)
@@ -677,6 +743,430 @@ func TestCartService_ApplyDiscount(t *testing.T) {
 	})
 }
 
+func TestCartService_RemoveDiscount(t *testing.T) {
+	t.Run("Success - Remove applied discount", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "TEST10"
+		cart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", nil).Return(nil)
+
+		// Act
+		result, err := service.RemoveDiscount(request.RemoveDiscountRequest{CartID: "cart-123"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Nil(t, result.DiscountAmount)
+		assert.Nil(t, result.DiscountCodeApplied)
+		assert.Nil(t, result.DiscountRemoved)
+	})
+
+	t.Run("Success - Cart without discount is left untouched", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+
+		// Act
+		result, err := service.RemoveDiscount(request.RemoveDiscountRequest{CartID: "cart-123"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Nil(t, result.DiscountAmount)
+	})
+
+	t.Run("Error - Cart not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		mockCartRepo.EXPECT().GetCartWithItems("invalid-cart").Return(nil, errors.New("cart not found"))
+
+		// Act
+		result, err := service.RemoveDiscount(request.RemoveDiscountRequest{CartID: "invalid-cart"})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to get cart")
+	})
+}
+
+func TestCartService_PersistedDiscount(t *testing.T) {
+	t.Run("Success - Valid discount is kept on GetCart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "TEST10"
+		cart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(createTestDiscount(), nil)
+
+		// Act
+		result, err := service.GetCart("cart-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result.DiscountAmount)
+		assert.Equal(t, 20.0, *result.DiscountAmount) // 10% of 200
+		assert.Equal(t, "TEST10", *result.DiscountCodeApplied)
+		assert.Nil(t, result.DiscountRemoved)
+	})
+
+	t.Run("Success - Expired discount is dropped with a reason", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "TEST10"
+		cart.DiscountCode = &code
+		discount := createTestDiscount()
+		past := time.Now().Add(-time.Hour)
+		discount.ExpiresAt = &past
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(discount, nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", nil).Return(nil)
+
+		// Act
+		result, err := service.GetCart("cart-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result.DiscountAmount)
+		assert.Nil(t, result.DiscountCodeApplied)
+		assert.NotNil(t, result.DiscountRemoved)
+		assert.Equal(t, "TEST10", result.DiscountRemoved.Code)
+		assert.Equal(t, "discount has expired", result.DiscountRemoved.Reason)
+	})
+
+	t.Run("Success - Discount is dropped when cart falls below minimum amount", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		req := request.RemoveItemRequest{
+			CartID:    "cart-123",
+			VariantID: "variant-123",
+		}
+
+		cartItem := &entity.CartItem{CartID: "cart-123", ProductVariantID: "variant-123", Quantity: 2}
+		emptyCart := createTestCart()
+		code := "TEST10"
+		emptyCart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().FindCartItem("cart-123", "variant-123").Return(cartItem, nil)
+		mockCartRepo.EXPECT().DeleteCartItem("cart-123", "variant-123").Return(nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(emptyCart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(createTestDiscount(), nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", nil).Return(nil)
+
+		// Act
+		result, err := service.RemoveItem(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result.DiscountRemoved)
+		assert.Equal(t, "cart subtotal does not meet minimum order amount for discount", result.DiscountRemoved.Reason)
+	})
+
+	t.Run("Success - Deactivated discount is dropped", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "GONE"
+		cart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("GONE").Return(nil, gorm.ErrRecordNotFound)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", nil).Return(nil)
+
+		// Act
+		result, err := service.GetCart("cart-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result.DiscountRemoved)
+		assert.Equal(t, "discount is no longer available", result.DiscountRemoved.Reason)
+	})
+
+	t.Run("Error - Discount lookup failure keeps the code on the cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "TEST10"
+		cart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(nil, errors.New("connection reset"))
+
+		// Act
+		result, err := service.GetCart("cart-123")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, "TEST10", *cart.DiscountCode)
+	})
+
+	t.Run("Error - Failed to clear invalid discount", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		cart := createTestCartWithItems()
+		code := "GONE"
+		cart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("GONE").Return(nil, gorm.ErrRecordNotFound)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", nil).Return(errors.New("database error"))
+
+		// Act
+		result, err := service.GetCart("cart-123")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to remove discount")
+	})
+}
+
+func TestCartService_MergeGuestCart(t *testing.T) {
+	customerID := "customer-123"
+
+	// createGuestCart returns an unowned cart holding the given items
+	createGuestCart := func(items ...entity.CartItem) *entity.Cart {
+		return &entity.Cart{ID: "guest-cart", IsActive: true, CartItems: items}
+	}
+
+	// createCustomerCart returns the customer's active cart holding 8 of variant-123
+	createCustomerCart := func() *entity.Cart {
+		cart := createTestCartWithItems()
+		cart.CustomerID = &customerID
+		cart.CartItems[0].Quantity = 8
+		return cart
+	}
+
+	t.Run("Success - Quantities are summed and capped at stock", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		otherVariant := createTestProductVariant()
+		otherVariant.ID = "variant-456"
+		otherVariant.StockQuantity = 3
+		inactiveVariant := createTestProductVariant()
+		inactiveVariant.ID = "variant-789"
+		inactiveVariant.IsActive = false
+
+		guestCart := createGuestCart(
+			entity.CartItem{ID: "guest-item-1", ProductVariantID: "variant-123", ProductVariant: createTestProductVariant(), Quantity: 5},
+			entity.CartItem{ID: "guest-item-2", ProductVariantID: "variant-456", ProductVariant: otherVariant, Quantity: 2},
+			entity.CartItem{ID: "guest-item-3", ProductVariantID: "variant-789", ProductVariant: inactiveVariant, Quantity: 1},
+		)
+
+		var mergedItems []entity.CartItem
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(createCustomerCart(), nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(guestCart, nil)
+		mockCartRepo.EXPECT().MergeCartItems("guest-cart", "cart-123", gomock.Any()).
+			Do(func(_, _ string, items []entity.CartItem) { mergedItems = items }).Return(true, nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "cart-123", result.CartID)
+		assert.Len(t, mergedItems, 2)
+		assert.Equal(t, "item-1", mergedItems[0].ID)
+		assert.Equal(t, 10, mergedItems[0].Quantity) // 8 + 5 capped at a stock of 10
+		assert.Empty(t, mergedItems[1].ID)
+		assert.Equal(t, "variant-456", mergedItems[1].ProductVariantID)
+		assert.Equal(t, 2, mergedItems[1].Quantity)
+	})
+
+	t.Run("Success - Guest cart becomes the customer's first cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		guestCart := createGuestCart(entity.CartItem{ProductVariantID: "variant-123", ProductVariant: createTestProductVariant(), Quantity: 2})
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(nil, nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(guestCart, nil)
+		mockCartRepo.EXPECT().AssignCartToCustomer("guest-cart", customerID).Return(true, nil)
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "guest-cart", result.CartID)
+		assert.Equal(t, 2, result.TotalItems)
+	})
+
+	t.Run("Success - Guest discount is carried over", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		code := "TEST10"
+		guestCart := createGuestCart(entity.CartItem{ProductVariantID: "variant-123", ProductVariant: createTestProductVariant(), Quantity: 1})
+		guestCart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(createCustomerCart(), nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(guestCart, nil)
+		mockCartRepo.EXPECT().MergeCartItems("guest-cart", "cart-123", gomock.Any()).Return(true, nil)
+		mockCartRepo.EXPECT().UpdateCartDiscount("cart-123", &code).Return(nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+
+		// Act
+		_, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Already merged guest cart leaves the customer cart unchanged", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		code := "TEST10"
+		guestCart := createGuestCart(entity.CartItem{ProductVariantID: "variant-123", ProductVariant: createTestProductVariant(), Quantity: 1})
+		guestCart.DiscountCode = &code
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(createCustomerCart(), nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(guestCart, nil)
+		mockCartRepo.EXPECT().MergeCartItems("guest-cart", "cart-123", gomock.Any()).Return(false, nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createCustomerCart(), nil)
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 8, result.TotalItems)
+	})
+
+	t.Run("Success - Cart of another customer is not merged", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		otherCustomerID := "customer-456"
+		otherCart := createGuestCart()
+		otherCart.CustomerID = &otherCustomerID
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(createCustomerCart(), nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(otherCart, nil)
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "cart-123", result.CartID)
+	})
+
+	t.Run("Success - Customer without any cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(nil, nil)
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Error - Guest cart not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		service := createTestCartService(mockCartRepo)
+
+		mockCartRepo.EXPECT().FindActiveCartByCustomerID(customerID).Return(nil, nil)
+		mockCartRepo.EXPECT().GetCartWithItems("guest-cart").Return(nil, errors.New("record not found"))
+
+		// Act
+		result, err := service.MergeGuestCart(customerID, "guest-cart")
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to get cart")
+	})
+}
+
 func TestCartService_calculateCartTotals(t *testing.T) {
 	t.Run("Error - Cart is nil", func(t *testing.T) {
 		// Arrange
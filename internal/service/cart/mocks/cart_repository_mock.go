@@ -34,6 +34,21 @@ func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignCartToCustomer mocks base method.
+func (m *MockCartRepository) AssignCartToCustomer(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCartToCustomer", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCartToCustomer indicates an expected call of AssignCartToCustomer.
+func (mr *MockCartRepositoryMockRecorder) AssignCartToCustomer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCartToCustomer", reflect.TypeOf((*MockCartRepository)(nil).AssignCartToCustomer), arg0, arg1)
+}
+
 // CreateCart mocks base method.
 func (m *MockCartRepository) CreateCart(arg0 *entity.Cart) error {
 	m.ctrl.T.Helper()
@@ -76,6 +91,21 @@ func (mr *MockCartRepositoryMockRecorder) DeleteCartItem(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCartItem", reflect.TypeOf((*MockCartRepository)(nil).DeleteCartItem), arg0, arg1)
 }
 
+// FindActiveCartByCustomerID mocks base method.
+func (m *MockCartRepository) FindActiveCartByCustomerID(arg0 string) (*entity.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveCartByCustomerID", arg0)
+	ret0, _ := ret[0].(*entity.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveCartByCustomerID indicates an expected call of FindActiveCartByCustomerID.
+func (mr *MockCartRepositoryMockRecorder) FindActiveCartByCustomerID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveCartByCustomerID", reflect.TypeOf((*MockCartRepository)(nil).FindActiveCartByCustomerID), arg0)
+}
+
 // FindCartByID mocks base method.
 func (m *MockCartRepository) FindCartByID(arg0 string) (*entity.Cart, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +196,35 @@ func (mr *MockCartRepositoryMockRecorder) GetProductVariantByID(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductVariantByID", reflect.TypeOf((*MockCartRepository)(nil).GetProductVariantByID), arg0)
 }
 
+// MergeCartItems mocks base method.
+func (m *MockCartRepository) MergeCartItems(arg0, arg1 string, arg2 []entity.CartItem) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCartItems", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCartItems indicates an expected call of MergeCartItems.
+func (mr *MockCartRepositoryMockRecorder) MergeCartItems(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCartItems", reflect.TypeOf((*MockCartRepository)(nil).MergeCartItems), arg0, arg1, arg2)
+}
+
+// UpdateCartDiscount mocks base method.
+func (m *MockCartRepository) UpdateCartDiscount(arg0 string, arg1 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartDiscount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCartDiscount indicates an expected call of UpdateCartDiscount.
+func (mr *MockCartRepositoryMockRecorder) UpdateCartDiscount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartDiscount", reflect.TypeOf((*MockCartRepository)(nil).UpdateCartDiscount), arg0, arg1)
+}
+
 // UpdateCartItem mocks base method.
 func (m *MockCartRepository) UpdateCartItem(arg0 *entity.CartItem) error {
 	m.ctrl.T.Helper()
@@ -178,4 +237,4 @@ func (m *MockCartRepository) UpdateCartItem(arg0 *entity.CartItem) error {
 func (mr *MockCartRepositoryMockRecorder) UpdateCartItem(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartItem", reflect.TypeOf((*MockCartRepository)(nil).UpdateCartItem), arg0)
-}
\ No newline at end of file
+}
@@ -1,7 +1,41 @@
 package service
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+)
 
 type CategoryService interface {
 	GetCategoryBySlug(slug string) (*entity.Category, error)
+	// ListCategories returns the active categories sorted by display order
+	ListCategories() ([]entity.Category, error)
+}
+
+// CategoryAdminService manages categories for the admin API
+type CategoryAdminService interface {
+	// GetAllCategories returns every category, active or not, sorted by display order
+	GetAllCategories() ([]entity.Category, error)
+	GetCategory(id string) (*entity.Category, error)
+	CreateCategory(actor AdminActor, req request.CategoryRequest) (*entity.Category, error)
+	// UpdateCategory replaces the category. Renaming the slug keeps its products, which
+	// are linked by category ID.
+	UpdateCategory(actor AdminActor, id string, req request.CategoryRequest) (*entity.Category, error)
+	// DeleteCategory refuses to delete a category that still has products
+	DeleteCategory(actor AdminActor, id string) error
 }
+
+var (
+	// ErrCategoryNotFound is returned when a category does not exist
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrDuplicateCategory is returned when a category name or slug is already used by another category
+	ErrDuplicateCategory = errors.New("category name or slug already exists")
+
+	// ErrInvalidCategorySlug is returned when a slug has characters other than lowercase letters, digits, '-' and '_'
+	ErrInvalidCategorySlug = errors.New("slug may only contain lowercase letters, digits, '-' and '_'")
+
+	// ErrCategoryInUse is returned when deleting a category that still has products
+	ErrCategoryInUse = errors.New("category still has products")
+)
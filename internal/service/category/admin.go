@@ -0,0 +1,133 @@
+package category
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+)
+
+// slugPattern matches slugs such as "jood_pro" or "prayer-robes"
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`)
+
+func (s *CategoryService) GetAllCategories() ([]entity.Category, error) {
+	return s.categoryRepo.FindCategories(true)
+}
+
+func (s *CategoryService) GetCategory(id string) (*entity.Category, error) {
+	category, err := s.categoryRepo.FindCategoryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, service.ErrCategoryNotFound
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) CreateCategory(actor service.AdminActor, req request.CategoryRequest) (*entity.Category, error) {
+	now := time.Now()
+	category := &entity.Category{
+		ID:        uuid.New().String(),
+		IsActive:  true,
+		CreatedAt: now,
+	}
+	if err := applyCategoryRequest(category, req, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.CreateCategory(category); err != nil {
+		return nil, categoryError(err, "failed to create category")
+	}
+
+	audit.Record(s.auditLogRepo, actor, "category.create", entity.AuditTargetCategory, category.ID, nil, category)
+
+	return category, nil
+}
+
+func (s *CategoryService) UpdateCategory(actor service.AdminActor, id string, req request.CategoryRequest) (*entity.Category, error) {
+	category, err := s.GetCategory(id)
+	if err != nil {
+		return nil, err
+	}
+	before := *category
+
+	if err := applyCategoryRequest(category, req, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.UpdateCategory(category); err != nil {
+		return nil, categoryError(err, "failed to update category")
+	}
+
+	audit.Record(s.auditLogRepo, actor, "category.update", entity.AuditTargetCategory, category.ID, &before, category)
+
+	return category, nil
+}
+
+func (s *CategoryService) DeleteCategory(actor service.AdminActor, id string) error {
+	category, err := s.GetCategory(id)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.categoryRepo.CountCategoryProducts(id)
+	if err != nil {
+		return fmt.Errorf("failed to count category products: %v", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d product(s) must be moved first", service.ErrCategoryInUse, count)
+	}
+
+	deleted, err := s.categoryRepo.DeleteCategory(id)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %v", err)
+	}
+	if !deleted {
+		return service.ErrCategoryNotFound
+	}
+
+	audit.Record(s.auditLogRepo, actor, "category.delete", entity.AuditTargetCategory, id, category, nil)
+
+	return nil
+}
+
+func categoryError(err error, message string) error {
+	if errors.Is(err, repository.ErrDuplicateCategory) {
+		return service.ErrDuplicateCategory
+	}
+	return fmt.Errorf("%s: %v", message, err)
+}
+
+func applyCategoryRequest(category *entity.Category, req request.CategoryRequest, now time.Time) error {
+	slug := strings.TrimSpace(req.Slug)
+	if !slugPattern.MatchString(slug) {
+		return service.ErrInvalidCategorySlug
+	}
+
+	category.Name = strings.TrimSpace(req.Name)
+	category.Slug = slug
+	category.HeroHeadline = req.HeroHeadline
+	category.HeroSubheadline = req.HeroSubheadline
+	category.HeroImageUrl = req.HeroImageUrl
+	category.Section3Headline = req.Section3Headline
+	category.Section3Subheadline = req.Section3Subheadline
+	category.Section3ImageUrl = req.Section3ImageUrl
+	category.MetaTitle = req.MetaTitle
+	category.MetaDescription = req.MetaDescription
+	category.DisplayOrder = req.DisplayOrder
+	if req.IsActive != nil {
+		category.IsActive = *req.IsActive
+	}
+	category.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,184 @@
+package category
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/category/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestCategoryService(ctrl *gomock.Controller) (*CategoryService, *mocks.MockCategoryRepository, *mocks.MockAuditLogRepository) {
+	categoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	auditLogRepo := mocks.NewMockAuditLogRepository(ctrl)
+	return NewCategoryService(categoryRepo, mocks.NewMockProductRepository(ctrl), auditLogRepo), categoryRepo, auditLogRepo
+}
+
+var testActor = service.AdminActor{ID: "admin-1", Email: "editor@example.com", Role: entity.AdminRoleCatalogEditor}
+
+// Helper function to create a test category request
+func createTestCategoryRequest(slug string) request.CategoryRequest {
+	headline := "Count your dhikr anywhere"
+	return request.CategoryRequest{
+		Name:         " Jood Pro ",
+		Slug:         slug,
+		HeroHeadline: &headline,
+		DisplayOrder: 2,
+	}
+}
+
+func TestCategoryService_CreateCategory(t *testing.T) {
+	t.Run("Success - Category is created active", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, auditLogRepo := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().CreateCategory(gomock.Any()).Return(nil)
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).Return(nil)
+
+		// Act
+		result, err := svc.CreateCategory(testActor, createTestCategoryRequest("jood_pro"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.ID)
+		assert.Equal(t, "Jood Pro", result.Name)
+		assert.Equal(t, "jood_pro", result.Slug)
+		assert.Equal(t, 2, result.DisplayOrder)
+		assert.True(t, result.IsActive)
+	})
+
+	t.Run("Error - Slug with spaces or capitals", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestCategoryService(ctrl)
+
+		// Act
+		result, err := svc.CreateCategory(testActor, createTestCategoryRequest("Jood Pro"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidCategorySlug)
+	})
+
+	t.Run("Error - Name or slug already used", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, _ := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().CreateCategory(gomock.Any()).Return(repository.ErrDuplicateCategory)
+
+		// Act
+		result, err := svc.CreateCategory(testActor, createTestCategoryRequest("jood_pro"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrDuplicateCategory)
+	})
+}
+
+func TestCategoryService_UpdateCategory(t *testing.T) {
+	t.Run("Success - Slug renamed and active flag kept", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, auditLogRepo := createTestCategoryService(ctrl)
+		existing := &entity.Category{ID: "category-1", Name: "Jood Pro", Slug: "jood_pro", IsActive: false}
+		categoryRepo.EXPECT().FindCategoryByID("category-1").Return(existing, nil)
+		categoryRepo.EXPECT().UpdateCategory(existing).Return(nil)
+
+		var auditLog *entity.AdminAuditLog
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).
+			Do(func(log *entity.AdminAuditLog) { auditLog = log }).Return(nil)
+
+		// Act
+		result, err := svc.UpdateCategory(testActor, "category-1", createTestCategoryRequest("jood-pro"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "jood-pro", result.Slug)
+		assert.False(t, result.IsActive)
+		assert.Equal(t, "category.update", auditLog.Action)
+		assert.Equal(t, "admin-1", auditLog.ActorID)
+		assert.Equal(t, "category-1", auditLog.TargetID)
+		assert.Equal(t, map[string]interface{}{"before": "jood_pro", "after": "jood-pro"}, auditLog.Changes["slug"])
+		assert.NotContains(t, auditLog.Changes, "is_active")
+	})
+
+	t.Run("Error - Category not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, _ := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().FindCategoryByID("missing").Return(nil, nil)
+
+		// Act
+		result, err := svc.UpdateCategory(testActor, "missing", createTestCategoryRequest("jood_pro"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
+	})
+}
+
+func TestCategoryService_DeleteCategory(t *testing.T) {
+	t.Run("Success - Empty category deleted", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, auditLogRepo := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().FindCategoryByID("category-1").Return(&entity.Category{ID: "category-1"}, nil)
+		categoryRepo.EXPECT().CountCategoryProducts("category-1").Return(int64(0), nil)
+		categoryRepo.EXPECT().DeleteCategory("category-1").Return(true, nil)
+		auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).Return(nil)
+
+		// Act
+		err := svc.DeleteCategory(testActor, "category-1")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error - Category still has products", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, _ := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().FindCategoryByID("category-1").Return(&entity.Category{ID: "category-1"}, nil)
+		categoryRepo.EXPECT().CountCategoryProducts("category-1").Return(int64(3), nil)
+
+		// Act
+		err := svc.DeleteCategory(testActor, "category-1")
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrCategoryInUse)
+		assert.Contains(t, err.Error(), "3 product(s)")
+	})
+
+	t.Run("Error - Category not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, categoryRepo, _ := createTestCategoryService(ctrl)
+		categoryRepo.EXPECT().FindCategoryByID("missing").Return(nil, nil)
+
+		// Act
+		err := svc.DeleteCategory(testActor, "missing")
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
+	})
+}
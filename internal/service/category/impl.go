@@ -21,3 +21,7 @@ func (s *CategoryService) GetCategoryBySlug(slug string) (*entity.Category, erro
 
 	return category, nil
 }
+
+func (s *CategoryService) ListCategories() ([]entity.Category, error) {
+	return s.categoryRepo.FindCategories(false)
+}
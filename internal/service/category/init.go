@@ -5,11 +5,13 @@ import "github.com/hanifbg/landing_backend/internal/repository"
 type CategoryService struct {
 	categoryRepo repository.CategoryRepository
 	productRepo  repository.ProductRepository
+	auditLogRepo repository.AuditLogRepository
 }
 
-func NewCategoryService(categoryRepo repository.CategoryRepository, productRepo repository.ProductRepository) *CategoryService {
+func NewCategoryService(categoryRepo repository.CategoryRepository, productRepo repository.ProductRepository, auditLogRepo repository.AuditLogRepository) *CategoryService {
 	return &CategoryService{
 		categoryRepo: categoryRepo,
 		productRepo:  productRepo,
+		auditLogRepo: auditLogRepo,
 	}
 }
@@ -0,0 +1,242 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/product.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockProductRepository is a mock of ProductRepository interface.
+type MockProductRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductRepositoryMockRecorder
+}
+
+// MockProductRepositoryMockRecorder is the mock recorder for MockProductRepository.
+type MockProductRepositoryMockRecorder struct {
+	mock *MockProductRepository
+}
+
+// NewMockProductRepository creates a new mock instance.
+func NewMockProductRepository(ctrl *gomock.Controller) *MockProductRepository {
+	mock := &MockProductRepository{ctrl: ctrl}
+	mock.recorder = &MockProductRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateProduct mocks base method.
+func (m *MockProductRepository) CreateProduct(product *entity.Product, movements []entity.InventoryMovement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProduct", product, movements)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProduct indicates an expected call of CreateProduct.
+func (mr *MockProductRepositoryMockRecorder) CreateProduct(product, movements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductRepository)(nil).CreateProduct), product, movements)
+}
+
+// CreateVariant mocks base method.
+func (m *MockProductRepository) CreateVariant(variant *entity.ProductVariant, movements []entity.InventoryMovement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", variant, movements)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockProductRepositoryMockRecorder) CreateVariant(variant, movements interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockProductRepository)(nil).CreateVariant), variant, movements)
+}
+
+// FindProductByID mocks base method.
+func (m *MockProductRepository) FindProductByID(id string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductByID", id)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductByID indicates an expected call of FindProductByID.
+func (mr *MockProductRepositoryMockRecorder) FindProductByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductByID", reflect.TypeOf((*MockProductRepository)(nil).FindProductByID), id)
+}
+
+// FindProducts mocks base method.
+func (m *MockProductRepository) FindProducts(includeInactive bool) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProducts", includeInactive)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProducts indicates an expected call of FindProducts.
+func (mr *MockProductRepositoryMockRecorder) FindProducts(includeInactive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProducts", reflect.TypeOf((*MockProductRepository)(nil).FindProducts), includeInactive)
+}
+
+// FindVariantByID mocks base method.
+func (m *MockProductRepository) FindVariantByID(productID, variantID string) (*entity.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVariantByID", productID, variantID)
+	ret0, _ := ret[0].(*entity.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVariantByID indicates an expected call of FindVariantByID.
+func (mr *MockProductRepositoryMockRecorder) FindVariantByID(productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVariantByID", reflect.TypeOf((*MockProductRepository)(nil).FindVariantByID), productID, variantID)
+}
+
+// GetAllProducts mocks base method.
+func (m *MockProductRepository) GetAllProducts() ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProducts")
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProducts indicates an expected call of GetAllProducts.
+func (mr *MockProductRepositoryMockRecorder) GetAllProducts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProducts", reflect.TypeOf((*MockProductRepository)(nil).GetAllProducts))
+}
+
+// GetAllProductsByCategory mocks base method.
+func (m *MockProductRepository) GetAllProductsByCategory(category string) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProductsByCategory", category)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProductsByCategory indicates an expected call of GetAllProductsByCategory.
+func (mr *MockProductRepositoryMockRecorder) GetAllProductsByCategory(category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProductsByCategory", reflect.TypeOf((*MockProductRepository)(nil).GetAllProductsByCategory), category)
+}
+
+// GetAllProductsByCategorySlug mocks base method.
+func (m *MockProductRepository) GetAllProductsByCategorySlug(categorySlug string) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProductsByCategorySlug", categorySlug)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProductsByCategorySlug indicates an expected call of GetAllProductsByCategorySlug.
+func (mr *MockProductRepositoryMockRecorder) GetAllProductsByCategorySlug(categorySlug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProductsByCategorySlug", reflect.TypeOf((*MockProductRepository)(nil).GetAllProductsByCategorySlug), categorySlug)
+}
+
+// GetProductByID mocks base method.
+func (m *MockProductRepository) GetProductByID(id string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", id)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockProductRepositoryMockRecorder) GetProductByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductRepository)(nil).GetProductByID), id)
+}
+
+// SKUExists mocks base method.
+func (m *MockProductRepository) SKUExists(sku, exceptVariantID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SKUExists", sku, exceptVariantID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SKUExists indicates an expected call of SKUExists.
+func (mr *MockProductRepositoryMockRecorder) SKUExists(sku, exceptVariantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SKUExists", reflect.TypeOf((*MockProductRepository)(nil).SKUExists), sku, exceptVariantID)
+}
+
+// SetProductActive mocks base method.
+func (m *MockProductRepository) SetProductActive(id string, active bool, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductActive", id, active, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductActive indicates an expected call of SetProductActive.
+func (mr *MockProductRepositoryMockRecorder) SetProductActive(id, active, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductActive", reflect.TypeOf((*MockProductRepository)(nil).SetProductActive), id, active, at)
+}
+
+// SetVariantActive mocks base method.
+func (m *MockProductRepository) SetVariantActive(productID, variantID string, active bool, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVariantActive", productID, variantID, active, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVariantActive indicates an expected call of SetVariantActive.
+func (mr *MockProductRepositoryMockRecorder) SetVariantActive(productID, variantID, active, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVariantActive", reflect.TypeOf((*MockProductRepository)(nil).SetVariantActive), productID, variantID, active, at)
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductRepository) UpdateProduct(product *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductRepositoryMockRecorder) UpdateProduct(product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), product)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockProductRepository) UpdateVariant(variant *entity.ProductVariant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductRepositoryMockRecorder) UpdateVariant(variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductRepository)(nil).UpdateVariant), variant)
+}
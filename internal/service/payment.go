@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"errors"
+
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
 )
@@ -9,9 +12,61 @@ type PaymentService interface {
 	// Order operations
 	CreateOrder(req request.CreateOrderRequest) (*response.CreateOrderResponse, error)
 	GetOrder(orderID string) (*response.OrderResponse, error)
+	// GetOrderSummary returns the order without the customer's name, contact details and
+	// address, for callers that only know the order ID
+	GetOrderSummary(orderID string) (*response.OrderResponse, error)
+	// ListCustomerOrders returns a page of the customer's orders, newest first
+	ListCustomerOrders(customerID string, req request.ListCustomerOrdersRequest) (*response.OrderListResponse, error)
+	// GetCustomerOrder returns the order only when it belongs to the customer, otherwise ErrOrderNotFound
+	GetCustomerOrder(customerID, orderID string) (*response.OrderResponse, error)
+	// LookupGuestOrder returns the order when the email or phone matches the one given
+	// at checkout, otherwise ErrOrderNotFound
+	LookupGuestOrder(req request.GuestOrderLookupRequest) (*response.OrderResponse, error)
 
 	// Payment operations
 	CreatePayment(orderID string) (*response.PaymentResponse, error)
 	GetPaymentStatus(paymentID string) (*response.PaymentStatusResponse, error)
 	HandlePaymentNotification(notificationData request.PaymentNotificationRequest) error
+
+	// ExpireOverduePayments cancels pending payments past their expiry time and
+	// releases the stock held by their orders. It returns how many were expired.
+	ExpireOverduePayments(ctx context.Context) (int, error)
+}
+
+// OrderAdminService lets the admin find orders and move them through their statuses
+type OrderAdminService interface {
+	// ListOrders returns a page of the orders matching the filters, newest first
+	ListOrders(req request.AdminListOrdersRequest) (*response.AdminOrderListResponse, error)
+	GetAdminOrder(orderID string) (*response.AdminOrderResponse, error)
+	// UpdateOrderStatus applies a status change allowed from the order's current status and
+	// records it. It returns ErrInvalidStatusTransition for any other change.
+	UpdateOrderStatus(actor AdminActor, orderID string, req request.UpdateOrderStatusRequest) (*response.AdminOrderResponse, error)
 }
+
+var (
+	// ErrOrderNotFound is returned when an order does not exist or the caller may not see it
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrInvalidStatusTransition is returned when an order cannot move from its current status to the requested one
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+
+	// ErrOrderStatusChanged is returned when the order's status changed while a status change was being applied
+	ErrOrderStatusChanged = errors.New("order status was changed by someone else, reload the order and try again")
+
+	// ErrInvalidNotificationSignature is returned when a payment notification's
+	// signature_key does not match the one computed with our server key
+	ErrInvalidNotificationSignature = errors.New("invalid notification signature")
+
+	// ErrNotificationAmountMismatch is returned when a payment notification's
+	// gross_amount does not match the stored payment amount
+	ErrNotificationAmountMismatch = errors.New("notification gross amount does not match payment amount")
+
+	// ErrInvalidDiscount is returned when an order's discount code is unknown or can no longer be used
+	ErrInvalidDiscount = errors.New("invalid discount code")
+
+	// ErrShippingServiceUnavailable is returned when the chosen courier service is not quoted for the destination
+	ErrShippingServiceUnavailable = errors.New("shipping service is not available for this destination")
+
+	// ErrShippingCostMismatch is returned when the shipping cost sent by the client differs from the courier quote
+	ErrShippingCostMismatch = errors.New("shipping cost does not match the courier quote")
+)
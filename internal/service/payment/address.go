@@ -0,0 +1,39 @@
+package payment
+
+import (
+	"fmt"
+
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+// applySavedAddress fills the recipient and shipping fields of the request from the
+// customer's saved address when an address ID is given. The address must belong to
+// the customer placing the order.
+func (s *PaymentService) applySavedAddress(req request.CreateOrderRequest) (request.CreateOrderRequest, error) {
+	if req.AddressID == "" {
+		return req, nil
+	}
+	if req.CustomerID == "" {
+		return req, service.ErrAddressRequiresLogin
+	}
+
+	address, err := s.customerRepo.FindAddressByID(req.CustomerID, req.AddressID)
+	if err != nil {
+		return req, fmt.Errorf("failed to get address: %w", err)
+	}
+	if address == nil {
+		return req, service.ErrAddressNotFound
+	}
+
+	req.CustomerName = address.RecipientName
+	req.CustomerPhone = address.PhoneNumber
+	req.ShippingAddress = address.StreetAddress
+	req.ShippingProvinceName = address.ProvinceName
+	req.ShippingProvinceID = address.ProvinceID
+	req.ShippingCityName = address.CityName
+	req.ShippingDistrictName = address.DistrictName
+	req.ShippingDistrictID = address.DistrictID
+	req.ShippingPostalCode = address.PostalCode
+	return req, nil
+}
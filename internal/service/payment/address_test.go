@@ -0,0 +1,115 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/payment/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentService_CreateOrderWithSavedAddress(t *testing.T) {
+	savedAddress := &entity.CustomerAddress{
+		ID:            "address-123",
+		CustomerID:    "customer-123",
+		RecipientName: "Jane Doe",
+		PhoneNumber:   "081234567890",
+		StreetAddress: "Jl. Test No. 1",
+		ProvinceName:  "DKI Jakarta",
+		CityName:      "Jakarta Selatan",
+		DistrictID:    "1234",
+		DistrictName:  "Kebayoran Baru",
+		PostalCode:    "12120",
+	}
+
+	newRequest := func(customerID string) request.CreateOrderRequest {
+		return request.CreateOrderRequest{
+			CartID:          "cart-123",
+			AddressID:       "address-123",
+			CustomerEmail:   "jane@example.com",
+			ShippingCourier: "jne",
+			ShippingService: "REG",
+			ShippingCost:    10000,
+			CustomerID:      customerID,
+		}
+	}
+
+	t.Run("Success - Recipient and address come from the saved address", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		mockCustomerRepo := mocks.NewMockCustomerRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+		service.customerRepo = mockCustomerRepo
+
+		var savedOrder *entity.Order
+		mockCustomerRepo.EXPECT().FindAddressByID("customer-123", "address-123").Return(savedAddress, nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "1234", gomock.Any(), "jne").
+			Return([]entity.ShippingQuote{{Courier: "jne", Service: "REG", Cost: 10000}}, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, _ []entity.OrderItem) { savedOrder = order }).Return(nil)
+
+		// Act
+		_, err := service.CreateOrder(newRequest("customer-123"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", savedOrder.CustomerName)
+		assert.Equal(t, "081234567890", savedOrder.CustomerPhone)
+		assert.Equal(t, "Jl. Test No. 1", savedOrder.ShippingStreetAddress)
+		assert.Equal(t, "Jakarta Selatan", savedOrder.ShippingCity)
+		assert.Equal(t, "Kebayoran Baru", savedOrder.ShippingDistrict)
+		assert.Equal(t, "12120", savedOrder.ShippingPostalCode)
+	})
+
+	t.Run("Error - Address of another customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockCustomerRepo := mocks.NewMockCustomerRepository(ctrl)
+		svc := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		svc.customerRepo = mockCustomerRepo
+
+		mockCustomerRepo.EXPECT().FindAddressByID("customer-999", "address-123").Return(nil, nil)
+
+		// Act
+		result, err := svc.CreateOrder(newRequest("customer-999"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrAddressNotFound)
+	})
+
+	t.Run("Error - Guest cannot use a saved address", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		svc := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		// Act
+		result, err := svc.CreateOrder(newRequest(""))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrAddressRequiresLogin)
+	})
+}
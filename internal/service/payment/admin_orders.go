@@ -0,0 +1,172 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+	"gorm.io/gorm"
+)
+
+const maxAdminOrdersPageSize = 100
+
+// orderStatusTransitions lists the statuses an admin may move an order to from each status.
+// Paid orders reach processing through the payment notification; pending is listed here so
+// bank transfers confirmed by hand can be processed too. Cancelled and refunded are final.
+var orderStatusTransitions = map[string][]string{
+	entity.OrderStatusPending:    {entity.OrderStatusProcessing, entity.OrderStatusCancelled},
+	entity.OrderStatusProcessing: {entity.OrderStatusShipped, entity.OrderStatusCancelled, entity.OrderStatusRefunded},
+	entity.OrderStatusShipped:    {entity.OrderStatusDelivered},
+	entity.OrderStatusDelivered:  {entity.OrderStatusRefunded},
+}
+
+// canTransition reports whether an order may move from one status to the other
+func canTransition(from, to string) bool {
+	for _, next := range orderStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *PaymentService) ListOrders(req request.AdminListOrdersRequest) (*response.AdminOrderListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = defaultOrdersPageSize
+	}
+	if limit > maxAdminOrdersPageSize {
+		limit = maxAdminOrdersPageSize
+	}
+
+	filter := repository.OrderFilter{
+		Statuses:      req.Statuses,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedBefore: req.CreatedBefore,
+		Courier:       strings.TrimSpace(req.Courier),
+		SourceChannel: strings.TrimSpace(req.SourceChannel),
+		Search:        strings.TrimSpace(req.Search),
+	}
+
+	orders, total, err := s.paymentRepo.FindOrders(filter, (page-1)*limit, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %v", err)
+	}
+
+	summaries := make([]response.AdminOrderSummaryResponse, 0, len(orders))
+	for i := range orders {
+		summaries = append(summaries, toAdminOrderSummary(&orders[i]))
+	}
+
+	return &response.AdminOrderListResponse{
+		Orders:     summaries,
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+	}, nil
+}
+
+func (s *PaymentService) GetAdminOrder(orderID string) (*response.AdminOrderResponse, error) {
+	order, err := s.paymentRepo.GetOrderWithItems(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, service.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %v", err)
+	}
+
+	changes, err := s.paymentRepo.FindOrderStatusChanges(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status history: %v", err)
+	}
+
+	allowed := orderStatusTransitions[order.OrderStatus]
+	if allowed == nil {
+		allowed = []string{}
+	}
+	if changes == nil {
+		changes = []entity.OrderStatusChange{}
+	}
+
+	return &response.AdminOrderResponse{
+		OrderResponse:      *s.buildOrderResponse(order),
+		CustomerID:         order.CustomerID,
+		ShippingCourier:    order.ShippingCourier,
+		ShippingService:    order.ShippingService,
+		AllowedTransitions: allowed,
+		StatusHistory:      changes,
+	}, nil
+}
+
+func (s *PaymentService) UpdateOrderStatus(actor service.AdminActor, orderID string, req request.UpdateOrderStatusRequest) (*response.AdminOrderResponse, error) {
+	order, err := s.paymentRepo.FindOrderByID(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, service.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %v", err)
+	}
+
+	if !canTransition(order.OrderStatus, req.Status) {
+		return nil, fmt.Errorf("%w: %s to %s", service.ErrInvalidStatusTransition, order.OrderStatus, req.Status)
+	}
+
+	change := &entity.OrderStatusChange{
+		ID:         uuid.New().String(),
+		OrderID:    order.ID,
+		FromStatus: order.OrderStatus,
+		ToStatus:   req.Status,
+		Note:       strings.TrimSpace(req.Note),
+		ChangedBy:  actor.Email,
+		CreatedAt:  time.Now(),
+	}
+
+	updated, err := s.paymentRepo.UpdateOrderStatus(change)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update order status: %v", err)
+	}
+	if !updated {
+		return nil, service.ErrOrderStatusChanged
+	}
+
+	audit.Record(s.auditLogRepo, actor, "order.status_change", entity.AuditTargetOrder, order.ID,
+		map[string]string{"order_status": change.FromStatus}, map[string]string{"order_status": change.ToStatus})
+
+	return s.GetAdminOrder(order.ID)
+}
+
+func toAdminOrderSummary(order *entity.Order) response.AdminOrderSummaryResponse {
+	totalItems := 0
+	for _, item := range order.OrderItems {
+		totalItems += item.Quantity
+	}
+
+	return response.AdminOrderSummaryResponse{
+		ID:              order.ID,
+		OrderNumber:     order.OrderNumber,
+		OrderStatus:     order.OrderStatus,
+		CustomerName:    order.CustomerName,
+		CustomerEmail:   order.CustomerEmail,
+		CustomerPhone:   order.CustomerPhone,
+		ShippingCourier: order.ShippingCourier,
+		ShippingService: order.ShippingService,
+		SourceChannel:   order.SourceChannel,
+		TotalAmount:     order.TotalAmount,
+		Currency:        order.Currency,
+		TotalItems:      totalItems,
+		CreatedAt:       order.CreatedAt,
+	}
+}
@@ -0,0 +1,180 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/payment/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPaymentService_ListOrders(t *testing.T) {
+	t.Run("Success - Filters and paging are passed to the repository", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		order := *createTestOrder()
+		order.OrderItems = []entity.OrderItem{{Quantity: 2}, {Quantity: 1}}
+
+		mockPaymentRepo.EXPECT().FindOrders(repository.OrderFilter{
+			Statuses:    []string{"processing"},
+			CreatedFrom: &from,
+			Courier:     "jne",
+			Search:      "john",
+		}, 20, 20).Return([]entity.Order{order}, int64(41), nil)
+
+		// Act
+		result, err := paymentService.ListOrders(request.AdminListOrdersRequest{
+			Statuses:    []string{"processing"},
+			CreatedFrom: &from,
+			Courier:     "jne",
+			Search:      " john ",
+			Page:        2,
+			Limit:       20,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result.Orders, 1)
+		assert.Equal(t, 3, result.Orders[0].TotalItems)
+		assert.Equal(t, int64(41), result.Total)
+		assert.Equal(t, 3, result.TotalPages)
+	})
+
+	t.Run("Success - Page size is capped", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		mockPaymentRepo.EXPECT().FindOrders(repository.OrderFilter{}, 0, maxAdminOrdersPageSize).Return(nil, int64(0), nil)
+
+		// Act
+		result, err := paymentService.ListOrders(request.AdminListOrdersRequest{Limit: 500})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result.Orders)
+		assert.Equal(t, maxAdminOrdersPageSize, result.Limit)
+	})
+}
+
+func TestPaymentService_UpdateOrderStatus(t *testing.T) {
+	actor := service.AdminActor{ID: "admin-1", Email: "ops@example.com", Role: entity.AdminRoleOps}
+
+	t.Run("Success - Allowed transition is recorded with the admin", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		order := createTestOrder()
+		order.OrderStatus = entity.OrderStatusProcessing
+		shipped := *order
+		shipped.OrderStatus = entity.OrderStatusShipped
+
+		mockAuditLogRepo := mocks.NewMockAuditLogRepository(ctrl)
+		paymentService.auditLogRepo = mockAuditLogRepo
+
+		var change *entity.OrderStatusChange
+		var auditLog *entity.AdminAuditLog
+		mockPaymentRepo.EXPECT().FindOrderByID("order-123").Return(order, nil)
+		mockPaymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).
+			Do(func(c *entity.OrderStatusChange) { change = c }).Return(true, nil)
+		mockAuditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).
+			Do(func(log *entity.AdminAuditLog) { auditLog = log }).Return(nil)
+		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(&shipped, nil)
+		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(nil, nil)
+		mockPaymentRepo.EXPECT().FindOrderStatusChanges("order-123").
+			DoAndReturn(func(string) ([]entity.OrderStatusChange, error) { return []entity.OrderStatusChange{*change}, nil })
+
+		// Act
+		result, err := paymentService.UpdateOrderStatus(actor, "order-123", request.UpdateOrderStatusRequest{
+			Status: entity.OrderStatusShipped,
+			Note:   "Handed to JNE",
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.OrderStatusProcessing, change.FromStatus)
+		assert.Equal(t, entity.OrderStatusShipped, change.ToStatus)
+		assert.Equal(t, "Handed to JNE", change.Note)
+		assert.Equal(t, "ops@example.com", change.ChangedBy)
+		assert.Equal(t, "order.status_change", auditLog.Action)
+		assert.Equal(t, map[string]interface{}{"before": "processing", "after": "shipped"}, auditLog.Changes["order_status"])
+		assert.Equal(t, entity.OrderStatusShipped, result.OrderStatus)
+		assert.Equal(t, []string{entity.OrderStatusDelivered}, result.AllowedTransitions)
+		assert.Len(t, result.StatusHistory, 1)
+	})
+
+	t.Run("Error - Transition not allowed from the current status", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		mockPaymentRepo.EXPECT().FindOrderByID("order-123").Return(createTestOrder(), nil)
+
+		// Act
+		result, err := paymentService.UpdateOrderStatus(actor, "order-123", request.UpdateOrderStatusRequest{Status: entity.OrderStatusDelivered})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, service.ErrInvalidStatusTransition))
+	})
+
+	t.Run("Error - Status changed by someone else first", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		mockPaymentRepo.EXPECT().FindOrderByID("order-123").Return(createTestOrder(), nil)
+		mockPaymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).Return(false, nil)
+
+		// Act
+		result, err := paymentService.UpdateOrderStatus(actor, "order-123", request.UpdateOrderStatusRequest{Status: entity.OrderStatusCancelled})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, service.ErrOrderStatusChanged, err)
+	})
+
+	t.Run("Error - Order not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mocks.NewMockCartRepository(ctrl), mocks.NewMockSnapClientInterface(ctrl))
+
+		mockPaymentRepo.EXPECT().FindOrderByID("missing").Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		result, err := paymentService.UpdateOrderStatus(actor, "missing", request.UpdateOrderStatusRequest{Status: entity.OrderStatusCancelled})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, service.ErrOrderNotFound, err)
+	})
+}
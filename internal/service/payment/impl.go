@@ -3,10 +3,14 @@ package payment
 import (
 	"bytes"
 	"context"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -16,11 +20,19 @@ import (
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
 	"github.com/hanifbg/landing_backend/internal/model/static"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/shipping"
 	"github.com/midtrans/midtrans-go"
 	"github.com/midtrans/midtrans-go/snap"
 )
 
 func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.CreateOrderResponse, error) {
+	req, err := s.applySavedAddress(req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get cart with items
 	cart, err := s.cartRepo.GetCartWithItems(req.CartID)
 	if err != nil {
@@ -37,9 +49,31 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 		subtotal += float64(item.Quantity) * item.ProductVariant.Price
 	}
 
-	// Check for discount
+	// Check for discount, re-validated with the same rules as the cart.
+	// The code in the request wins over the one remembered on the cart.
 	var discountAmount float64 = 0
 	var discountCode string
+	requestedCode := req.DiscountCode
+	if requestedCode == "" && cart.DiscountCode != nil {
+		requestedCode = *cart.DiscountCode
+	}
+	if requestedCode != "" {
+		discount, err := s.cartRepo.GetDiscountByCode(requestedCode)
+		if err != nil {
+			return nil, fmt.Errorf("%w: discount not found", service.ErrInvalidDiscount)
+		}
+		if err := discount.Validate(subtotal, time.Now()); err != nil {
+			return nil, fmt.Errorf("%w: %v", service.ErrInvalidDiscount, err)
+		}
+		discountAmount = discount.AmountFor(subtotal)
+		discountCode = discount.Code
+	}
+
+	// Verify the shipping cost against the courier quote for the cart's real weight
+	shippingCost, shipments, err := s.quoteShippingCost(cart, req)
+	if err != nil {
+		return nil, err
+	}
 
 	//crete order number
 	nextSeq, err := s.paymentRepo.GetSeq()
@@ -68,8 +102,8 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 		Subtotal:              subtotal,
 		DiscountAmount:        discountAmount,
 		DiscountCodeApplied:   discountCode,
-		ShippingCost:          req.ShippingCost,
-		TotalAmount:           subtotal - discountAmount + req.ShippingCost,
+		ShippingCost:          shippingCost,
+		TotalAmount:           subtotal - discountAmount + shippingCost,
 		Currency:              "IDR",
 		OrderStatus:           "pending",
 		SourceChannel:         "web",
@@ -77,8 +111,17 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 		CreatedAt:             time.Now(),
 		UpdatedAt:             time.Now(),
 	}
+	if req.CustomerID != "" {
+		order.CustomerID = &req.CustomerID
+	}
 
-	// Create order items
+	// Create order items, each recording the warehouse it ships from
+	warehouseIDs := make(map[string]string)
+	for _, shipment := range shipments {
+		for _, item := range shipment.Items {
+			warehouseIDs[item.ID] = shipment.Warehouse.ID
+		}
+	}
 	orderItems := make([]entity.OrderItem, 0)
 	for _, cartItem := range cart.CartItems {
 		orderItem := entity.OrderItem{
@@ -91,13 +134,20 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 			CreatedAt:        time.Now(),
 			UpdatedAt:        time.Now(),
 		}
+		if warehouseID, ok := warehouseIDs[cartItem.ID]; ok {
+			orderItem.WarehouseID = &warehouseID
+		}
 		orderItems = append(orderItems, orderItem)
 	}
 
 	// Save order and order items in a single transaction
 	if err := s.paymentRepo.CreateOrderWithItems(order, orderItems); err != nil {
-		return nil, fmt.Errorf("failed to create order with items: %v", err)
+		if errors.Is(err, repository.ErrDiscountUsageLimitReached) {
+			return nil, fmt.Errorf("%w: %w", service.ErrInvalidDiscount, err)
+		}
+		return nil, fmt.Errorf("failed to create order with items: %w", err)
 	}
+	s.lowStock.Check(variantIDs(orderItems))
 
 	// Prepare response
 	itemResponses := make([]response.OrderItemResponse, 0)
@@ -107,6 +157,7 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 			ProductVariantID: item.ProductVariantID,
 			Quantity:         item.Quantity,
 			PriceAtPurchase:  item.PriceAtPurchase,
+			WarehouseID:      item.WarehouseID,
 		})
 	}
 
@@ -163,7 +214,7 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 	// Call the function to send the message if WhatsApp repository is available
 	//if s.whatsAppRepo != nil {
 	// Format the phone number correctly for WhatsApp
-	phoneNumber := s.formatPhoneNumberForWhatsApp(order.CustomerPhone)
+	phoneNumber := entity.WhatsAppAddress(order.CustomerPhone)
 	if err := s.whatsAppRepo.SendMessage(phoneNumber, buf.String()); err != nil {
 		log.Printf("failed to send WhatsApp message: %v", err)
 	}
@@ -171,34 +222,20 @@ func (s *PaymentService) CreateOrder(req request.CreateOrderRequest) (*response.
 	return orderResponse, nil
 }
 
-// formatPhoneNumberForWhatsApp converts a regular phone number to WhatsApp format
-// It ensures the number starts with "+628" for Indonesia and appends "@s.whatsapp.net"
-func (s *PaymentService) formatPhoneNumberForWhatsApp(phoneNumber string) string {
-	// Remove any non-digit characters
-	digitsOnly := regexp.MustCompile(`\D`).ReplaceAllString(phoneNumber, "")
-
-	// If the number starts with 0, replace it with 62 (Indonesia country code)
-	if strings.HasPrefix(digitsOnly, "0") {
-		digitsOnly = "62" + digitsOnly[1:]
-	}
-
-	// If the number doesn't start with 62, add it
-	if !strings.HasPrefix(digitsOnly, "62") {
-		digitsOnly = "62" + digitsOnly
-	}
-
-	// Add the plus sign and WhatsApp suffix
-	return "+" + digitsOnly + "@s.whatsapp.net"
-}
-
 func (s *PaymentService) GetOrder(orderID string) (*response.OrderResponse, error) {
-	var payment *response.PaymentResponse
 	// Get order with items
 	order, err := s.paymentRepo.GetOrderWithItems(orderID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get order: %v", err)
 	}
 
+	return s.buildOrderResponse(order), nil
+}
+
+// buildOrderResponse converts an order with its items, and its payment when there is one, to the response
+func (s *PaymentService) buildOrderResponse(order *entity.Order) *response.OrderResponse {
+	var payment *response.PaymentResponse
+
 	// Prepare response
 	itemResponses := make([]response.OrderItemResponse, 0)
 	for _, item := range order.OrderItems {
@@ -209,12 +246,13 @@ func (s *PaymentService) GetOrder(orderID string) (*response.OrderResponse, erro
 			ProductImage:     item.ProductVariant.ImageURL,
 			Quantity:         item.Quantity,
 			PriceAtPurchase:  item.PriceAtPurchase,
+			WarehouseID:      item.WarehouseID,
 		})
 	}
 
-	existingPayment, err := s.paymentRepo.FindPaymentByOrderID(orderID)
+	existingPayment, err := s.paymentRepo.FindPaymentByOrderID(order.ID)
 	if err != nil {
-		fmt.Errorf("failed to get payment: %v", err)
+		log.Printf("failed to get payment: %v", err)
 	}
 
 	if existingPayment != nil {
@@ -258,7 +296,7 @@ func (s *PaymentService) GetOrder(orderID string) (*response.OrderResponse, erro
 		Payment:              payment,
 	}
 
-	return orderResponse, nil
+	return orderResponse
 }
 
 func (s *PaymentService) CreatePayment(orderID string) (*response.PaymentResponse, error) {
@@ -401,12 +439,26 @@ func (s *PaymentService) HandlePaymentNotification(notification request.PaymentN
 		return fmt.Errorf("invalid order_id")
 	}
 
+	// Verify the notification really comes from Midtrans before trusting it
+	if !s.verifyNotificationSignature(notification) {
+		s.recordNotificationRejection(notification, entity.NotificationRejectInvalidSignature)
+		return fmt.Errorf("order %s: %w", orderID, service.ErrInvalidNotificationSignature)
+	}
+
 	// Get payment by order ID
 	payment, err := s.paymentRepo.FindPaymentByOrderID(orderID)
 	if err != nil {
 		return fmt.Errorf("payment not found: %v", err)
 	}
 
+	// Midtrans is charged with the truncated order total (see CreatePayment),
+	// so compare whole rupiah amounts
+	grossAmount, err := strconv.ParseFloat(notification.GrossAmount, 64)
+	if err != nil || int64(grossAmount) != int64(payment.Amount) {
+		s.recordNotificationRejection(notification, entity.NotificationRejectAmountMismatch)
+		return fmt.Errorf("order %s: got %q, expected %.2f: %w", orderID, notification.GrossAmount, payment.Amount, service.ErrNotificationAmountMismatch)
+	}
+
 	// Update transaction ID if not set
 	if payment.TransactionID == "" {
 		payment.TransactionID = transactionID
@@ -455,16 +507,17 @@ func (s *PaymentService) HandlePaymentNotification(notification request.PaymentN
 	switch transactionStatus {
 	case "capture", "settlement":
 		paymentStatus = entity.PaymentStatusSuccess
-		orderStatus = "processing"
+		orderStatus = entity.OrderStatusProcessing
 	case "pending":
 		paymentStatus = entity.PaymentStatusPending
-		orderStatus = "pending"
+		orderStatus = entity.OrderStatusPending
 	case "deny", "cancel", "expire":
+		// Cancelling the order also releases its reserved stock
 		paymentStatus = entity.PaymentStatusFailed
-		orderStatus = "cancelled"
+		orderStatus = entity.OrderStatusCancelled
 	case "refund":
 		paymentStatus = entity.PaymentStatusRefunded
-		orderStatus = "refunded"
+		orderStatus = entity.OrderStatusRefunded
 	default:
 		return fmt.Errorf("unknown transaction status: %s", transactionStatus)
 	}
@@ -483,7 +536,13 @@ func (s *PaymentService) HandlePaymentNotification(notification request.PaymentN
 	}
 
 	// Update payment and order status in a single transaction
-	if err := s.paymentRepo.UpdatePaymentAndOrderStatus(payment, orderID, orderStatus); err != nil {
+	err = s.paymentRepo.UpdatePaymentAndOrderStatus(payment, orderID, orderStatus)
+	if errors.Is(err, repository.ErrPaidOrderClosed) {
+		// The payment is recorded, so Midtrans must not retry; an admin refunds or fulfils the order
+		s.alertPaidClosedOrder(orderID)
+		return nil
+	}
+	if err != nil {
 		return fmt.Errorf("failed to update payment and order status: %v", err)
 	}
 
@@ -555,3 +614,163 @@ func (s *PaymentService) HandlePaymentNotification(notification request.PaymentN
 
 	return nil
 }
+
+// alertPaidClosedOrder tells the order chat about a payment that arrived for a closed order
+func (s *PaymentService) alertPaidClosedOrder(orderID string) {
+	log.Printf("order %s was paid after it was closed and needs manual handling", orderID)
+
+	orderNumber := orderID
+	if order, err := s.paymentRepo.FindOrderByID(orderID); err == nil {
+		orderNumber = order.OrderNumber
+	}
+	message := fmt.Sprintf("Order %s was paid after it was cancelled or refunded and its stock is no longer reserved. Refund the payment or fulfil the order by hand.", orderNumber)
+	if _, err := s.telegramRepo.telegramRepo.SendMessage(context.Background(), s.telegramRepo.orderChatID, s.telegramRepo.messageThreadID, message); err != nil {
+		log.Printf("failed to send Telegram message: %v", err)
+	}
+}
+
+func variantIDs(items []entity.OrderItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductVariantID)
+	}
+	return ids
+}
+
+// quoteShippingCost asks the shipping providers for the chosen service's cost, applies the
+// shipping rules and checks it against the cost the client sent. The weight comes from the
+// cart, and the destination province is looked up from the district. The cart is quoted
+// from the warehouses holding it, which are returned so the order items can record them;
+// without warehouses it ships from the configured origin and no shipments are returned.
+func (s *PaymentService) quoteShippingCost(cart *entity.Cart, req request.CreateOrderRequest) (float64, []shipping.Shipment, error) {
+	if s.shippingRepo == nil {
+		return 0, nil, fmt.Errorf("shipping origin is not configured")
+	}
+	if req.ShippingDistrictID == "" {
+		return 0, nil, fmt.Errorf("shipping district id is required")
+	}
+
+	provinceID, err := s.provinces.Resolve(req.ShippingDistrictID, req.ShippingProvinceID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	shipments, err := s.origins.Plan(cart, provinceID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var quotes []entity.ShippingQuote
+	if len(shipments) > 0 {
+		quotes, err = shipping.QuoteShipments(s.shippingRepo, shipments, req.ShippingDistrictID, req.ShippingCourier)
+	} else if s.originID == "" {
+		return 0, nil, fmt.Errorf("shipping origin is not configured")
+	} else {
+		quotes, err = s.shippingRepo.CalculateShippingCost(s.originID, req.ShippingDistrictID, shipping.WeightGrams(cart.CartItems), req.ShippingCourier)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to quote shipping cost: %w", err)
+	}
+	quotes, err = s.shippingRules.Apply(entity.NewShippingParcel(cart, provinceID), quotes)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// More than one provider can quote the same service; the client may have picked any of
+	// them. Quotes come cheapest first, so the cheapest is reported on a mismatch.
+	var cheapest *entity.ShippingQuote
+	for i, quote := range quotes {
+		if !strings.EqualFold(quote.Service, req.ShippingService) {
+			continue
+		}
+		if quote.Courier != "" && !strings.EqualFold(quote.Courier, req.ShippingCourier) {
+			continue
+		}
+
+		if float64(quote.Cost) == req.ShippingCost {
+			return req.ShippingCost, shipments, nil
+		}
+		if cheapest == nil {
+			cheapest = &quotes[i]
+		}
+	}
+
+	if cheapest != nil {
+		return 0, nil, fmt.Errorf("%w: quoted %d, got %.0f", service.ErrShippingCostMismatch, cheapest.Cost, req.ShippingCost)
+	}
+	return 0, nil, fmt.Errorf("%w: %s %s", service.ErrShippingServiceUnavailable, req.ShippingCourier, req.ShippingService)
+}
+
+// expireBatchSize caps how many overdue payments one sweep handles
+const expireBatchSize = 100
+
+func (s *PaymentService) ExpireOverduePayments(ctx context.Context) (int, error) {
+	payments, err := s.paymentRepo.FindExpiredPendingPayments(time.Now(), expireBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired payments: %w", err)
+	}
+
+	expired := 0
+	for _, payment := range payments {
+		if err := ctx.Err(); err != nil {
+			return expired, err
+		}
+
+		ok, err := s.paymentRepo.ExpirePayment(payment.ID)
+		if err != nil {
+			log.Printf("failed to expire payment %s for order %s: %v", payment.ID, payment.OrderID, err)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		log.Printf("expired %d overdue payments", expired)
+	}
+
+	return expired, nil
+}
+
+// verifyNotificationSignature checks the notification signature_key, which Midtrans computes as
+// SHA512(order_id + status_code + gross_amount + server_key)
+func (s *PaymentService) verifyNotificationSignature(notification request.PaymentNotificationRequest) bool {
+	if s.serverKey == "" || notification.SignatureKey == "" {
+		return false
+	}
+
+	hash := sha512.Sum512([]byte(notification.OrderID + notification.StatusCode + notification.GrossAmount + s.serverKey))
+	expected := hex.EncodeToString(hash[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(notification.SignatureKey))) == 1
+}
+
+// recordNotificationRejection stores a rejected notification for audit.
+// Failures are only logged so they never change the response sent to the caller.
+func (s *PaymentService) recordNotificationRejection(notification request.PaymentNotificationRequest, reason string) {
+	log.Printf("rejected payment notification for order %s: %s", notification.OrderID, reason)
+
+	payload := entity.JSONMap{}
+	if payloadBytes, err := json.Marshal(notification); err == nil {
+		if err := json.Unmarshal(payloadBytes, &payload); err != nil {
+			payload = nil
+		}
+	}
+
+	audit := &entity.PaymentNotificationAudit{
+		ID:                uuid.New().String(),
+		OrderID:           notification.OrderID,
+		TransactionID:     notification.TransactionID,
+		TransactionStatus: notification.TransactionStatus,
+		StatusCode:        notification.StatusCode,
+		GrossAmount:       notification.GrossAmount,
+		Reason:            reason,
+		Payload:           payload,
+		CreatedAt:         time.Now(),
+	}
+
+	if err := s.paymentRepo.CreateNotificationAudit(audit); err != nil {
+		log.Printf("failed to record payment notification audit: %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package payment
 
 import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
 	"errors"
 	"testing"
 	"time"
@@ -9,20 +12,44 @@ import (
 
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
 	"github.com/hanifbg/landing_backend/internal/repository"
+	svc "github.com/hanifbg/landing_backend/internal/service"
 	"github.com/hanifbg/landing_backend/internal/service/payment/mocks"
+	"github.com/hanifbg/landing_backend/internal/service/shipping"
 	"github.com/midtrans/midtrans-go"
 	"github.com/midtrans/midtrans-go/snap"
 	"github.com/stretchr/testify/assert"
 )
 
+const (
+	testServerKey = "test-server-key"
+	testOriginID  = "501"
+)
+
+// Helper function to sign a notification the way Midtrans does, filling in
+// the status code and gross amount Midtrans always sends when they are missing
+func signNotification(notification request.PaymentNotificationRequest) request.PaymentNotificationRequest {
+	if notification.StatusCode == "" {
+		notification.StatusCode = "200"
+	}
+	if notification.GrossAmount == "" {
+		notification.GrossAmount = "250.00"
+	}
+	hash := sha512.Sum512([]byte(notification.OrderID + notification.StatusCode + notification.GrossAmount + testServerKey))
+	notification.SignatureKey = hex.EncodeToString(hash[:])
+	return notification
+}
+
 // Helper function to create a test payment service
 func createTestPaymentService(ctrl *gomock.Controller, paymentRepo repository.PaymentRepository, cartRepo repository.CartRepository, snapClient SnapClientInterface) *PaymentService {
 	svc := &PaymentService{
 		paymentRepo: paymentRepo,
 		cartRepo:    cartRepo,
 		snapClient:  snapClient,
+		serverKey:   testServerKey,
 		baseURL:     "http://localhost:8080",
+		originID:    testOriginID,
 	}
 	// Provide default no-op mocks for external side effects to avoid nil panics
 	mockMailer := mocks.NewMockMailer(ctrl)
@@ -37,6 +64,12 @@ func createTestPaymentService(ctrl *gomock.Controller, paymentRepo repository.Pa
 	mockTele.EXPECT().SendMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	svc.telegramRepo = telegramService{telegramRepo: mockTele}
 
+	// Quote the shipping cost the test requests send
+	mockShipping := mocks.NewMockShippingRepository(ctrl)
+	mockShipping.EXPECT().CalculateShippingCost(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]entity.ShippingQuote{{Courier: "jne", Service: "REG", Cost: 10000}}, nil).AnyTimes()
+	svc.shippingRepo = mockShipping
+
 	return svc
 }
 
@@ -62,11 +95,11 @@ func TestPaymentService_CreateOrder(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
 			ShippingCost:         10000,
-			TotalWeight:          1000,
 			Notes:                "Test notes",
 		}
 
@@ -88,6 +121,43 @@ func TestPaymentService_CreateOrder(t *testing.T) {
 		// assert.Len(t, result.OrderItems, 2)
 	})
 
+	t.Run("Success - Order of a logged in customer is linked to them", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		req := request.CreateOrderRequest{
+			CartID:             "cart-123",
+			CustomerName:       "John Doe",
+			CustomerEmail:      "john@example.com",
+			CustomerPhone:      "+1234567890",
+			ShippingDistrictID: "114",
+			ShippingCourier:    "jne",
+			ShippingService:    "REG",
+			ShippingCost:       10000,
+			CustomerID:         "customer-123",
+		}
+
+		var savedOrder *entity.Order
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, _ []entity.OrderItem) { savedOrder = order }).Return(nil)
+
+		// Act
+		_, err := service.CreateOrder(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, savedOrder.CustomerID)
+		assert.Equal(t, "customer-123", *savedOrder.CustomerID)
+	})
+
 	t.Run("Error - Cart not found", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
@@ -107,11 +177,11 @@ func TestPaymentService_CreateOrder(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
 			ShippingCost:         10000,
-			TotalWeight:          1000,
 		}
 
 		mockCartRepo.EXPECT().GetCartWithItems("invalid-cart").Return(nil, errors.New("cart not found"))
@@ -151,11 +221,11 @@ func TestPaymentService_CreateOrder(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
 			ShippingCost:         10000,
-			TotalWeight:          1000,
 		}
 
 		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(emptyCart, nil)
@@ -190,11 +260,11 @@ func TestPaymentService_CreateOrder(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
 			ShippingCost:         10000,
-			TotalWeight:          1000,
 		}
 
 		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
@@ -452,7 +522,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		}
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -477,7 +547,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		}
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -504,7 +574,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(nil, errors.New("payment not found"))
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -532,7 +602,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -561,7 +631,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "processing").Return(errors.New("database error"))
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -590,7 +660,7 @@ func TestPaymentService_HandlePaymentNotification_ErrorCases(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "processing").Return(errors.New("database error"))
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -688,7 +758,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -718,7 +788,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "pending").Return(nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -748,7 +818,46 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "cancelled").Return(nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Payment for a closed order is recorded and flagged", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockTele := mocks.NewMockTelegramAPI(ctrl)
+		mockTele.EXPECT().SendMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int64, _ int64, message string) (*response.Message, error) {
+				assert.Contains(t, message, "ORD-123")
+				return nil, nil
+			})
+		service.telegramRepo = telegramService{telegramRepo: mockTele}
+
+		payment := createTestPayment()
+		notification := request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+			TransactionTime:   "2023-01-01 12:00:00",
+			GrossAmount:       "250.00",
+		}
+
+		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
+		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "processing").Return(repository.ErrPaidOrderClosed)
+		mockPaymentRepo.EXPECT().FindOrderByID("order-123").Return(&entity.Order{ID: "order-123", OrderNumber: "ORD-123"}, nil)
+
+		// Act
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -772,7 +881,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		}
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -797,7 +906,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		}
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -824,7 +933,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("invalid-order").Return(nil, errors.New("payment not found"))
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -852,7 +961,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -888,7 +997,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -923,7 +1032,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -958,7 +1067,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -985,7 +1094,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -1013,7 +1122,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -1049,7 +1158,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err) // Should still succeed even with invalid time format
@@ -1078,7 +1187,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "refunded").Return(nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -1113,7 +1222,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -1142,7 +1251,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "cancelled").Return(nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -1171,7 +1280,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "cancelled").Return(nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -1206,7 +1315,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(order, nil)
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.NoError(t, err)
@@ -1234,7 +1343,7 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 		mockPaymentRepo.EXPECT().UpdatePaymentAndOrderStatus(gomock.Any(), "order-123", "processing").Return(errors.New("database error"))
 
 		// Act
-		err := service.HandlePaymentNotification(notification)
+		err := service.HandlePaymentNotification(signNotification(notification))
 
 		// Assert
 		assert.Error(t, err)
@@ -1242,84 +1351,8 @@ func TestPaymentService_HandlePaymentNotification(t *testing.T) {
 	})
 }
 
-// Helper function to create test cart with items
-func createTestCartWithItems() *entity.Cart {
-	return &entity.Cart{
-		ID: "cart-123",
-		CartItems: []entity.CartItem{
-			{
-				ID:               "item-1",
-				CartID:           "cart-123",
-				ProductVariantID: "variant-1",
-				Quantity:         2,
-				ProductVariant: &entity.ProductVariant{
-					ID:    "variant-1",
-					Price: 100.0,
-					Name:  "Test Product",
-				},
-			},
-			{
-				ID:               "item-2",
-				CartID:           "cart-123",
-				ProductVariantID: "variant-2",
-				Quantity:         1,
-				ProductVariant: &entity.ProductVariant{
-					ID:    "variant-2",
-					Price: 50.0,
-					Name:  "Test Product 2",
-				},
-			},
-		},
-	}
-}
-
-// Helper function to create test order
-func createTestOrder() *entity.Order {
-	return &entity.Order{
-		ID:                    "order-123",
-		CartID:                "cart-123",
-		CustomerName:          "John Doe",
-		CustomerEmail:         "john@example.com",
-		CustomerPhone:         "+1234567890",
-		ShippingStreetAddress: "123 Test St",
-		ShippingCity:          "Jakarta",
-		ShippingProvince:      "DKI Jakarta",
-		ShippingDistrict:      "Kebayoran Baru",
-		ShippingPostalCode:    "12190",
-		ShippingCourier:       "jne",
-		ShippingService:       "REG",
-		ShippingCountry:       "Indonesia",
-		Subtotal:              250.0,
-		DiscountAmount:        0.0,
-		ShippingCost:          10000.0,
-		TotalAmount:           250.0,
-		Currency:              "IDR",
-		OrderStatus:           "pending",
-		SourceChannel:         "web",
-		CreatedAt:             time.Now(),
-		UpdatedAt:             time.Now(),
-	}
-}
-
-// Helper function to create test payment
-func createTestPayment() *entity.Payment {
-	expiryTime := time.Now().Add(24 * time.Hour)
-	return &entity.Payment{
-		ID:           "payment-123",
-		OrderID:      "order-123",
-		Amount:       250.0,
-		Status:       entity.PaymentStatusPending,
-		PaymentToken: "test-token",
-		PaymentURL:   "https://app.sandbox.midtrans.com/snap/v2/vtweb/test-token",
-		ExpiryTime:   &expiryTime,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
-	}
-}
-
-// Test constructor functions
-func TestNewPaymentService(t *testing.T) {
-	t.Run("Success - Create payment service with dependencies", func(t *testing.T) {
+func TestPaymentService_HandlePaymentNotification_Verification(t *testing.T) {
+	t.Run("Error - Invalid signature is rejected and audited", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -1327,69 +1360,86 @@ func TestNewPaymentService(t *testing.T) {
 		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
 		mockCartRepo := mocks.NewMockCartRepository(ctrl)
 		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		notification := signNotification(request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+		})
+		notification.SignatureKey = "forged-signature"
+
+		mockPaymentRepo.EXPECT().CreateNotificationAudit(gomock.Any()).DoAndReturn(func(audit *entity.PaymentNotificationAudit) error {
+			assert.Equal(t, "order-123", audit.OrderID)
+			assert.Equal(t, entity.NotificationRejectInvalidSignature, audit.Reason)
+			return nil
+		})
 
 		// Act
-		service := NewPaymentService(mockPaymentRepo, mockCartRepo, mockSnapClient, "http://localhost:8080")
+		err := service.HandlePaymentNotification(notification)
 
 		// Assert
-		assert.NotNil(t, service)
-		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
-		assert.Equal(t, mockCartRepo, service.cartRepo)
-		assert.Equal(t, mockSnapClient, service.snapClient)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, svc.ErrInvalidNotificationSignature)
 	})
-}
 
-func TestNewPaymentServiceWithMidtrans(t *testing.T) {
-	t.Run("Success - Create payment service with sandbox Midtrans", func(t *testing.T) {
+	t.Run("Error - Tampered status code breaks the signature", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
 		mockCartRepo := mocks.NewMockCartRepository(ctrl)
-		mockMailer := mocks.NewMockMailer(ctrl)
-		mockWhatsApp := mocks.NewMockWhatsApp(ctrl)
-		mockTelegram := mocks.NewMockTelegramAPI(ctrl)
-		serverKey := "test-server-key"
-		isProduction := false
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		notification := signNotification(request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+			StatusCode:        "201",
+		})
+		notification.StatusCode = "200"
+
+		mockPaymentRepo.EXPECT().CreateNotificationAudit(gomock.Any()).Return(nil)
 
 		// Act
-		service := NewPaymentServiceWithMidtrans(mockPaymentRepo, mockCartRepo, serverKey, isProduction, "http://localhost:8080", mockMailer, mockWhatsApp, mockTelegram, "", 0, 0)
+		err := service.HandlePaymentNotification(notification)
 
 		// Assert
-		assert.NotNil(t, service)
-		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
-		assert.Equal(t, mockCartRepo, service.cartRepo)
-		assert.NotNil(t, service.snapClient)
+		assert.ErrorIs(t, err, svc.ErrInvalidNotificationSignature)
 	})
 
-	t.Run("Success - Create payment service with production Midtrans", func(t *testing.T) {
+	t.Run("Error - Missing server key rejects every notification", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
 		mockCartRepo := mocks.NewMockCartRepository(ctrl)
-		mockMailer := mocks.NewMockMailer(ctrl)
-		mockWhatsApp := mocks.NewMockWhatsApp(ctrl)
-		mockTelegram := mocks.NewMockTelegramAPI(ctrl)
-		serverKey := "test-server-key"
-		isProduction := true
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.serverKey = ""
+
+		notification := signNotification(request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+		})
+
+		mockPaymentRepo.EXPECT().CreateNotificationAudit(gomock.Any()).Return(nil)
 
 		// Act
-		service := NewPaymentServiceWithMidtrans(mockPaymentRepo, mockCartRepo, serverKey, isProduction, "http://localhost:8080", mockMailer, mockWhatsApp, mockTelegram, "", 0, 0)
+		err := service.HandlePaymentNotification(notification)
 
 		// Assert
-		assert.NotNil(t, service)
-		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
-		assert.Equal(t, mockCartRepo, service.cartRepo)
-		assert.NotNil(t, service.snapClient)
+		assert.ErrorIs(t, err, svc.ErrInvalidNotificationSignature)
 	})
-}
 
-// Additional error handling tests
-func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
-	t.Run("Error - Empty cart", func(t *testing.T) {
+	t.Run("Error - Gross amount mismatch is rejected and audited", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -1399,38 +1449,30 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
 		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
 
-		emptyCart := &entity.Cart{
-			ID:        "cart-123",
-			CartItems: []entity.CartItem{}, // Empty cart
-		}
-		req := request.CreateOrderRequest{
-			CartID:               "cart-123",
-			CustomerName:         "John Doe",
-			CustomerEmail:        "john@example.com",
-			CustomerPhone:        "+1234567890",
-			ShippingAddress:      "123 Test St",
-			ShippingCityName:     "Jakarta",
-			ShippingProvinceName: "DKI Jakarta",
-			ShippingDistrictName: "Kebayoran Baru",
-			ShippingPostalCode:   "12190",
-			ShippingCourier:      "jne",
-			ShippingService:      "REG",
-			ShippingCost:         10000,
-			TotalWeight:          1000,
-		}
+		payment := createTestPayment()
+		notification := signNotification(request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+			GrossAmount:       "1.00",
+		})
 
-		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(emptyCart, nil)
+		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(payment, nil)
+		mockPaymentRepo.EXPECT().CreateNotificationAudit(gomock.Any()).DoAndReturn(func(audit *entity.PaymentNotificationAudit) error {
+			assert.Equal(t, entity.NotificationRejectAmountMismatch, audit.Reason)
+			assert.Equal(t, "1.00", audit.GrossAmount)
+			return nil
+		})
 
 		// Act
-		result, err := service.CreateOrder(req)
+		err := service.HandlePaymentNotification(notification)
 
 		// Assert
-		assert.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "cart is empty")
+		assert.ErrorIs(t, err, svc.ErrNotificationAmountMismatch)
 	})
 
-	t.Run("Error - Cart not found", func(t *testing.T) {
+	t.Run("Success - Audit failure does not change the rejection", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -1440,7 +1482,443 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
 		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
 
-		req := request.CreateOrderRequest{
+		notification := request.PaymentNotificationRequest{
+			TransactionID:     "txn-123",
+			OrderID:           "order-123",
+			TransactionStatus: "settlement",
+			PaymentType:       "credit_card",
+		}
+
+		mockPaymentRepo.EXPECT().CreateNotificationAudit(gomock.Any()).Return(errors.New("database error"))
+
+		// Act
+		err := service.HandlePaymentNotification(notification)
+
+		// Assert
+		assert.ErrorIs(t, err, svc.ErrInvalidNotificationSignature)
+	})
+}
+
+// Test ExpireOverduePayments method
+func TestPaymentService_ExpireOverduePayments(t *testing.T) {
+	t.Run("Success - Expire overdue payments", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		payments := []entity.Payment{
+			{ID: "payment-1", OrderID: "order-1", Status: entity.PaymentStatusPending},
+			{ID: "payment-2", OrderID: "order-2", Status: entity.PaymentStatusPending},
+		}
+
+		mockPaymentRepo.EXPECT().FindExpiredPendingPayments(gomock.Any(), expireBatchSize).Return(payments, nil)
+		mockPaymentRepo.EXPECT().ExpirePayment("payment-1").Return(true, nil)
+		mockPaymentRepo.EXPECT().ExpirePayment("payment-2").Return(true, nil)
+
+		// Act
+		expired, err := service.ExpireOverduePayments(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, expired)
+	})
+
+	t.Run("Success - Payment settled meanwhile is not counted", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		payments := []entity.Payment{{ID: "payment-1", OrderID: "order-1", Status: entity.PaymentStatusPending}}
+
+		mockPaymentRepo.EXPECT().FindExpiredPendingPayments(gomock.Any(), expireBatchSize).Return(payments, nil)
+		mockPaymentRepo.EXPECT().ExpirePayment("payment-1").Return(false, nil)
+
+		// Act
+		expired, err := service.ExpireOverduePayments(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, expired)
+	})
+
+	t.Run("Success - One failing payment does not stop the sweep", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		payments := []entity.Payment{
+			{ID: "payment-1", OrderID: "order-1", Status: entity.PaymentStatusPending},
+			{ID: "payment-2", OrderID: "order-2", Status: entity.PaymentStatusPending},
+		}
+
+		mockPaymentRepo.EXPECT().FindExpiredPendingPayments(gomock.Any(), expireBatchSize).Return(payments, nil)
+		mockPaymentRepo.EXPECT().ExpirePayment("payment-1").Return(false, errors.New("database error"))
+		mockPaymentRepo.EXPECT().ExpirePayment("payment-2").Return(true, nil)
+
+		// Act
+		expired, err := service.ExpireOverduePayments(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, expired)
+	})
+
+	t.Run("Error - Failed to find expired payments", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().FindExpiredPendingPayments(gomock.Any(), expireBatchSize).Return(nil, errors.New("database error"))
+
+		// Act
+		expired, err := service.ExpireOverduePayments(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, 0, expired)
+		assert.Contains(t, err.Error(), "failed to find expired payments")
+	})
+
+	t.Run("Error - Cancelled context stops the sweep", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		payments := []entity.Payment{{ID: "payment-1", OrderID: "order-1", Status: entity.PaymentStatusPending}}
+		mockPaymentRepo.EXPECT().FindExpiredPendingPayments(gomock.Any(), expireBatchSize).Return(payments, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		expired, err := service.ExpireOverduePayments(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, expired)
+	})
+}
+
+// Helper function to create test cart with items
+func createTestCartWithItems() *entity.Cart {
+	return &entity.Cart{
+		ID: "cart-123",
+		CartItems: []entity.CartItem{
+			{
+				ID:               "item-1",
+				CartID:           "cart-123",
+				ProductVariantID: "variant-1",
+				Quantity:         2,
+				ProductVariant: &entity.ProductVariant{
+					ID:    "variant-1",
+					Price: 100.0,
+					Name:  "Test Product",
+				},
+			},
+			{
+				ID:               "item-2",
+				CartID:           "cart-123",
+				ProductVariantID: "variant-2",
+				Quantity:         1,
+				ProductVariant: &entity.ProductVariant{
+					ID:    "variant-2",
+					Price: 50.0,
+					Name:  "Test Product 2",
+				},
+			},
+		},
+	}
+}
+
+// Helper function to create test order
+func createTestOrder() *entity.Order {
+	return &entity.Order{
+		ID:                    "order-123",
+		CartID:                "cart-123",
+		CustomerName:          "John Doe",
+		CustomerEmail:         "john@example.com",
+		CustomerPhone:         "+1234567890",
+		ShippingStreetAddress: "123 Test St",
+		ShippingCity:          "Jakarta",
+		ShippingProvince:      "DKI Jakarta",
+		ShippingDistrict:      "Kebayoran Baru",
+		ShippingPostalCode:    "12190",
+		ShippingCourier:       "jne",
+		ShippingService:       "REG",
+		ShippingCountry:       "Indonesia",
+		Subtotal:              250.0,
+		DiscountAmount:        0.0,
+		ShippingCost:          10000.0,
+		TotalAmount:           250.0,
+		Currency:              "IDR",
+		OrderStatus:           "pending",
+		SourceChannel:         "web",
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
+	}
+}
+
+// Helper function to create a test discount usable on the test cart
+func createTestDiscount() *entity.Discount {
+	now := time.Now()
+	future := now.Add(24 * time.Hour)
+	return &entity.Discount{
+		ID:                 "discount-123",
+		Code:               "TEST10",
+		Type:               entity.DiscountTypePercentage,
+		Value:              10.0,
+		MinimumOrderAmount: 50.0,
+		StartsAt:           now.Add(-1 * time.Hour),
+		ExpiresAt:          &future,
+		UsageLimit:         100,
+		UsesCount:          5,
+		IsActive:           true,
+	}
+}
+
+// Helper function to create test payment
+func createTestPayment() *entity.Payment {
+	expiryTime := time.Now().Add(24 * time.Hour)
+	return &entity.Payment{
+		ID:           "payment-123",
+		OrderID:      "order-123",
+		Amount:       250.0,
+		Status:       entity.PaymentStatusPending,
+		PaymentToken: "test-token",
+		PaymentURL:   "https://app.sandbox.midtrans.com/snap/v2/vtweb/test-token",
+		ExpiryTime:   &expiryTime,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Test constructor functions
+func TestNewPaymentService(t *testing.T) {
+	t.Run("Success - Create payment service with dependencies", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+
+		// Act
+		service := NewPaymentService(mockPaymentRepo, mockCartRepo, mockSnapClient, "http://localhost:8080")
+
+		// Assert
+		assert.NotNil(t, service)
+		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
+		assert.Equal(t, mockCartRepo, service.cartRepo)
+		assert.Equal(t, mockSnapClient, service.snapClient)
+	})
+}
+
+func TestNewPaymentServiceWithMidtrans(t *testing.T) {
+	t.Run("Success - Create payment service with sandbox Midtrans", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		mockWhatsApp := mocks.NewMockWhatsApp(ctrl)
+		mockTelegram := mocks.NewMockTelegramAPI(ctrl)
+		serverKey := "test-server-key"
+		isProduction := false
+
+		// Act
+		service := NewPaymentServiceWithMidtrans(mockPaymentRepo, mockCartRepo, serverKey, isProduction, "http://localhost:8080", mockMailer, mockWhatsApp, mockTelegram, "", 0, 0)
+
+		// Assert
+		assert.NotNil(t, service)
+		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
+		assert.Equal(t, mockCartRepo, service.cartRepo)
+		assert.NotNil(t, service.snapClient)
+	})
+
+	t.Run("Success - Create payment service with production Midtrans", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockMailer := mocks.NewMockMailer(ctrl)
+		mockWhatsApp := mocks.NewMockWhatsApp(ctrl)
+		mockTelegram := mocks.NewMockTelegramAPI(ctrl)
+		serverKey := "test-server-key"
+		isProduction := true
+
+		// Act
+		service := NewPaymentServiceWithMidtrans(mockPaymentRepo, mockCartRepo, serverKey, isProduction, "http://localhost:8080", mockMailer, mockWhatsApp, mockTelegram, "", 0, 0)
+
+		// Assert
+		assert.NotNil(t, service)
+		assert.Equal(t, mockPaymentRepo, service.paymentRepo)
+		assert.Equal(t, mockCartRepo, service.cartRepo)
+		assert.NotNil(t, service.snapClient)
+	})
+}
+
+// Additional error handling tests
+func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
+	t.Run("Error - Empty cart", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		emptyCart := &entity.Cart{
+			ID:        "cart-123",
+			CartItems: []entity.CartItem{}, // Empty cart
+		}
+		req := request.CreateOrderRequest{
+			CartID:               "cart-123",
+			CustomerName:         "John Doe",
+			CustomerEmail:        "john@example.com",
+			CustomerPhone:        "+1234567890",
+			ShippingAddress:      "123 Test St",
+			ShippingCityName:     "Jakarta",
+			ShippingProvinceName: "DKI Jakarta",
+			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
+			ShippingPostalCode:   "12190",
+			ShippingCourier:      "jne",
+			ShippingService:      "REG",
+			ShippingCost:         10000,
+		}
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(emptyCart, nil)
+
+		// Act
+		result, err := service.CreateOrder(req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "cart is empty")
+	})
+
+	t.Run("Error - Cart not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		req := request.CreateOrderRequest{
+			CartID:               "cart-123",
+			CustomerName:         "John Doe",
+			CustomerEmail:        "john@example.com",
+			CustomerPhone:        "+1234567890",
+			ShippingAddress:      "123 Test St",
+			ShippingCityName:     "Jakarta",
+			ShippingProvinceName: "DKI Jakarta",
+			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
+			ShippingPostalCode:   "12190",
+			ShippingCourier:      "jne",
+			ShippingService:      "REG",
+			ShippingCost:         10000,
+		}
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(nil, errors.New("cart not found"))
+
+		// Act
+		result, err := service.CreateOrder(req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to get cart")
+	})
+
+	t.Run("Error - Database failure during order creation", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		cart := createTestCartWithItems()
+		req := request.CreateOrderRequest{
+			CartID:               "cart-123",
+			CustomerName:         "John Doe",
+			CustomerEmail:        "john@example.com",
+			CustomerPhone:        "+1234567890",
+			ShippingAddress:      "123 Test St",
+			ShippingCityName:     "Jakarta",
+			ShippingProvinceName: "DKI Jakarta",
+			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
+			ShippingPostalCode:   "12190",
+			ShippingCourier:      "jne",
+			ShippingService:      "REG",
+			ShippingCost:         10000,
+		}
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+
+		// Act
+		result, err := service.CreateOrder(req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to create order with items")
+	})
+
+	t.Run("Error - Insufficient stock is returned as a typed error", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		cart := createTestCartWithItems()
+		req := request.CreateOrderRequest{
 			CartID:               "cart-123",
 			CustomerName:         "John Doe",
 			CustomerEmail:        "john@example.com",
@@ -1449,14 +1927,17 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
 			ShippingCost:         10000,
-			TotalWeight:          1000,
 		}
 
-		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(nil, errors.New("cart not found"))
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Return(&repository.InsufficientStockError{SKUs: []string{"SKU-1"}})
 
 		// Act
 		result, err := service.CreateOrder(req)
@@ -1464,10 +1945,65 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 		// Assert
 		assert.Error(t, err)
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "failed to get cart")
+		var stockErr *repository.InsufficientStockError
+		assert.ErrorAs(t, err, &stockErr)
+		assert.Equal(t, []string{"SKU-1"}, stockErr.SKUs)
 	})
+}
 
-	t.Run("Error - Database failure during order creation", func(t *testing.T) {
+// Test CreateOrder with a discount code
+func TestPaymentService_CreateOrder_Discount(t *testing.T) {
+	newRequest := func(code string) request.CreateOrderRequest {
+		return request.CreateOrderRequest{
+			CartID:               "cart-123",
+			CustomerName:         "John Doe",
+			CustomerEmail:        "john@example.com",
+			CustomerPhone:        "+1234567890",
+			ShippingAddress:      "123 Test St",
+			ShippingCityName:     "Jakarta",
+			ShippingProvinceName: "DKI Jakarta",
+			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
+			ShippingPostalCode:   "12190",
+			ShippingCourier:      "jne",
+			ShippingService:      "REG",
+			ShippingCost:         10000,
+			DiscountCode:         code,
+		}
+	}
+
+	t.Run("Success - Discount is re-validated and stored on the order", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		discount := createTestDiscount()
+		var savedOrder *entity.Order
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(discount, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, items []entity.OrderItem) { savedOrder = order }).
+			Return(nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest("TEST10"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 25.0, savedOrder.DiscountAmount) // 10% of 250
+		assert.Equal(t, "TEST10", savedOrder.DiscountCodeApplied)
+		assert.Equal(t, 250.0-25.0+10000, savedOrder.TotalAmount)
+	})
+
+	t.Run("Success - Discount remembered on the cart is used", func(t *testing.T) {
 		// Arrange
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -1478,7 +2014,103 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
 
 		cart := createTestCartWithItems()
-		req := request.CreateOrderRequest{
+		code := "TEST10"
+		cart.DiscountCode = &code
+		var savedOrder *entity.Order
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(createTestDiscount(), nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, items []entity.OrderItem) { savedOrder = order }).
+			Return(nil)
+
+		// Act
+		_, err := service.CreateOrder(newRequest(""))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "TEST10", savedOrder.DiscountCodeApplied)
+		assert.Equal(t, 25.0, savedOrder.DiscountAmount)
+	})
+
+	t.Run("Error - Unknown discount code", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("NOPE").Return(nil, errors.New("record not found"))
+
+		// Act
+		result, err := service.CreateOrder(newRequest("NOPE"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrInvalidDiscount)
+		assert.Contains(t, err.Error(), "discount not found")
+	})
+
+	t.Run("Error - Expired discount is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		discount := createTestDiscount()
+		past := time.Now().Add(-time.Hour)
+		discount.ExpiresAt = &past
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(discount, nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest("TEST10"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrInvalidDiscount)
+		assert.Contains(t, err.Error(), "discount has expired")
+	})
+
+	t.Run("Error - Usage limit reached while the order is saved", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(createTestCartWithItems(), nil)
+		mockCartRepo.EXPECT().GetDiscountByCode("TEST10").Return(createTestDiscount(), nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).Return(repository.ErrDiscountUsageLimitReached)
+
+		// Act
+		result, err := service.CreateOrder(newRequest("TEST10"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrInvalidDiscount)
+		assert.ErrorIs(t, err, repository.ErrDiscountUsageLimitReached)
+	})
+}
+
+// Test CreateOrder shipping cost verification
+func TestPaymentService_CreateOrder_ShippingVerification(t *testing.T) {
+	newRequest := func(cost float64) request.CreateOrderRequest {
+		return request.CreateOrderRequest{
 			CartID:               "cart-123",
 			CustomerName:         "John Doe",
 			CustomerEmail:        "john@example.com",
@@ -1487,24 +2119,253 @@ func TestPaymentService_CreateOrder_ErrorCases(t *testing.T) {
 			ShippingCityName:     "Jakarta",
 			ShippingProvinceName: "DKI Jakarta",
 			ShippingDistrictName: "Kebayoran Baru",
+			ShippingDistrictID:   "114",
 			ShippingPostalCode:   "12190",
 			ShippingCourier:      "jne",
 			ShippingService:      "REG",
-			ShippingCost:         10000,
-			TotalWeight:          1000,
+			ShippingCost:         cost,
 		}
+	}
 
-		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+	newCart := func() *entity.Cart {
+		cart := createTestCartWithItems()
+		cart.CartItems[0].ProductVariant.Weight = 500 // 2 x 500g
+		cart.CartItems[1].ProductVariant.Weight = 250 // 1 x 250g
+		return cart
+	}
+
+	quotes := []entity.ShippingQuote{
+		{Courier: "jne", Service: "OKE", Cost: 8000},
+		{Courier: "jne", Service: "REG", Cost: 12000},
+	}
+
+	t.Run("Success - Quote for the cart weight is used", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+
+		var savedOrder *entity.Order
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "114", 1250, "jne").Return(quotes, nil)
 		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
-		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, items []entity.OrderItem) { savedOrder = order }).
+			Return(nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(12000))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 12000.0, savedOrder.ShippingCost)
+		assert.Equal(t, 250.0+12000, savedOrder.TotalAmount)
+	})
+
+	t.Run("Success - Free shipping rule lets the order ship for nothing", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		mockRules := mocks.NewMockShippingRuleRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+		service.shippingRules = shipping.NewRuleEvaluator(mockRules)
+
+		var savedOrder *entity.Order
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "114", 1250, "jne").Return(quotes, nil)
+		mockRules.EXPECT().FindActiveShippingRules().Return([]entity.ShippingRule{
+			{ID: "rule-1", Type: entity.ShippingRuleFreeShipping, Courier: "jne", MinSubtotal: 200, IsActive: true},
+		}, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, items []entity.OrderItem) { savedOrder = order }).
+			Return(nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(0))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 0.0, savedOrder.ShippingCost)
+		assert.Equal(t, 250.0, savedOrder.TotalAmount)
+	})
+
+	t.Run("Success - Order items record the warehouse they ship from", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		mockWarehouses := mocks.NewMockWarehouseRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+		service.origins = shipping.NewOriginPlanner(mockWarehouses)
+
+		var savedItems []entity.OrderItem
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockWarehouses.EXPECT().FindActiveWarehouses().Return([]entity.Warehouse{
+			{ID: "wh-jkt", Code: "JKT", DistrictID: "152"},
+			{ID: "wh-sby", Code: "SBY", DistrictID: "444"},
+		}, nil)
+		mockWarehouses.EXPECT().FindWarehouseStocks([]string{"variant-1", "variant-2"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-jkt", ProductVariantID: "variant-1", Quantity: 2},
+			{WarehouseID: "wh-sby", ProductVariantID: "variant-2", Quantity: 1},
+		}, nil)
+		// Each warehouse's parcel is quoted from it, and the order pays for both
+		mockShipping.EXPECT().CalculateShippingCost("152", "114", 1000, "jne").Return(quotes, nil)
+		mockShipping.EXPECT().CalculateShippingCost("444", "114", 250, "jne").Return(quotes, nil)
+		mockPaymentRepo.EXPECT().GetSeq().Return(int64(1), nil)
+		mockPaymentRepo.EXPECT().CreateOrderWithItems(gomock.Any(), gomock.Any()).
+			Do(func(order *entity.Order, items []entity.OrderItem) { savedItems = items }).
+			Return(nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(24000))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Len(t, savedItems, 2)
+		assert.Equal(t, "wh-jkt", *savedItems[0].WarehouseID)
+		assert.Equal(t, "wh-sby", *savedItems[1].WarehouseID)
+	})
+
+	t.Run("Error - Tampered shipping cost is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "114", 1250, "jne").Return(quotes, nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(0))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrShippingCostMismatch)
+		assert.Contains(t, err.Error(), "quoted 12000, got 0")
+	})
+
+	t.Run("Error - Province of another district is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockLocations := mocks.NewMockLocationRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.provinces = shipping.NewProvinceResolver(mockLocations)
+
+		req := newRequest(10000)
+		req.ShippingProvinceID = "6" // A province with free shipping, far from district 114
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockLocations.EXPECT().FindLocationDistrictProvinceID("114").Return("9", nil)
 
 		// Act
 		result, err := service.CreateOrder(req)
 
 		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrShippingProvinceMismatch)
+	})
+
+	t.Run("Error - Service not quoted for the destination", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "114", 1250, "jne").Return(quotes[:1], nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(12000))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, svc.ErrShippingServiceUnavailable)
+	})
+
+	t.Run("Error - Courier quote fails", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		mockShipping := mocks.NewMockShippingRepository(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.shippingRepo = mockShipping
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+		mockShipping.EXPECT().CalculateShippingCost(testOriginID, "114", 1250, "jne").Return(nil, errors.New("API error"))
+
+		// Act
+		result, err := service.CreateOrder(newRequest(12000))
+
+		// Assert
+		assert.Nil(t, result)
 		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to quote shipping cost")
+	})
+
+	t.Run("Error - Shipping origin not configured", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+		service.originID = ""
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(newCart(), nil)
+
+		// Act
+		result, err := service.CreateOrder(newRequest(12000))
+
+		// Assert
 		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "failed to create order with items")
+		assert.EqualError(t, err, "shipping origin is not configured")
 	})
 }
 
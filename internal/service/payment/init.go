@@ -4,6 +4,8 @@ import (
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/repository"
 	"github.com/hanifbg/landing_backend/internal/repository/util"
+	"github.com/hanifbg/landing_backend/internal/service/shipping"
+	"github.com/hanifbg/landing_backend/internal/service/stockalert"
 	"github.com/midtrans/midtrans-go"
 	"github.com/midtrans/midtrans-go/snap"
 )
@@ -14,13 +16,22 @@ type SnapClientInterface interface {
 }
 
 type PaymentService struct {
-	paymentRepo  repository.PaymentRepository
-	cartRepo     repository.CartRepository
-	snapClient   SnapClientInterface
-	baseURL      string
-	mailer       repository.Mailer
-	whatsAppRepo repository.WhatsApp
-	telegramRepo telegramService
+	paymentRepo   repository.PaymentRepository
+	cartRepo      repository.CartRepository
+	shippingRepo  repository.ShippingRateProvider
+	shippingRules *shipping.RuleEvaluator // Adjusts quotes the same way the cost endpoint does
+	origins       *shipping.OriginPlanner // Picks the warehouses orders ship from; nil ships from originID
+	provinces     *shipping.ProvinceResolver
+	customerRepo  repository.CustomerRepository
+	auditLogRepo  repository.AuditLogRepository
+	snapClient    SnapClientInterface
+	serverKey     string
+	baseURL       string
+	originID      string // RajaOngkir district ID orders ship from without warehouses
+	mailer        repository.Mailer
+	whatsAppRepo  repository.WhatsApp
+	telegramRepo  telegramService
+	lowStock      *stockalert.Alerter
 }
 
 type telegramService struct {
@@ -32,10 +43,19 @@ type telegramService struct {
 
 // New creates a PaymentService following the same pattern as other services
 func New(cfg *config.AppConfig, repo *util.RepoWrapper) *PaymentService {
-	return NewPaymentServiceWithMidtrans(repo.PaymentRepo, repo.CartRepo,
+	service := NewPaymentServiceWithMidtrans(repo.PaymentRepo, repo.CartRepo,
 		cfg.MidtransServerKey, cfg.IsProduction, cfg.BaseURL,
 		repo.MailRepo, repo.WhatsAppRepo, repo.TelegramRepo,
 		cfg.TeleToken, cfg.TeleOrderChatID, cfg.TeleMessageThreadID)
+	service.shippingRepo = shipping.NewRateAggregator(repo.ShippingRateProviders...)
+	service.shippingRules = shipping.NewRuleEvaluator(repo.ShippingRuleRepo)
+	service.origins = shipping.NewOriginPlanner(repo.WarehouseRepo)
+	service.provinces = shipping.NewProvinceResolver(repo.LocationRepo)
+	service.originID = cfg.ShippingOriginID
+	service.customerRepo = repo.CustomerRepo
+	service.auditLogRepo = repo.AuditLogRepo
+	service.lowStock = stockalert.New(cfg, repo)
+	return service
 }
 
 func NewPaymentService(paymentRepo repository.PaymentRepository, cartRepo repository.CartRepository, snapClient SnapClientInterface, baseURL string) *PaymentService {
@@ -67,6 +87,7 @@ func NewPaymentServiceWithMidtrans(paymentRepo repository.PaymentRepository, car
 		paymentRepo:  paymentRepo,
 		cartRepo:     cartRepo,
 		snapClient:   &snapClient,
+		serverKey:    midtransServerKey,
 		baseURL:      baseURL,
 		mailer:       mailer,
 		whatsAppRepo: whatsapp,
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: d:\\Code Store\\Github\\iqibla-backend\\internal\\repository\\cart.go
+// Source: internal/repository/cart.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -34,6 +34,21 @@ func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignCartToCustomer mocks base method.
+func (m *MockCartRepository) AssignCartToCustomer(cartID, customerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCartToCustomer", cartID, customerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCartToCustomer indicates an expected call of AssignCartToCustomer.
+func (mr *MockCartRepositoryMockRecorder) AssignCartToCustomer(cartID, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCartToCustomer", reflect.TypeOf((*MockCartRepository)(nil).AssignCartToCustomer), cartID, customerID)
+}
+
 // CreateCart mocks base method.
 func (m *MockCartRepository) CreateCart(cart *entity.Cart) error {
 	m.ctrl.T.Helper()
@@ -76,6 +91,21 @@ func (mr *MockCartRepositoryMockRecorder) DeleteCartItem(cartID, variantID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCartItem", reflect.TypeOf((*MockCartRepository)(nil).DeleteCartItem), cartID, variantID)
 }
 
+// FindActiveCartByCustomerID mocks base method.
+func (m *MockCartRepository) FindActiveCartByCustomerID(customerID string) (*entity.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveCartByCustomerID", customerID)
+	ret0, _ := ret[0].(*entity.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveCartByCustomerID indicates an expected call of FindActiveCartByCustomerID.
+func (mr *MockCartRepositoryMockRecorder) FindActiveCartByCustomerID(customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveCartByCustomerID", reflect.TypeOf((*MockCartRepository)(nil).FindActiveCartByCustomerID), customerID)
+}
+
 // FindCartByID mocks base method.
 func (m *MockCartRepository) FindCartByID(cartID string) (*entity.Cart, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +196,35 @@ func (mr *MockCartRepositoryMockRecorder) GetProductVariantByID(variantID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductVariantByID", reflect.TypeOf((*MockCartRepository)(nil).GetProductVariantByID), variantID)
 }
 
+// MergeCartItems mocks base method.
+func (m *MockCartRepository) MergeCartItems(guestCartID, customerCartID string, items []entity.CartItem) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCartItems", guestCartID, customerCartID, items)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeCartItems indicates an expected call of MergeCartItems.
+func (mr *MockCartRepositoryMockRecorder) MergeCartItems(guestCartID, customerCartID, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCartItems", reflect.TypeOf((*MockCartRepository)(nil).MergeCartItems), guestCartID, customerCartID, items)
+}
+
+// UpdateCartDiscount mocks base method.
+func (m *MockCartRepository) UpdateCartDiscount(cartID string, discountCode *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartDiscount", cartID, discountCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCartDiscount indicates an expected call of UpdateCartDiscount.
+func (mr *MockCartRepositoryMockRecorder) UpdateCartDiscount(cartID, discountCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartDiscount", reflect.TypeOf((*MockCartRepository)(nil).UpdateCartDiscount), cartID, discountCode)
+}
+
 // UpdateCartItem mocks base method.
 func (m *MockCartRepository) UpdateCartItem(item *entity.CartItem) error {
 	m.ctrl.T.Helper()
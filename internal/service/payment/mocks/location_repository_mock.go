@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: location.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockLocationRepository is a mock of LocationRepository interface.
+type MockLocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationRepositoryMockRecorder
+}
+
+// MockLocationRepositoryMockRecorder is the mock recorder for MockLocationRepository.
+type MockLocationRepositoryMockRecorder struct {
+	mock *MockLocationRepository
+}
+
+// NewMockLocationRepository creates a new mock instance.
+func NewMockLocationRepository(ctrl *gomock.Controller) *MockLocationRepository {
+	mock := &MockLocationRepository{ctrl: ctrl}
+	mock.recorder = &MockLocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationRepository) EXPECT() *MockLocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindCitiesDueForDistrictSync mocks base method.
+func (m *MockLocationRepository) FindCitiesDueForDistrictSync(before time.Time, limit int) ([]entity.LocationCity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCitiesDueForDistrictSync", before, limit)
+	ret0, _ := ret[0].([]entity.LocationCity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCitiesDueForDistrictSync indicates an expected call of FindCitiesDueForDistrictSync.
+func (mr *MockLocationRepositoryMockRecorder) FindCitiesDueForDistrictSync(before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCitiesDueForDistrictSync", reflect.TypeOf((*MockLocationRepository)(nil).FindCitiesDueForDistrictSync), before, limit)
+}
+
+// FindLocationCities mocks base method.
+func (m *MockLocationRepository) FindLocationCities(provinceID, cityID string) ([]entity.LocationCity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocationCities", provinceID, cityID)
+	ret0, _ := ret[0].([]entity.LocationCity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocationCities indicates an expected call of FindLocationCities.
+func (mr *MockLocationRepositoryMockRecorder) FindLocationCities(provinceID, cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocationCities", reflect.TypeOf((*MockLocationRepository)(nil).FindLocationCities), provinceID, cityID)
+}
+
+// FindLocationDistrictProvinceID mocks base method.
+func (m *MockLocationRepository) FindLocationDistrictProvinceID(districtID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocationDistrictProvinceID", districtID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocationDistrictProvinceID indicates an expected call of FindLocationDistrictProvinceID.
+func (mr *MockLocationRepositoryMockRecorder) FindLocationDistrictProvinceID(districtID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocationDistrictProvinceID", reflect.TypeOf((*MockLocationRepository)(nil).FindLocationDistrictProvinceID), districtID)
+}
+
+// FindLocationDistricts mocks base method.
+func (m *MockLocationRepository) FindLocationDistricts(cityID string) ([]entity.LocationDistrict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocationDistricts", cityID)
+	ret0, _ := ret[0].([]entity.LocationDistrict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocationDistricts indicates an expected call of FindLocationDistricts.
+func (mr *MockLocationRepositoryMockRecorder) FindLocationDistricts(cityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocationDistricts", reflect.TypeOf((*MockLocationRepository)(nil).FindLocationDistricts), cityID)
+}
+
+// FindLocationProvinces mocks base method.
+func (m *MockLocationRepository) FindLocationProvinces(provinceID string) ([]entity.LocationProvince, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLocationProvinces", provinceID)
+	ret0, _ := ret[0].([]entity.LocationProvince)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLocationProvinces indicates an expected call of FindLocationProvinces.
+func (mr *MockLocationRepositoryMockRecorder) FindLocationProvinces(provinceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLocationProvinces", reflect.TypeOf((*MockLocationRepository)(nil).FindLocationProvinces), provinceID)
+}
+
+// FindProvincesDueForCitySync mocks base method.
+func (m *MockLocationRepository) FindProvincesDueForCitySync(before time.Time, limit int) ([]entity.LocationProvince, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProvincesDueForCitySync", before, limit)
+	ret0, _ := ret[0].([]entity.LocationProvince)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProvincesDueForCitySync indicates an expected call of FindProvincesDueForCitySync.
+func (mr *MockLocationRepositoryMockRecorder) FindProvincesDueForCitySync(before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProvincesDueForCitySync", reflect.TypeOf((*MockLocationRepository)(nil).FindProvincesDueForCitySync), before, limit)
+}
+
+// ReplaceLocationCities mocks base method.
+func (m *MockLocationRepository) ReplaceLocationCities(provinceID string, cities []entity.ShippingCity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceLocationCities", provinceID, cities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceLocationCities indicates an expected call of ReplaceLocationCities.
+func (mr *MockLocationRepositoryMockRecorder) ReplaceLocationCities(provinceID, cities interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceLocationCities", reflect.TypeOf((*MockLocationRepository)(nil).ReplaceLocationCities), provinceID, cities)
+}
+
+// ReplaceLocationDistricts mocks base method.
+func (m *MockLocationRepository) ReplaceLocationDistricts(cityID string, districts []entity.ShippingDistrict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceLocationDistricts", cityID, districts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceLocationDistricts indicates an expected call of ReplaceLocationDistricts.
+func (mr *MockLocationRepositoryMockRecorder) ReplaceLocationDistricts(cityID, districts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceLocationDistricts", reflect.TypeOf((*MockLocationRepository)(nil).ReplaceLocationDistricts), cityID, districts)
+}
+
+// ReplaceLocationProvinces mocks base method.
+func (m *MockLocationRepository) ReplaceLocationProvinces(provinces []entity.ShippingProvince) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceLocationProvinces", provinces)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceLocationProvinces indicates an expected call of ReplaceLocationProvinces.
+func (mr *MockLocationRepositoryMockRecorder) ReplaceLocationProvinces(provinces interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceLocationProvinces", reflect.TypeOf((*MockLocationRepository)(nil).ReplaceLocationProvinces), provinces)
+}
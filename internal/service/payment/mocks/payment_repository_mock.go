@@ -1,14 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: d:\\Code Store\\Github\\iqibla-backend\\internal\\repository\\payment.go
+// Source: github.com/hanifbg/landing_backend/internal/repository (interfaces: PaymentRepository,Mailer,WhatsApp)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+	repository "github.com/hanifbg/landing_backend/internal/repository"
 )
 
 // MockPaymentRepository is a mock of PaymentRepository interface.
@@ -17,15 +19,6 @@ type MockPaymentRepository struct {
 	recorder *MockPaymentRepositoryMockRecorder
 }
 
-// GetSeq implements repository.PaymentRepository.
-func (m *MockPaymentRepository) GetSeq() (int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSeq")
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
 // MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
 type MockPaymentRepositoryMockRecorder struct {
 	mock *MockPaymentRepository
@@ -43,197 +36,313 @@ func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateNotificationAudit mocks base method.
+func (m *MockPaymentRepository) CreateNotificationAudit(arg0 *entity.PaymentNotificationAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationAudit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotificationAudit indicates an expected call of CreateNotificationAudit.
+func (mr *MockPaymentRepositoryMockRecorder) CreateNotificationAudit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationAudit", reflect.TypeOf((*MockPaymentRepository)(nil).CreateNotificationAudit), arg0)
+}
+
 // CreateOrder mocks base method.
-func (m *MockPaymentRepository) CreateOrder(order *entity.Order) error {
+func (m *MockPaymentRepository) CreateOrder(arg0 *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrder", order)
+	ret := m.ctrl.Call(m, "CreateOrder", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrder indicates an expected call of CreateOrder.
-func (mr *MockPaymentRepositoryMockRecorder) CreateOrder(order interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) CreateOrder(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrder), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrder), arg0)
 }
 
 // CreateOrderItem mocks base method.
-func (m *MockPaymentRepository) CreateOrderItem(item *entity.OrderItem) error {
+func (m *MockPaymentRepository) CreateOrderItem(arg0 *entity.OrderItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrderItem", item)
+	ret := m.ctrl.Call(m, "CreateOrderItem", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrderItem indicates an expected call of CreateOrderItem.
-func (mr *MockPaymentRepositoryMockRecorder) CreateOrderItem(item interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) CreateOrderItem(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrderItem), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrderItem), arg0)
 }
 
 // CreateOrderWithItems mocks base method.
-func (m *MockPaymentRepository) CreateOrderWithItems(order *entity.Order, items []entity.OrderItem) error {
+func (m *MockPaymentRepository) CreateOrderWithItems(arg0 *entity.Order, arg1 []entity.OrderItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrderWithItems", order, items)
+	ret := m.ctrl.Call(m, "CreateOrderWithItems", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrderWithItems indicates an expected call of CreateOrderWithItems.
-func (mr *MockPaymentRepositoryMockRecorder) CreateOrderWithItems(order, items interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) CreateOrderWithItems(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderWithItems", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrderWithItems), order, items)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderWithItems", reflect.TypeOf((*MockPaymentRepository)(nil).CreateOrderWithItems), arg0, arg1)
 }
 
 // CreatePayment mocks base method.
-func (m *MockPaymentRepository) CreatePayment(payment *entity.Payment) error {
+func (m *MockPaymentRepository) CreatePayment(arg0 *entity.Payment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePayment", payment)
+	ret := m.ctrl.Call(m, "CreatePayment", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreatePayment indicates an expected call of CreatePayment.
-func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(payment interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePayment), payment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePayment), arg0)
+}
+
+// ExpirePayment mocks base method.
+func (m *MockPaymentRepository) ExpirePayment(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePayment", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePayment indicates an expected call of ExpirePayment.
+func (mr *MockPaymentRepositoryMockRecorder) ExpirePayment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePayment", reflect.TypeOf((*MockPaymentRepository)(nil).ExpirePayment), arg0)
+}
+
+// FindExpiredPendingPayments mocks base method.
+func (m *MockPaymentRepository) FindExpiredPendingPayments(arg0 time.Time, arg1 int) ([]entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredPendingPayments", arg0, arg1)
+	ret0, _ := ret[0].([]entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredPendingPayments indicates an expected call of FindExpiredPendingPayments.
+func (mr *MockPaymentRepositoryMockRecorder) FindExpiredPendingPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredPendingPayments", reflect.TypeOf((*MockPaymentRepository)(nil).FindExpiredPendingPayments), arg0, arg1)
 }
 
 // FindOrderByID mocks base method.
-func (m *MockPaymentRepository) FindOrderByID(orderID string) (*entity.Order, error) {
+func (m *MockPaymentRepository) FindOrderByID(arg0 string) (*entity.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOrderByID", orderID)
+	ret := m.ctrl.Call(m, "FindOrderByID", arg0)
 	ret0, _ := ret[0].(*entity.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOrderByID indicates an expected call of FindOrderByID.
-func (mr *MockPaymentRepositoryMockRecorder) FindOrderByID(orderID interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) FindOrderByID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrderByID), orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrderByID), arg0)
+}
+
+// FindOrderByNumber mocks base method.
+func (m *MockPaymentRepository) FindOrderByNumber(arg0 string) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderByNumber", arg0)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderByNumber indicates an expected call of FindOrderByNumber.
+func (mr *MockPaymentRepositoryMockRecorder) FindOrderByNumber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderByNumber", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrderByNumber), arg0)
+}
+
+// FindOrderStatusChanges mocks base method.
+func (m *MockPaymentRepository) FindOrderStatusChanges(arg0 string) ([]entity.OrderStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderStatusChanges", arg0)
+	ret0, _ := ret[0].([]entity.OrderStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderStatusChanges indicates an expected call of FindOrderStatusChanges.
+func (mr *MockPaymentRepositoryMockRecorder) FindOrderStatusChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderStatusChanges", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrderStatusChanges), arg0)
+}
+
+// FindOrders mocks base method.
+func (m *MockPaymentRepository) FindOrders(arg0 repository.OrderFilter, arg1, arg2 int) ([]entity.Order, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrders", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entity.Order)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOrders indicates an expected call of FindOrders.
+func (mr *MockPaymentRepositoryMockRecorder) FindOrders(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrders", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrders), arg0, arg1, arg2)
+}
+
+// FindOrdersByCustomerID mocks base method.
+func (m *MockPaymentRepository) FindOrdersByCustomerID(arg0 string, arg1 []string, arg2, arg3 int) ([]entity.Order, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersByCustomerID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]entity.Order)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindOrdersByCustomerID indicates an expected call of FindOrdersByCustomerID.
+func (mr *MockPaymentRepositoryMockRecorder) FindOrdersByCustomerID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersByCustomerID", reflect.TypeOf((*MockPaymentRepository)(nil).FindOrdersByCustomerID), arg0, arg1, arg2, arg3)
 }
 
 // FindPaymentByID mocks base method.
-func (m *MockPaymentRepository) FindPaymentByID(paymentID string) (*entity.Payment, error) {
+func (m *MockPaymentRepository) FindPaymentByID(arg0 string) (*entity.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPaymentByID", paymentID)
+	ret := m.ctrl.Call(m, "FindPaymentByID", arg0)
 	ret0, _ := ret[0].(*entity.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPaymentByID indicates an expected call of FindPaymentByID.
-func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByID(paymentID interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByID), paymentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByID), arg0)
 }
 
 // FindPaymentByOrderID mocks base method.
-func (m *MockPaymentRepository) FindPaymentByOrderID(orderID string) (*entity.Payment, error) {
+func (m *MockPaymentRepository) FindPaymentByOrderID(arg0 string) (*entity.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPaymentByOrderID", orderID)
+	ret := m.ctrl.Call(m, "FindPaymentByOrderID", arg0)
 	ret0, _ := ret[0].(*entity.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPaymentByOrderID indicates an expected call of FindPaymentByOrderID.
-func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByOrderID(orderID interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByOrderID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByOrderID), orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByOrderID), arg0)
 }
 
 // FindPaymentByTransactionID mocks base method.
-func (m *MockPaymentRepository) FindPaymentByTransactionID(transactionID string) (*entity.Payment, error) {
+func (m *MockPaymentRepository) FindPaymentByTransactionID(arg0 string) (*entity.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPaymentByTransactionID", transactionID)
+	ret := m.ctrl.Call(m, "FindPaymentByTransactionID", arg0)
 	ret0, _ := ret[0].(*entity.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPaymentByTransactionID indicates an expected call of FindPaymentByTransactionID.
-func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByTransactionID(transactionID interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) FindPaymentByTransactionID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByTransactionID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByTransactionID), transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentByTransactionID", reflect.TypeOf((*MockPaymentRepository)(nil).FindPaymentByTransactionID), arg0)
 }
 
 // GetOrderWithItems mocks base method.
-func (m *MockPaymentRepository) GetOrderWithItems(orderID string) (*entity.Order, error) {
+func (m *MockPaymentRepository) GetOrderWithItems(arg0 string) (*entity.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderWithItems", orderID)
+	ret := m.ctrl.Call(m, "GetOrderWithItems", arg0)
 	ret0, _ := ret[0].(*entity.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrderWithItems indicates an expected call of GetOrderWithItems.
-func (mr *MockPaymentRepositoryMockRecorder) GetOrderWithItems(orderID interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) GetOrderWithItems(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItems", reflect.TypeOf((*MockPaymentRepository)(nil).GetOrderWithItems), orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItems", reflect.TypeOf((*MockPaymentRepository)(nil).GetOrderWithItems), arg0)
+}
+
+// GetSeq mocks base method.
+func (m *MockPaymentRepository) GetSeq() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeq")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeq indicates an expected call of GetSeq.
+func (mr *MockPaymentRepositoryMockRecorder) GetSeq() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeq", reflect.TypeOf((*MockPaymentRepository)(nil).GetSeq))
 }
 
 // UpdateOrderStatus mocks base method.
-func (m *MockPaymentRepository) UpdateOrderStatus(orderID, status string) error {
+func (m *MockPaymentRepository) UpdateOrderStatus(arg0 *entity.OrderStatusChange) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrderStatus", orderID, status)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
-func (mr *MockPaymentRepositoryMockRecorder) UpdateOrderStatus(orderID, status interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) UpdateOrderStatus(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdateOrderStatus), orderID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdateOrderStatus), arg0)
 }
 
 // UpdatePayment mocks base method.
-func (m *MockPaymentRepository) UpdatePayment(payment *entity.Payment) error {
+func (m *MockPaymentRepository) UpdatePayment(arg0 *entity.Payment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePayment", payment)
+	ret := m.ctrl.Call(m, "UpdatePayment", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePayment indicates an expected call of UpdatePayment.
-func (mr *MockPaymentRepositoryMockRecorder) UpdatePayment(payment interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePayment), payment)
-}
-
-// GetSeq indicates an expected call of GetSeq.
-func (mr *MockPaymentRepositoryMockRecorder) GetSeq() *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePayment(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeq", reflect.TypeOf((*MockPaymentRepository)(nil).GetSeq))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePayment), arg0)
 }
 
 // UpdatePaymentAndOrderStatus mocks base method.
-func (m *MockPaymentRepository) UpdatePaymentAndOrderStatus(payment *entity.Payment, orderID, orderStatus string) error {
+func (m *MockPaymentRepository) UpdatePaymentAndOrderStatus(arg0 *entity.Payment, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePaymentAndOrderStatus", payment, orderID, orderStatus)
+	ret := m.ctrl.Call(m, "UpdatePaymentAndOrderStatus", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePaymentAndOrderStatus indicates an expected call of UpdatePaymentAndOrderStatus.
-func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentAndOrderStatus(payment, orderID, orderStatus interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentAndOrderStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentAndOrderStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentAndOrderStatus), payment, orderID, orderStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentAndOrderStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentAndOrderStatus), arg0, arg1, arg2)
 }
 
 // UpdatePaymentStatus mocks base method.
-func (m *MockPaymentRepository) UpdatePaymentStatus(paymentID string, status entity.PaymentStatus) error {
+func (m *MockPaymentRepository) UpdatePaymentStatus(arg0 string, arg1 entity.PaymentStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePaymentStatus", paymentID, status)
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
-func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentStatus(paymentID, status interface{}) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentStatus(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentStatus), paymentID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentStatus), arg0, arg1)
 }
 
 // MockMailer is a mock of Mailer interface.
@@ -260,31 +369,87 @@ func (m *MockMailer) EXPECT() *MockMailerMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockMailer) Send(from, to, subject, body string) error {
+func (m *MockMailer) Send(arg0, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", from, to, subject, body)
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockMailerMockRecorder) Send(from, to, subject, body interface{}) *gomock.Call {
+func (mr *MockMailerMockRecorder) Send(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), arg0, arg1, arg2, arg3)
+}
+
+// SendEmailVerification mocks base method.
+func (m *MockMailer) SendEmailVerification(arg0 *entity.Customer, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmailVerification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmailVerification indicates an expected call of SendEmailVerification.
+func (mr *MockMailerMockRecorder) SendEmailVerification(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailer)(nil).Send), from, to, subject, body)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmailVerification", reflect.TypeOf((*MockMailer)(nil).SendEmailVerification), arg0, arg1, arg2)
 }
 
 // SendOrderConfirmation mocks base method.
-func (m *MockMailer) SendOrderConfirmation(order *entity.Order, items []entity.OrderItem) error {
+func (m *MockMailer) SendOrderConfirmation(arg0 *entity.Order, arg1 []entity.OrderItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendOrderConfirmation", order, items)
+	ret := m.ctrl.Call(m, "SendOrderConfirmation", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendOrderConfirmation indicates an expected call of SendOrderConfirmation.
-func (mr *MockMailerMockRecorder) SendOrderConfirmation(order interface{}, items interface{}) *gomock.Call {
+func (mr *MockMailerMockRecorder) SendOrderConfirmation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderConfirmation", reflect.TypeOf((*MockMailer)(nil).SendOrderConfirmation), arg0, arg1)
+}
+
+// SendPasswordReset mocks base method.
+func (m *MockMailer) SendPasswordReset(arg0 *entity.Customer, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordReset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordReset indicates an expected call of SendPasswordReset.
+func (mr *MockMailerMockRecorder) SendPasswordReset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordReset", reflect.TypeOf((*MockMailer)(nil).SendPasswordReset), arg0, arg1, arg2)
+}
+
+// SendShipmentDelivered mocks base method.
+func (m *MockMailer) SendShipmentDelivered(arg0 *entity.Order, arg1 *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendShipmentDelivered", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendShipmentDelivered indicates an expected call of SendShipmentDelivered.
+func (mr *MockMailerMockRecorder) SendShipmentDelivered(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendShipmentDelivered", reflect.TypeOf((*MockMailer)(nil).SendShipmentDelivered), arg0, arg1)
+}
+
+// SendShipmentDispatched mocks base method.
+func (m *MockMailer) SendShipmentDispatched(arg0 *entity.Order, arg1 *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendShipmentDispatched", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendShipmentDispatched indicates an expected call of SendShipmentDispatched.
+func (mr *MockMailerMockRecorder) SendShipmentDispatched(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOrderConfirmation", reflect.TypeOf((*MockMailer)(nil).SendOrderConfirmation), order, items)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendShipmentDispatched", reflect.TypeOf((*MockMailer)(nil).SendShipmentDispatched), arg0, arg1)
 }
 
 // MockWhatsApp is a mock of WhatsApp interface.
@@ -311,15 +476,15 @@ func (m *MockWhatsApp) EXPECT() *MockWhatsAppMockRecorder {
 }
 
 // SendMessage mocks base method.
-func (m *MockWhatsApp) SendMessage(phoneNumber, message string) error {
+func (m *MockWhatsApp) SendMessage(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", phoneNumber, message)
+	ret := m.ctrl.Call(m, "SendMessage", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockWhatsAppMockRecorder) SendMessage(phoneNumber, message interface{}) *gomock.Call {
+func (mr *MockWhatsAppMockRecorder) SendMessage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockWhatsApp)(nil).SendMessage), phoneNumber, message)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockWhatsApp)(nil).SendMessage), arg0, arg1)
 }
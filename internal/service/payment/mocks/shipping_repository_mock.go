@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/hanifbg/landing_backend/internal/repository (interfaces: ShippingRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockShippingRepository is a mock of ShippingRepository interface.
+type MockShippingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRepositoryMockRecorder
+}
+
+// MockShippingRepositoryMockRecorder is the mock recorder for MockShippingRepository.
+type MockShippingRepositoryMockRecorder struct {
+	mock *MockShippingRepository
+}
+
+// NewMockShippingRepository creates a new mock instance.
+func NewMockShippingRepository(ctrl *gomock.Controller) *MockShippingRepository {
+	mock := &MockShippingRepository{ctrl: ctrl}
+	mock.recorder = &MockShippingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRepository) EXPECT() *MockShippingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CalculateShippingCost mocks base method.
+func (m *MockShippingRepository) CalculateShippingCost(arg0, arg1 string, arg2 int, arg3 string) ([]entity.ShippingQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateShippingCost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]entity.ShippingQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateShippingCost indicates an expected call of CalculateShippingCost.
+func (mr *MockShippingRepositoryMockRecorder) CalculateShippingCost(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateShippingCost", reflect.TypeOf((*MockShippingRepository)(nil).CalculateShippingCost), arg0, arg1, arg2, arg3)
+}
+
+// Couriers mocks base method.
+func (m *MockShippingRepository) Couriers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Couriers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Couriers indicates an expected call of Couriers.
+func (mr *MockShippingRepositoryMockRecorder) Couriers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Couriers", reflect.TypeOf((*MockShippingRepository)(nil).Couriers))
+}
+
+// GetCities mocks base method.
+func (m *MockShippingRepository) GetCities(arg0, arg1 string) ([]entity.ShippingCity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCities", arg0, arg1)
+	ret0, _ := ret[0].([]entity.ShippingCity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockShippingRepositoryMockRecorder) GetCities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockShippingRepository)(nil).GetCities), arg0, arg1)
+}
+
+// GetDistricts mocks base method.
+func (m *MockShippingRepository) GetDistricts(arg0 string) ([]entity.ShippingDistrict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDistricts", arg0)
+	ret0, _ := ret[0].([]entity.ShippingDistrict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDistricts indicates an expected call of GetDistricts.
+func (mr *MockShippingRepositoryMockRecorder) GetDistricts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDistricts", reflect.TypeOf((*MockShippingRepository)(nil).GetDistricts), arg0)
+}
+
+// GetProvinces mocks base method.
+func (m *MockShippingRepository) GetProvinces(arg0 string) ([]entity.ShippingProvince, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvinces", arg0)
+	ret0, _ := ret[0].([]entity.ShippingProvince)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvinces indicates an expected call of GetProvinces.
+func (mr *MockShippingRepositoryMockRecorder) GetProvinces(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvinces", reflect.TypeOf((*MockShippingRepository)(nil).GetProvinces), arg0)
+}
+
+// Name mocks base method.
+func (m *MockShippingRepository) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockShippingRepositoryMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockShippingRepository)(nil).Name))
+}
+
+// ValidateAWB mocks base method.
+func (m *MockShippingRepository) ValidateAWB(arg0, arg1 string, arg2 *string) (*entity.TrackingData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAWB", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.TrackingData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAWB indicates an expected call of ValidateAWB.
+func (mr *MockShippingRepositoryMockRecorder) ValidateAWB(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAWB", reflect.TypeOf((*MockShippingRepository)(nil).ValidateAWB), arg0, arg1, arg2)
+}
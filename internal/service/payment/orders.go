@@ -0,0 +1,129 @@
+package payment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+const (
+	defaultOrdersPageSize = 10
+	maxOrdersPageSize     = 50
+)
+
+func (s *PaymentService) GetOrderSummary(orderID string) (*response.OrderResponse, error) {
+	orderResponse, err := s.GetOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Knowing the order ID is not proof of being the customer
+	orderResponse.CustomerName = ""
+	orderResponse.CustomerEmail = ""
+	orderResponse.CustomerPhone = ""
+	orderResponse.ShippingAddress = ""
+	orderResponse.ShippingPostalCode = ""
+	orderResponse.Notes = ""
+
+	return orderResponse, nil
+}
+
+func (s *PaymentService) ListCustomerOrders(customerID string, req request.ListCustomerOrdersRequest) (*response.OrderListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := req.Limit
+	if limit < 1 {
+		limit = defaultOrdersPageSize
+	}
+	if limit > maxOrdersPageSize {
+		limit = maxOrdersPageSize
+	}
+
+	orders, total, err := s.paymentRepo.FindOrdersByCustomerID(customerID, req.Statuses, (page-1)*limit, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %v", err)
+	}
+
+	summaries := make([]response.OrderSummaryResponse, 0, len(orders))
+	for i := range orders {
+		summaries = append(summaries, toOrderSummary(&orders[i]))
+	}
+
+	return &response.OrderListResponse{
+		Orders:     summaries,
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+	}, nil
+}
+
+func (s *PaymentService) GetCustomerOrder(customerID, orderID string) (*response.OrderResponse, error) {
+	order, err := s.paymentRepo.GetOrderWithItems(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrOrderNotFound, err)
+	}
+
+	// Someone else's order is reported exactly like a missing one
+	if order.CustomerID == nil || *order.CustomerID != customerID {
+		return nil, service.ErrOrderNotFound
+	}
+
+	return s.buildOrderResponse(order), nil
+}
+
+func (s *PaymentService) LookupGuestOrder(req request.GuestOrderLookupRequest) (*response.OrderResponse, error) {
+	order, err := s.paymentRepo.FindOrderByNumber(strings.TrimSpace(req.OrderNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %v", err)
+	}
+	if order == nil {
+		return nil, service.ErrOrderNotFound
+	}
+
+	emailMatches := req.Email != "" && strings.EqualFold(strings.TrimSpace(req.Email), strings.TrimSpace(order.CustomerEmail))
+	phoneMatches := req.Phone != "" && entity.SamePhoneNumber(req.Phone, order.CustomerPhone)
+	if !emailMatches && !phoneMatches {
+		return nil, service.ErrOrderNotFound
+	}
+
+	return s.buildOrderResponse(order), nil
+}
+
+func toOrderSummary(order *entity.Order) response.OrderSummaryResponse {
+	totalItems := 0
+	items := make([]response.OrderItemResponse, 0, len(order.OrderItems))
+	for _, item := range order.OrderItems {
+		totalItems += item.Quantity
+
+		itemResponse := response.OrderItemResponse{
+			ID:               item.ID,
+			ProductVariantID: item.ProductVariantID,
+			Quantity:         item.Quantity,
+			PriceAtPurchase:  item.PriceAtPurchase,
+			WarehouseID:      item.WarehouseID,
+		}
+		if item.ProductVariant != nil {
+			itemResponse.ProductName = item.ProductVariant.Name
+			itemResponse.ProductImage = item.ProductVariant.ImageURL
+		}
+		items = append(items, itemResponse)
+	}
+
+	return response.OrderSummaryResponse{
+		ID:          order.ID,
+		OrderNumber: order.OrderNumber,
+		OrderStatus: order.OrderStatus,
+		TotalAmount: order.TotalAmount,
+		Currency:    order.Currency,
+		TotalItems:  totalItems,
+		OrderItems:  items,
+		CreatedAt:   order.CreatedAt,
+	}
+}
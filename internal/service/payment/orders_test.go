@@ -0,0 +1,246 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/payment/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function to create a test order placed by a logged in customer
+func createTestCustomerOrder(customerID string) *entity.Order {
+	order := createTestOrder()
+	order.OrderNumber = "IQB-2025-00001"
+	order.CustomerPhone = "0812-3456-7890"
+	order.CustomerID = &customerID
+	order.OrderItems = []entity.OrderItem{
+		{
+			ID:               "item-1",
+			OrderID:          "order-123",
+			ProductVariantID: "variant-1",
+			Quantity:         2,
+			PriceAtPurchase:  100.0,
+			ProductVariant:   &entity.ProductVariant{ID: "variant-1", Name: "Test Product"},
+		},
+	}
+	return order
+}
+
+func TestPaymentService_GetOrderSummary(t *testing.T) {
+	t.Run("Success - Customer details are left out", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(createTestCustomerOrder("customer-123"), nil)
+		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(nil, nil)
+
+		// Act
+		result, err := service.GetOrderSummary("order-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "IQB-2025-00001", result.OrderNumber)
+		assert.Len(t, result.OrderItems, 1)
+		assert.Empty(t, result.CustomerName)
+		assert.Empty(t, result.CustomerEmail)
+		assert.Empty(t, result.CustomerPhone)
+		assert.Empty(t, result.ShippingAddress)
+	})
+}
+
+func TestPaymentService_ListCustomerOrders(t *testing.T) {
+	t.Run("Success - Page of orders with totals", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		orders := []entity.Order{*createTestCustomerOrder("customer-123")}
+		mockPaymentRepo.EXPECT().FindOrdersByCustomerID("customer-123", []string{"pending"}, 5, 5).Return(orders, int64(11), nil)
+
+		// Act
+		result, err := service.ListCustomerOrders("customer-123", request.ListCustomerOrdersRequest{
+			Statuses: []string{"pending"},
+			Page:     2,
+			Limit:    5,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Page)
+		assert.Equal(t, 5, result.Limit)
+		assert.Equal(t, int64(11), result.Total)
+		assert.Equal(t, 3, result.TotalPages)
+		assert.Len(t, result.Orders, 1)
+		assert.Equal(t, 2, result.Orders[0].TotalItems)
+		assert.Equal(t, "Test Product", result.Orders[0].OrderItems[0].ProductName)
+	})
+
+	t.Run("Success - Defaults and limits the page size", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		service := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().FindOrdersByCustomerID("customer-123", nil, 0, 10).Return(nil, int64(0), nil)
+		mockPaymentRepo.EXPECT().FindOrdersByCustomerID("customer-123", nil, 0, 50).Return(nil, int64(0), nil)
+
+		// Act
+		defaults, err := service.ListCustomerOrders("customer-123", request.ListCustomerOrdersRequest{})
+		assert.NoError(t, err)
+		capped, err := service.ListCustomerOrders("customer-123", request.ListCustomerOrdersRequest{Limit: 500})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, defaults.Page)
+		assert.Equal(t, 10, defaults.Limit)
+		assert.NotNil(t, defaults.Orders)
+		assert.Equal(t, 0, defaults.TotalPages)
+		assert.Equal(t, 50, capped.Limit)
+	})
+}
+
+func TestPaymentService_GetCustomerOrder(t *testing.T) {
+	t.Run("Success - Order of the customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(createTestCustomerOrder("customer-123"), nil)
+		mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(nil, nil)
+
+		// Act
+		result, err := paymentService.GetCustomerOrder("customer-123", "order-123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe", result.CustomerName)
+	})
+
+	t.Run("Error - Order of another customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(createTestCustomerOrder("customer-456"), nil)
+
+		// Act
+		result, err := paymentService.GetCustomerOrder("customer-123", "order-123")
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	})
+
+	t.Run("Error - Guest order", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().GetOrderWithItems("order-123").Return(createTestOrder(), nil)
+
+		// Act
+		result, err := paymentService.GetCustomerOrder("customer-123", "order-123")
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	})
+}
+
+func TestPaymentService_LookupGuestOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   request.GuestOrderLookupRequest
+		found bool
+	}{
+		{name: "email in any case", req: request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-00001", Email: "JOHN@example.com"}, found: true},
+		{name: "phone with country code", req: request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-00001", Phone: "+62 812 3456 7890"}, found: true},
+		{name: "phone with leading zero", req: request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-00001", Phone: "081234567890"}, found: true},
+		{name: "wrong email", req: request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-00001", Email: "jane@example.com"}},
+		{name: "wrong phone", req: request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-00001", Phone: "081200000000"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+			mockCartRepo := mocks.NewMockCartRepository(ctrl)
+			mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+			paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+			order := createTestCustomerOrder("customer-123")
+			order.CustomerID = nil
+			mockPaymentRepo.EXPECT().FindOrderByNumber("IQB-2025-00001").Return(order, nil)
+			mockPaymentRepo.EXPECT().FindPaymentByOrderID("order-123").Return(nil, nil).AnyTimes()
+
+			// Act
+			result, err := paymentService.LookupGuestOrder(tt.req)
+
+			// Assert
+			if tt.found {
+				assert.NoError(t, err)
+				assert.Equal(t, "order-123", result.ID)
+			} else {
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, service.ErrOrderNotFound)
+			}
+		})
+	}
+
+	t.Run("Error - Unknown order number", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPaymentRepo := mocks.NewMockPaymentRepository(ctrl)
+		mockCartRepo := mocks.NewMockCartRepository(ctrl)
+		mockSnapClient := mocks.NewMockSnapClientInterface(ctrl)
+		paymentService := createTestPaymentService(ctrl, mockPaymentRepo, mockCartRepo, mockSnapClient)
+
+		mockPaymentRepo.EXPECT().FindOrderByNumber("IQB-2025-99999").Return(nil, nil)
+
+		// Act
+		result, err := paymentService.LookupGuestOrder(request.GuestOrderLookupRequest{OrderNumber: "IQB-2025-99999", Email: "john@example.com"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	})
+}
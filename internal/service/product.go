@@ -1,8 +1,67 @@
 package service
 
-import "github.com/hanifbg/landing_backend/internal/model/entity"
+import (
+	"errors"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+)
 
 type ProductService interface {
 	GetAllProducts(category string) ([]entity.Product, error)
 	GetProductByID(id string) (*entity.Product, error)
 }
+
+// ProductAdminService manages the catalog for the admin API. Deleting a product or
+// variant only deactivates it, so past orders keep their references and it can be
+// reactivated later.
+type ProductAdminService interface {
+	ListProducts(includeInactive bool) ([]entity.Product, error)
+	GetProduct(id string) (*entity.Product, error)
+	CreateProduct(actor AdminActor, req request.CreateProductRequest) (*entity.Product, error)
+	UpdateProduct(actor AdminActor, id string, req request.ProductRequest) (*entity.Product, error)
+	SetProductActive(actor AdminActor, id string, active bool) error
+
+	CreateVariant(actor AdminActor, productID string, req request.VariantRequest) (*entity.ProductVariant, error)
+	UpdateVariant(actor AdminActor, productID, variantID string, req request.VariantRequest) (*entity.ProductVariant, error)
+	SetVariantActive(actor AdminActor, productID, variantID string, active bool) error
+}
+
+// InventoryAdminService exposes a variant's stock ledger to the admin API. Stock only
+// changes through the ledger: orders record sales and cancellation releases, admins
+// record restocks, returns and adjustments.
+type InventoryAdminService interface {
+	GetInventoryHistory(productID, variantID string, page, limit int) (*response.InventoryHistoryResponse, error)
+	AdjustInventory(actor AdminActor, productID, variantID string, req request.InventoryAdjustmentRequest) (*response.InventoryAdjustmentResponse, error)
+	// RebuildStock sets the variant's stock to what its ledger adds up to
+	RebuildStock(actor AdminActor, productID, variantID string) (*response.StockRebuildResponse, error)
+}
+
+var (
+	// ErrProductNotFound is returned when a product does not exist
+	ErrProductNotFound = errors.New("product not found")
+
+	// ErrVariantNotFound is returned when a variant does not exist or belongs to another product
+	ErrVariantNotFound = errors.New("variant not found")
+
+	// ErrDuplicateSKU is returned when a variant SKU is already used by another variant
+	ErrDuplicateSKU = errors.New("sku already exists")
+
+	// ErrNegativeStock is returned when an adjustment would leave a variant with less than zero units
+	ErrNegativeStock = errors.New("stock cannot go below zero")
+
+	// ErrInvalidInventoryAdjustment is returned when a restock or return takes stock away
+	ErrInvalidInventoryAdjustment = errors.New("restocks and returns must add stock")
+
+	// ErrWarehouseRequired is returned when stock is added without a warehouse while warehouses
+	// are in use. Orders only ship from warehouse stock, so the units could never be sold.
+	ErrWarehouseRequired = errors.New("stock must be placed in a warehouse, add it through an inventory adjustment with a warehouse_id")
+
+	// ErrWarehouseNotFound is returned when a stock change names a warehouse that does not exist
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+
+	// ErrStockNotEditable is returned when a variant update sends a stock quantity. After the
+	// opening stock, stock only changes through inventory adjustments, which keep the ledger.
+	ErrStockNotEditable = errors.New("stock_quantity cannot be updated, use POST /api/v1/admin/products/:id/variants/:variant_id/inventory/adjustments")
+)
@@ -0,0 +1,319 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+)
+
+func (p *ProductService) ListProducts(includeInactive bool) ([]entity.Product, error) {
+	products, err := p.productRepo.FindProducts(includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (p *ProductService) GetProduct(id string) (*entity.Product, error) {
+	product, err := p.productRepo.FindProductByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, service.ErrProductNotFound
+	}
+
+	return product, nil
+}
+
+func (p *ProductService) CreateProduct(actor service.AdminActor, req request.CreateProductRequest) (*entity.Product, error) {
+	now := time.Now()
+	product := &entity.Product{
+		ID:        uuid.New().String(),
+		IsActive:  true,
+		CreatedAt: now,
+	}
+	category, err := p.findCategory(req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	applyProductRequest(product, req.ProductRequest, category, now)
+
+	for _, variantReq := range req.Variants {
+		if variantReq.StockQuantity > 0 {
+			// Opening stock is held outside any warehouse
+			if err := p.checkStockWarehouse(""); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	seen := make(map[string]bool, len(req.Variants))
+	var movements []entity.InventoryMovement
+	for _, variantReq := range req.Variants {
+		variant := newVariant(product.ID, variantReq, now)
+		if seen[variant.SKU] {
+			return nil, fmt.Errorf("%w: %s is used by more than one variant", service.ErrDuplicateSKU, variant.SKU)
+		}
+		seen[variant.SKU] = true
+
+		if err := p.checkSKUAvailable(variant.SKU, ""); err != nil {
+			return nil, err
+		}
+		product.Variants = append(product.Variants, *variant)
+		movements = append(movements, openingStock(actor, variant.ID, variantReq.StockQuantity)...)
+	}
+
+	if err := p.productRepo.CreateProduct(product, movements); err != nil {
+		return nil, skuError(err, "failed to create product")
+	}
+	variantIDs := make([]string, 0, len(product.Variants))
+	for i := range product.Variants {
+		product.Variants[i].StockQuantity = req.Variants[i].StockQuantity
+		variantIDs = append(variantIDs, product.Variants[i].ID)
+	}
+	p.lowStock.Check(variantIDs)
+
+	audit.Record(p.auditLogRepo, actor, "product.create", entity.AuditTargetProduct, product.ID, nil, product)
+
+	return product, nil
+}
+
+func (p *ProductService) UpdateProduct(actor service.AdminActor, id string, req request.ProductRequest) (*entity.Product, error) {
+	product, err := p.GetProduct(id)
+	if err != nil {
+		return nil, err
+	}
+	before := *product
+
+	category, err := p.findCategory(req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyProductRequest(product, req, category, time.Now())
+	if err := p.productRepo.UpdateProduct(product); err != nil {
+		return nil, fmt.Errorf("failed to update product: %v", err)
+	}
+
+	audit.Record(p.auditLogRepo, actor, "product.update", entity.AuditTargetProduct, product.ID, &before, product)
+
+	return product, nil
+}
+
+func (p *ProductService) SetProductActive(actor service.AdminActor, id string, active bool) error {
+	product, err := p.GetProduct(id)
+	if err != nil {
+		return err
+	}
+
+	updated, err := p.productRepo.SetProductActive(id, active, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update product: %v", err)
+	}
+	if !updated {
+		return service.ErrProductNotFound
+	}
+
+	audit.Record(p.auditLogRepo, actor, activeAction("product", active), entity.AuditTargetProduct, id,
+		map[string]bool{"is_active": product.IsActive}, map[string]bool{"is_active": active})
+
+	return nil
+}
+
+func (p *ProductService) CreateVariant(actor service.AdminActor, productID string, req request.VariantRequest) (*entity.ProductVariant, error) {
+	if _, err := p.GetProduct(productID); err != nil {
+		return nil, err
+	}
+
+	variant := newVariant(productID, req, time.Now())
+	if err := p.checkSKUAvailable(variant.SKU, ""); err != nil {
+		return nil, err
+	}
+	if req.StockQuantity > 0 {
+		// Opening stock is held outside any warehouse
+		if err := p.checkStockWarehouse(""); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.productRepo.CreateVariant(variant, openingStock(actor, variant.ID, req.StockQuantity)); err != nil {
+		return nil, skuError(err, "failed to create variant")
+	}
+	variant.StockQuantity = req.StockQuantity
+	p.lowStock.Check([]string{variant.ID})
+
+	audit.Record(p.auditLogRepo, actor, "variant.create", entity.AuditTargetVariant, variant.ID, nil, variant)
+
+	return variant, nil
+}
+
+func (p *ProductService) UpdateVariant(actor service.AdminActor, productID, variantID string, req request.VariantRequest) (*entity.ProductVariant, error) {
+	if req.StockQuantity != 0 {
+		return nil, service.ErrStockNotEditable
+	}
+
+	variant, err := p.findVariant(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	before := *variant
+
+	applyVariantRequest(variant, req, time.Now())
+	if err := p.checkSKUAvailable(variant.SKU, variant.ID); err != nil {
+		return nil, err
+	}
+
+	if err := p.productRepo.UpdateVariant(variant); err != nil {
+		return nil, skuError(err, "failed to update variant")
+	}
+	// A raised threshold can put the current stock at or below it
+	p.lowStock.Check([]string{variant.ID})
+
+	audit.Record(p.auditLogRepo, actor, "variant.update", entity.AuditTargetVariant, variant.ID, &before, variant)
+
+	return variant, nil
+}
+
+func (p *ProductService) SetVariantActive(actor service.AdminActor, productID, variantID string, active bool) error {
+	variant, err := p.findVariant(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := p.productRepo.SetVariantActive(productID, variantID, active, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update variant: %v", err)
+	}
+	if !updated {
+		return service.ErrVariantNotFound
+	}
+
+	audit.Record(p.auditLogRepo, actor, activeAction("variant", active), entity.AuditTargetVariant, variantID,
+		map[string]bool{"is_active": variant.IsActive}, map[string]bool{"is_active": active})
+
+	return nil
+}
+
+func (p *ProductService) findVariant(productID, variantID string) (*entity.ProductVariant, error) {
+	variant, err := p.productRepo.FindVariantByID(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if variant == nil {
+		return nil, service.ErrVariantNotFound
+	}
+	return variant, nil
+}
+
+// checkSKUAvailable gives a clear error before saving. The unique index still catches
+// two admins saving the same SKU at once, see skuError.
+func (p *ProductService) checkSKUAvailable(sku, exceptVariantID string) error {
+	exists, err := p.productRepo.SKUExists(sku, exceptVariantID)
+	if err != nil {
+		return fmt.Errorf("failed to check sku: %v", err)
+	}
+	if exists {
+		return fmt.Errorf("%w: %s", service.ErrDuplicateSKU, sku)
+	}
+	return nil
+}
+
+// activeAction names the audit action of deleting or reactivating a product or variant
+func activeAction(target string, active bool) string {
+	if active {
+		return target + ".reactivate"
+	}
+	return target + ".delete"
+}
+
+func skuError(err error, message string) error {
+	if errors.Is(err, repository.ErrDuplicateSKU) {
+		return service.ErrDuplicateSKU
+	}
+	return fmt.Errorf("%s: %v", message, err)
+}
+
+func (p *ProductService) findCategory(id string) (*entity.Category, error) {
+	category, err := p.categoryRepo.FindCategoryByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %v", err)
+	}
+	if category == nil {
+		return nil, service.ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+func applyProductRequest(product *entity.Product, req request.ProductRequest, category *entity.Category, now time.Time) {
+	product.Name = strings.TrimSpace(req.Name)
+	product.Description = req.Description
+	product.CategoryID = &category.ID
+	product.Category = category.Slug
+	product.Brand = strings.TrimSpace(req.Brand)
+	product.Features = entity.JSONArray(req.Features)
+	product.InBoxItems = entity.JSONArray(req.InBoxItems)
+	product.ImageURLs = entity.JSONArray(req.ImageURLs)
+	product.TokopediaURL = req.TokopediaURL
+	product.ShopeeURL = req.ShopeeURL
+	product.UpdatedAt = now
+}
+
+// newVariant starts the variant with no stock; its opening stock is recorded as a
+// restock movement, see openingStock
+func newVariant(productID string, req request.VariantRequest, now time.Time) *entity.ProductVariant {
+	variant := &entity.ProductVariant{
+		ID:        uuid.New().String(),
+		ProductID: productID,
+		IsActive:  true,
+		CreatedAt: now,
+	}
+	applyVariantRequest(variant, req, now)
+	return variant
+}
+
+func applyVariantRequest(variant *entity.ProductVariant, req request.VariantRequest, now time.Time) {
+	variant.SKU = strings.TrimSpace(req.SKU)
+	variant.Name = strings.TrimSpace(req.Name)
+	variant.Price = req.Price
+	variant.LowStockThreshold = req.LowStockThreshold
+	variant.ImageURL = req.ImageURL
+	variant.Weight = req.Weight
+	variant.Dimensions = nil
+	if req.Dimensions != nil {
+		variant.Dimensions = &entity.Dimensions{
+			Length: req.Dimensions.Length,
+			Width:  req.Dimensions.Width,
+			Height: req.Dimensions.Height,
+			Unit:   req.Dimensions.Unit,
+		}
+	}
+	variant.AttributeValues = entity.JSONMap(req.AttributeValues)
+	variant.Specifications = entity.JSONMap(req.Specifications)
+	variant.UpdatedAt = now
+}
+
+// openingStock is the restock movement that gives a new variant its first units
+func openingStock(actor service.AdminActor, variantID string, quantity int) []entity.InventoryMovement {
+	if quantity == 0 {
+		return nil
+	}
+	return []entity.InventoryMovement{{
+		ProductVariantID: variantID,
+		Reason:           entity.InventoryReasonRestock,
+		QuantityDelta:    quantity,
+		ReferenceType:    entity.InventoryReferenceAdminUser,
+		ReferenceID:      actor.ID,
+		Note:             "Opening stock",
+	}}
+}
@@ -0,0 +1,510 @@
+package product
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/product/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function to create a product service whose category repository knows category-1
+func createTestAdminProductService(ctrl *gomock.Controller, productRepo *mocks.MockProductRepository) *ProductService {
+	categoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	categoryRepo.EXPECT().FindCategoryByID("category-1").
+		Return(&entity.Category{ID: "category-1", Slug: "jood_pro"}, nil).AnyTimes()
+	categoryRepo.EXPECT().FindCategoryByID(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	// No warehouses, so stock may be added outside of one
+	warehouseRepo := mocks.NewMockWarehouseRepository(ctrl)
+	warehouseRepo.EXPECT().FindActiveWarehouses().Return(nil, nil).AnyTimes()
+
+	svc := createTestProductService(productRepo)
+	svc.categoryRepo = categoryRepo
+	svc.auditLogRepo = mocks.NewMockAuditLogRepository(ctrl)
+	svc.warehouseRepo = warehouseRepo
+	return svc
+}
+
+var testActor = service.AdminActor{ID: "admin-1", Email: "editor@example.com", Role: entity.AdminRoleCatalogEditor}
+
+// Helper function to expect one audit log entry and capture it
+func expectAuditLog(ctrl *gomock.Controller, svc *ProductService) *entity.AdminAuditLog {
+	entry := &entity.AdminAuditLog{}
+	auditLogRepo := mocks.NewMockAuditLogRepository(ctrl)
+	auditLogRepo.EXPECT().CreateAuditLog(gomock.Any()).
+		Do(func(log *entity.AdminAuditLog) { *entry = *log }).Return(nil)
+	svc.auditLogRepo = auditLogRepo
+	return entry
+}
+
+// Helper function to create a test variant request
+func createTestVariantRequest(sku string) request.VariantRequest {
+	return request.VariantRequest{
+		SKU:             sku,
+		Name:            "Black",
+		Price:           150000,
+		StockQuantity:   10,
+		Weight:          200,
+		Dimensions:      &request.DimensionsRequest{Length: 10, Width: 5, Height: 2, Unit: "cm"},
+		AttributeValues: map[string]interface{}{"color": "black"},
+		Specifications:  map[string]interface{}{"Battery": "45mAh"},
+	}
+}
+
+// Helper function to create a test variant request for an update, which carries no stock
+func createTestVariantUpdateRequest(sku string) request.VariantRequest {
+	req := createTestVariantRequest(sku)
+	req.StockQuantity = 0
+	return req
+}
+
+// Helper function to create a test product request
+func createTestCreateProductRequest(skus ...string) request.CreateProductRequest {
+	req := request.CreateProductRequest{
+		ProductRequest: request.ProductRequest{
+			Name:       " Smart Tasbih ",
+			CategoryID: "category-1",
+			Brand:      "iQibla",
+			Features:   []string{"Vibration reminder"},
+			InBoxItems: []string{"Tasbih", "Cable"},
+		},
+	}
+	for _, sku := range skus {
+		req.Variants = append(req.Variants, createTestVariantRequest(sku))
+	}
+	return req
+}
+
+func TestProductService_CreateProduct(t *testing.T) {
+	t.Run("Success - Product is created active with its variants", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "").Return(false, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-002", "").Return(false, nil)
+		var movements []entity.InventoryMovement
+		mockProductRepo.EXPECT().CreateProduct(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(product *entity.Product, m []entity.InventoryMovement) error {
+				assert.Zero(t, product.Variants[0].StockQuantity)
+				movements = m
+				return nil
+			})
+		auditLog := expectAuditLog(ctrl, svc)
+
+		// Act
+		result, err := svc.CreateProduct(testActor, createTestCreateProductRequest("SKU-001", " SKU-002 "))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.IsActive)
+		assert.Equal(t, "Smart Tasbih", result.Name)
+		assert.Equal(t, "category-1", *result.CategoryID)
+		assert.Equal(t, "jood_pro", result.Category)
+		assert.Equal(t, entity.JSONArray{"Tasbih", "Cable"}, result.InBoxItems)
+		assert.Len(t, result.Variants, 2)
+		assert.Equal(t, "SKU-002", result.Variants[1].SKU)
+		assert.Equal(t, result.ID, result.Variants[0].ProductID)
+		assert.Equal(t, "product.create", auditLog.Action)
+		assert.Equal(t, result.ID, auditLog.TargetID)
+		assert.Equal(t, map[string]interface{}{"before": nil, "after": "Smart Tasbih"}, auditLog.Changes["name"])
+		assert.True(t, result.Variants[0].IsActive)
+		assert.Equal(t, "cm", result.Variants[0].Dimensions.Unit)
+		assert.Equal(t, 10, result.Variants[1].StockQuantity)
+		assert.Len(t, movements, 2)
+		assert.Equal(t, result.Variants[1].ID, movements[1].ProductVariantID)
+		assert.Equal(t, entity.InventoryReasonRestock, movements[1].Reason)
+		assert.Equal(t, 10, movements[1].QuantityDelta)
+		assert.Equal(t, "admin-1", movements[1].ReferenceID)
+	})
+
+	t.Run("Error - Unknown category", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		req := createTestCreateProductRequest("SKU-001")
+		req.CategoryID = "category-404"
+
+		// Act
+		result, err := svc.CreateProduct(testActor, req)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
+	})
+
+	t.Run("Error - Same SKU twice in the request", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "").Return(false, nil)
+
+		// Act
+		result, err := svc.CreateProduct(testActor, createTestCreateProductRequest("SKU-001", "SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrDuplicateSKU)
+	})
+
+	t.Run("Error - SKU used by an existing variant", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "").Return(true, nil)
+
+		// Act
+		result, err := svc.CreateProduct(testActor, createTestCreateProductRequest("SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrDuplicateSKU)
+		assert.Contains(t, err.Error(), "SKU-001")
+	})
+
+	t.Run("Error - SKU taken between the check and the insert", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "").Return(false, nil)
+		mockProductRepo.EXPECT().CreateProduct(gomock.Any(), gomock.Any()).Return(repository.ErrDuplicateSKU)
+
+		// Act
+		result, err := svc.CreateProduct(testActor, createTestCreateProductRequest("SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrDuplicateSKU)
+	})
+}
+
+func TestProductService_UpdateProduct(t *testing.T) {
+	t.Run("Success - Inactive products can be edited", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		existing := &entity.Product{ID: "product-1", Name: "Old Name", IsActive: false}
+		mockProductRepo.EXPECT().FindProductByID("product-1").Return(existing, nil)
+		mockProductRepo.EXPECT().UpdateProduct(existing).Return(nil)
+		auditLog := expectAuditLog(ctrl, svc)
+
+		// Act
+		result, err := svc.UpdateProduct(testActor, "product-1", createTestCreateProductRequest().ProductRequest)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Smart Tasbih", result.Name)
+		assert.False(t, result.IsActive)
+		assert.Equal(t, "product.update", auditLog.Action)
+		assert.Equal(t, "editor@example.com", auditLog.ActorEmail)
+		assert.Equal(t, map[string]interface{}{"before": "Old Name", "after": "Smart Tasbih"}, auditLog.Changes["name"])
+		assert.NotContains(t, auditLog.Changes, "is_active")
+	})
+
+	t.Run("Error - Product not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("missing").Return(nil, nil)
+
+		// Act
+		result, err := svc.UpdateProduct(testActor, "missing", createTestCreateProductRequest().ProductRequest)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrProductNotFound)
+	})
+}
+
+func TestProductService_SetProductActive(t *testing.T) {
+	t.Run("Success - Product deactivated", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("product-1").Return(&entity.Product{ID: "product-1", IsActive: true}, nil)
+		mockProductRepo.EXPECT().SetProductActive("product-1", false, gomock.Any()).Return(true, nil)
+		auditLog := expectAuditLog(ctrl, svc)
+
+		// Act
+		err := svc.SetProductActive(testActor, "product-1", false)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "product.delete", auditLog.Action)
+		assert.Equal(t, map[string]interface{}{"before": true, "after": false}, auditLog.Changes["is_active"])
+	})
+
+	t.Run("Error - Product not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("missing").Return(nil, nil)
+
+		// Act
+		err := svc.SetProductActive(testActor, "missing", true)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrProductNotFound)
+	})
+}
+
+func TestProductService_CreateVariant(t *testing.T) {
+	t.Run("Success - Variant added to the product", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("product-1").Return(&entity.Product{ID: "product-1"}, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-003", "").Return(false, nil)
+		var movements []entity.InventoryMovement
+		mockProductRepo.EXPECT().CreateVariant(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(variant *entity.ProductVariant, m []entity.InventoryMovement) error {
+				assert.Zero(t, variant.StockQuantity)
+				movements = m
+				return nil
+			})
+		expectAuditLog(ctrl, svc)
+
+		// Act
+		result, err := svc.CreateVariant(testActor, "product-1", createTestVariantRequest("SKU-003"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "product-1", result.ProductID)
+		assert.Equal(t, 10, result.StockQuantity)
+		assert.Len(t, movements, 1)
+		assert.Equal(t, 10, movements[0].QuantityDelta)
+	})
+
+	t.Run("Success - Variant without stock has no opening movement", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("product-1").Return(&entity.Product{ID: "product-1"}, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-003", "").Return(false, nil)
+		mockProductRepo.EXPECT().CreateVariant(gomock.Any(), gomock.Nil()).Return(nil)
+		expectAuditLog(ctrl, svc)
+
+		req := createTestVariantRequest("SKU-003")
+		req.StockQuantity = 0
+
+		// Act
+		result, err := svc.CreateVariant(testActor, "product-1", req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, result.StockQuantity)
+	})
+
+	t.Run("Error - Opening stock outside a warehouse while warehouses are in use", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+		warehouseRepo := mocks.NewMockWarehouseRepository(ctrl)
+		svc.warehouseRepo = warehouseRepo
+
+		mockProductRepo.EXPECT().FindProductByID("product-1").Return(&entity.Product{ID: "product-1"}, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-003", "").Return(false, nil)
+		warehouseRepo.EXPECT().FindActiveWarehouses().Return([]entity.Warehouse{{ID: "warehouse-1", Code: "JKT"}}, nil)
+
+		// Act
+		result, err := svc.CreateVariant(testActor, "product-1", createTestVariantRequest("SKU-003"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrWarehouseRequired)
+	})
+
+	t.Run("Error - Product not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindProductByID("missing").Return(nil, nil)
+
+		// Act
+		result, err := svc.CreateVariant(testActor, "missing", createTestVariantRequest("SKU-003"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrProductNotFound)
+	})
+}
+
+func TestProductService_UpdateVariant(t *testing.T) {
+	t.Run("Success - Variant keeps its own SKU", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		existing := &entity.ProductVariant{ID: "variant-1", ProductID: "product-1", SKU: "SKU-001", StockQuantity: 3, IsActive: true}
+		mockProductRepo.EXPECT().FindVariantByID("product-1", "variant-1").Return(existing, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "variant-1").Return(false, nil)
+		mockProductRepo.EXPECT().UpdateVariant(existing).Return(nil)
+		expectAuditLog(ctrl, svc)
+
+		req := createTestVariantUpdateRequest("SKU-001")
+		req.Dimensions = nil
+
+		// Act
+		result, err := svc.UpdateVariant(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 150000.0, result.Price)
+		assert.Nil(t, result.Dimensions)
+		assert.Equal(t, 3, result.StockQuantity, "stock only changes through the inventory ledger")
+	})
+
+	t.Run("Error - New SKU belongs to another variant", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		existing := &entity.ProductVariant{ID: "variant-1", ProductID: "product-1", SKU: "SKU-001"}
+		mockProductRepo.EXPECT().FindVariantByID("product-1", "variant-1").Return(existing, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-002", "variant-1").Return(true, nil)
+
+		// Act
+		result, err := svc.UpdateVariant(testActor, "product-1", "variant-1", createTestVariantUpdateRequest("SKU-002"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrDuplicateSKU)
+	})
+
+	t.Run("Error - Stock quantity is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		// Act
+		result, err := svc.UpdateVariant(testActor, "product-1", "variant-1", createTestVariantRequest("SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrStockNotEditable)
+	})
+
+	t.Run("Error - Variant of another product", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindVariantByID("product-2", "variant-1").Return(nil, nil)
+
+		// Act
+		result, err := svc.UpdateVariant(testActor, "product-2", "variant-1", createTestVariantUpdateRequest("SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrVariantNotFound)
+	})
+
+	t.Run("Error - Repository failure", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		existing := &entity.ProductVariant{ID: "variant-1", ProductID: "product-1", SKU: "SKU-001"}
+		mockProductRepo.EXPECT().FindVariantByID("product-1", "variant-1").Return(existing, nil)
+		mockProductRepo.EXPECT().SKUExists("SKU-001", "variant-1").Return(false, nil)
+		mockProductRepo.EXPECT().UpdateVariant(existing).Return(errors.New("database error"))
+
+		// Act
+		result, err := svc.UpdateVariant(testActor, "product-1", "variant-1", createTestVariantUpdateRequest("SKU-001"))
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, service.ErrDuplicateSKU)
+	})
+}
+
+func TestProductService_SetVariantActive(t *testing.T) {
+	t.Run("Error - Variant not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockProductRepo := mocks.NewMockProductRepository(ctrl)
+		svc := createTestAdminProductService(ctrl, mockProductRepo)
+
+		mockProductRepo.EXPECT().FindVariantByID("product-1", "missing").Return(nil, nil)
+
+		// Act
+		err := svc.SetVariantActive(testActor, "product-1", "missing", false)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrVariantNotFound)
+	})
+}
@@ -4,14 +4,25 @@ import (
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/repository"
 	"github.com/hanifbg/landing_backend/internal/repository/util"
+	"github.com/hanifbg/landing_backend/internal/service/stockalert"
 )
 
 type ProductService struct {
-	productRepo repository.ProductRepository
+	productRepo   repository.ProductRepository
+	categoryRepo  repository.CategoryRepository
+	auditLogRepo  repository.AuditLogRepository
+	inventoryRepo repository.InventoryRepository
+	warehouseRepo repository.WarehouseRepository
+	lowStock      *stockalert.Alerter
 }
 
 func New(cfg *config.AppConfig, repo *util.RepoWrapper) *ProductService {
 	return &ProductService{
-		productRepo: repo.ProductRepo,
+		productRepo:   repo.ProductRepo,
+		categoryRepo:  repo.CategoryRepo,
+		auditLogRepo:  repo.AuditLogRepo,
+		inventoryRepo: repo.InventoryRepo,
+		warehouseRepo: repo.WarehouseRepo,
+		lowStock:      stockalert.New(cfg, repo),
 	}
 }
@@ -0,0 +1,165 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+)
+
+const (
+	defaultMovementPageSize = 20
+	maxMovementPageSize     = 100
+)
+
+func (p *ProductService) GetInventoryHistory(productID, variantID string, page, limit int) (*response.InventoryHistoryResponse, error) {
+	variant, err := p.findVariant(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultMovementPageSize
+	}
+	if limit > maxMovementPageSize {
+		limit = maxMovementPageSize
+	}
+
+	movements, total, err := p.inventoryRepo.FindInventoryMovements(variant.ID, (page-1)*limit, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory movements: %v", err)
+	}
+	if movements == nil {
+		movements = []entity.InventoryMovement{}
+	}
+
+	ledger, err := p.inventoryRepo.SumInventoryMovements(variant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum inventory movements: %v", err)
+	}
+
+	return &response.InventoryHistoryResponse{
+		VariantID:      variant.ID,
+		SKU:            variant.SKU,
+		StockQuantity:  variant.StockQuantity,
+		LedgerQuantity: ledger,
+		Movements:      movements,
+		Page:           page,
+		Limit:          limit,
+		Total:          total,
+		TotalPages:     int((total + int64(limit) - 1) / int64(limit)),
+	}, nil
+}
+
+func (p *ProductService) AdjustInventory(actor service.AdminActor, productID, variantID string, req request.InventoryAdjustmentRequest) (*response.InventoryAdjustmentResponse, error) {
+	if req.Reason != entity.InventoryReasonAdjustment && req.QuantityDelta < 0 {
+		return nil, service.ErrInvalidInventoryAdjustment
+	}
+
+	variant, err := p.findVariant(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkStockWarehouse(req.WarehouseID); err != nil {
+		return nil, err
+	}
+
+	movement := &entity.InventoryMovement{
+		ProductVariantID: variant.ID,
+		Reason:           req.Reason,
+		QuantityDelta:    req.QuantityDelta,
+		ReferenceType:    entity.InventoryReferenceAdminUser,
+		ReferenceID:      actor.ID,
+		Note:             strings.TrimSpace(req.Note),
+		CreatedAt:        time.Now(),
+	}
+	if req.WarehouseID != "" {
+		movement.WarehouseID = &req.WarehouseID
+	}
+
+	stock, err := p.inventoryRepo.AdjustStock(movement)
+	if err != nil {
+		if errors.Is(err, repository.ErrNegativeStock) {
+			return nil, service.ErrNegativeStock
+		}
+		return nil, fmt.Errorf("failed to adjust stock: %v", err)
+	}
+	p.lowStock.Check([]string{variant.ID})
+
+	audit.Record(p.auditLogRepo, actor, "variant.adjust_stock", entity.AuditTargetVariant, variant.ID,
+		map[string]int{"stock_quantity": stock - movement.QuantityDelta}, map[string]int{"stock_quantity": stock})
+
+	return &response.InventoryAdjustmentResponse{
+		Movement:      *movement,
+		StockQuantity: stock,
+	}, nil
+}
+
+func (p *ProductService) RebuildStock(actor service.AdminActor, productID, variantID string) (*response.StockRebuildResponse, error) {
+	variant, err := p.findVariant(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	rebuild, err := p.inventoryRepo.RebuildStock(variant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild stock: %v", err)
+	}
+	p.lowStock.Check([]string{variant.ID})
+
+	result := &response.StockRebuildResponse{
+		VariantID:   variant.ID,
+		StockBefore: rebuild.Before,
+		StockAfter:  rebuild.After,
+		Warehouses:  []response.WarehouseStockRebuildResponse{},
+	}
+	before := map[string]int{"stock_quantity": rebuild.Before}
+	after := map[string]int{"stock_quantity": rebuild.After}
+	for _, warehouse := range rebuild.Warehouses {
+		result.Warehouses = append(result.Warehouses, response.WarehouseStockRebuildResponse{
+			WarehouseID: warehouse.WarehouseID,
+			StockBefore: warehouse.Before,
+			StockAfter:  warehouse.After,
+		})
+		before["warehouse:"+warehouse.WarehouseID] = warehouse.Before
+		after["warehouse:"+warehouse.WarehouseID] = warehouse.After
+	}
+
+	audit.Record(p.auditLogRepo, actor, "variant.rebuild_stock", entity.AuditTargetVariant, variant.ID, before, after)
+
+	return result, nil
+}
+
+// checkStockWarehouse checks the warehouse a stock change names. Once a warehouse is active
+// every unit must be placed in one, since orders only ship from warehouse stock.
+func (p *ProductService) checkStockWarehouse(warehouseID string) error {
+	if warehouseID == "" {
+		warehouses, err := p.warehouseRepo.FindActiveWarehouses()
+		if err != nil {
+			return fmt.Errorf("failed to get warehouses: %v", err)
+		}
+		if len(warehouses) > 0 {
+			return service.ErrWarehouseRequired
+		}
+		return nil
+	}
+
+	warehouse, err := p.warehouseRepo.FindWarehouseByID(warehouseID)
+	if err != nil {
+		return fmt.Errorf("failed to get warehouse: %v", err)
+	}
+	if warehouse == nil {
+		return service.ErrWarehouseNotFound
+	}
+	return nil
+}
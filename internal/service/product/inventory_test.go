@@ -0,0 +1,264 @@
+package product
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/product/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const testWarehouseID = "6f1c2a9e-3b4d-4c5e-8f70-112233445566"
+
+// Helper function to create a product service with an inventory repository and a
+// variant-1 of product-1 holding 7 units
+func createTestInventoryService(ctrl *gomock.Controller) (*ProductService, *mocks.MockProductRepository, *mocks.MockInventoryRepository) {
+	productRepo := mocks.NewMockProductRepository(ctrl)
+	productRepo.EXPECT().FindVariantByID("product-1", "variant-1").
+		Return(&entity.ProductVariant{ID: "variant-1", ProductID: "product-1", SKU: "SKU-001", StockQuantity: 7}, nil).AnyTimes()
+	productRepo.EXPECT().FindVariantByID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+	svc := createTestAdminProductService(ctrl, productRepo)
+	svc.inventoryRepo = inventoryRepo
+	return svc, productRepo, inventoryRepo
+}
+
+func TestProductService_GetInventoryHistory(t *testing.T) {
+	t.Run("Success - Page of movements with the ledger sum", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+
+		movements := []entity.InventoryMovement{
+			{ProductVariantID: "variant-1", Reason: entity.InventoryReasonSale, QuantityDelta: -3},
+			{ProductVariantID: "variant-1", Reason: entity.InventoryReasonRestock, QuantityDelta: 10},
+		}
+		inventoryRepo.EXPECT().FindInventoryMovements("variant-1", 20, 20).Return(movements, int64(22), nil)
+		inventoryRepo.EXPECT().SumInventoryMovements("variant-1").Return(7, nil)
+
+		// Act
+		result, err := svc.GetInventoryHistory("product-1", "variant-1", 2, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "SKU-001", result.SKU)
+		assert.Equal(t, 7, result.StockQuantity)
+		assert.Equal(t, 7, result.LedgerQuantity)
+		assert.Len(t, result.Movements, 2)
+		assert.Equal(t, 20, result.Limit)
+		assert.Equal(t, 2, result.TotalPages)
+	})
+
+	t.Run("Error - Variant not found", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestInventoryService(ctrl)
+
+		// Act
+		result, err := svc.GetInventoryHistory("product-1", "missing", 1, 20)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrVariantNotFound)
+	})
+}
+
+func TestProductService_AdjustInventory(t *testing.T) {
+	t.Run("Success - Restock recorded against the admin", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+
+		var recorded entity.InventoryMovement
+		inventoryRepo.EXPECT().AdjustStock(gomock.Any()).
+			DoAndReturn(func(movement *entity.InventoryMovement) (int, error) {
+				recorded = *movement
+				return 12, nil
+			})
+		auditLog := expectAuditLog(ctrl, svc)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonRestock, QuantityDelta: 5, Note: " Supplier delivery "}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 12, result.StockQuantity)
+		assert.Equal(t, "variant-1", recorded.ProductVariantID)
+		assert.Equal(t, entity.InventoryReferenceAdminUser, recorded.ReferenceType)
+		assert.Equal(t, "admin-1", recorded.ReferenceID)
+		assert.Equal(t, "Supplier delivery", recorded.Note)
+		assert.Equal(t, "variant.adjust_stock", auditLog.Action)
+		assert.Equal(t, map[string]interface{}{"before": 7.0, "after": 12.0}, auditLog.Changes["stock_quantity"])
+	})
+
+	t.Run("Success - Restock placed in a warehouse", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+		warehouseRepo := mocks.NewMockWarehouseRepository(ctrl)
+		svc.warehouseRepo = warehouseRepo
+
+		var recorded entity.InventoryMovement
+		warehouseRepo.EXPECT().FindWarehouseByID(testWarehouseID).Return(&entity.Warehouse{ID: testWarehouseID, Code: "JKT"}, nil)
+		inventoryRepo.EXPECT().AdjustStock(gomock.Any()).
+			DoAndReturn(func(movement *entity.InventoryMovement) (int, error) {
+				recorded = *movement
+				return 12, nil
+			})
+		expectAuditLog(ctrl, svc)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonRestock, QuantityDelta: 5, WarehouseID: testWarehouseID}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 12, result.StockQuantity)
+		assert.Equal(t, testWarehouseID, *recorded.WarehouseID)
+	})
+
+	t.Run("Error - Restock outside a warehouse while warehouses are in use", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestInventoryService(ctrl)
+		warehouseRepo := mocks.NewMockWarehouseRepository(ctrl)
+		svc.warehouseRepo = warehouseRepo
+
+		warehouseRepo.EXPECT().FindActiveWarehouses().Return([]entity.Warehouse{{ID: testWarehouseID, Code: "JKT"}}, nil)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonRestock, QuantityDelta: 5}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrWarehouseRequired)
+	})
+
+	t.Run("Error - Unknown warehouse", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestInventoryService(ctrl)
+		warehouseRepo := mocks.NewMockWarehouseRepository(ctrl)
+		svc.warehouseRepo = warehouseRepo
+
+		warehouseRepo.EXPECT().FindWarehouseByID(testWarehouseID).Return(nil, nil)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonRestock, QuantityDelta: 5, WarehouseID: testWarehouseID}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrWarehouseNotFound)
+	})
+
+	t.Run("Error - Restock that takes stock away", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestInventoryService(ctrl)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonRestock, QuantityDelta: -5}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrInvalidInventoryAdjustment)
+	})
+
+	t.Run("Error - Adjustment below zero", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+
+		inventoryRepo.EXPECT().AdjustStock(gomock.Any()).Return(0, repository.ErrNegativeStock)
+
+		req := request.InventoryAdjustmentRequest{Reason: entity.InventoryReasonAdjustment, QuantityDelta: -8}
+
+		// Act
+		result, err := svc.AdjustInventory(testActor, "product-1", "variant-1", req)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrNegativeStock)
+	})
+}
+
+func TestProductService_RebuildStock(t *testing.T) {
+	t.Run("Success - Stock set to the ledger sum", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+
+		inventoryRepo.EXPECT().RebuildStock("variant-1").Return(&repository.StockRebuild{
+			Before: 7,
+			After:  5,
+			Warehouses: []repository.WarehouseStockRebuild{
+				{WarehouseID: testWarehouseID, Before: 4, After: 3},
+			},
+		}, nil)
+		auditLog := expectAuditLog(ctrl, svc)
+
+		// Act
+		result, err := svc.RebuildStock(testActor, "product-1", "variant-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result.StockBefore)
+		assert.Equal(t, 5, result.StockAfter)
+		assert.Equal(t, []response.WarehouseStockRebuildResponse{
+			{WarehouseID: testWarehouseID, StockBefore: 4, StockAfter: 3},
+		}, result.Warehouses)
+		assert.Equal(t, "variant.rebuild_stock", auditLog.Action)
+		assert.Equal(t, map[string]interface{}{"before": 4.0, "after": 3.0}, auditLog.Changes["warehouse:"+testWarehouseID])
+	})
+
+	t.Run("Error - Repository failure", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, inventoryRepo := createTestInventoryService(ctrl)
+
+		inventoryRepo.EXPECT().RebuildStock("variant-1").Return(nil, errors.New("database error"))
+
+		// Act
+		result, err := svc.RebuildStock(testActor, "product-1", "variant-1")
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: inventory.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+	repository "github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// MockInventoryRepository is a mock of InventoryRepository interface.
+type MockInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryRepositoryMockRecorder
+}
+
+// MockInventoryRepositoryMockRecorder is the mock recorder for MockInventoryRepository.
+type MockInventoryRepositoryMockRecorder struct {
+	mock *MockInventoryRepository
+}
+
+// NewMockInventoryRepository creates a new mock instance.
+func NewMockInventoryRepository(ctrl *gomock.Controller) *MockInventoryRepository {
+	mock := &MockInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryRepository) EXPECT() *MockInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdjustStock mocks base method.
+func (m *MockInventoryRepository) AdjustStock(movement *entity.InventoryMovement) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStock", movement)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStock indicates an expected call of AdjustStock.
+func (mr *MockInventoryRepositoryMockRecorder) AdjustStock(movement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStock", reflect.TypeOf((*MockInventoryRepository)(nil).AdjustStock), movement)
+}
+
+// ClaimLowStockAlerts mocks base method.
+func (m *MockInventoryRepository) ClaimLowStockAlerts(variantIDs []string, at time.Time) ([]repository.LowStockVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLowStockAlerts", variantIDs, at)
+	ret0, _ := ret[0].([]repository.LowStockVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLowStockAlerts indicates an expected call of ClaimLowStockAlerts.
+func (mr *MockInventoryRepositoryMockRecorder) ClaimLowStockAlerts(variantIDs, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLowStockAlerts", reflect.TypeOf((*MockInventoryRepository)(nil).ClaimLowStockAlerts), variantIDs, at)
+}
+
+// FindInventoryMovements mocks base method.
+func (m *MockInventoryRepository) FindInventoryMovements(variantID string, offset, limit int) ([]entity.InventoryMovement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInventoryMovements", variantID, offset, limit)
+	ret0, _ := ret[0].([]entity.InventoryMovement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindInventoryMovements indicates an expected call of FindInventoryMovements.
+func (mr *MockInventoryRepositoryMockRecorder) FindInventoryMovements(variantID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInventoryMovements", reflect.TypeOf((*MockInventoryRepository)(nil).FindInventoryMovements), variantID, offset, limit)
+}
+
+// RebuildStock mocks base method.
+func (m *MockInventoryRepository) RebuildStock(variantID string) (*repository.StockRebuild, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildStock", variantID)
+	ret0, _ := ret[0].(*repository.StockRebuild)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildStock indicates an expected call of RebuildStock.
+func (mr *MockInventoryRepositoryMockRecorder) RebuildStock(variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildStock", reflect.TypeOf((*MockInventoryRepository)(nil).RebuildStock), variantID)
+}
+
+// ReleaseLowStockAlerts mocks base method.
+func (m *MockInventoryRepository) ReleaseLowStockAlerts(variantIDs []string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLowStockAlerts", variantIDs, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLowStockAlerts indicates an expected call of ReleaseLowStockAlerts.
+func (mr *MockInventoryRepositoryMockRecorder) ReleaseLowStockAlerts(variantIDs, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLowStockAlerts", reflect.TypeOf((*MockInventoryRepository)(nil).ReleaseLowStockAlerts), variantIDs, at)
+}
+
+// SumInventoryMovements mocks base method.
+func (m *MockInventoryRepository) SumInventoryMovements(variantID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumInventoryMovements", variantID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumInventoryMovements indicates an expected call of SumInventoryMovements.
+func (mr *MockInventoryRepositoryMockRecorder) SumInventoryMovements(variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumInventoryMovements", reflect.TypeOf((*MockInventoryRepository)(nil).SumInventoryMovements), variantID)
+}
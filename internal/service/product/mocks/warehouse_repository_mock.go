@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: warehouse.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockWarehouseRepository is a mock of WarehouseRepository interface.
+type MockWarehouseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarehouseRepositoryMockRecorder
+}
+
+// MockWarehouseRepositoryMockRecorder is the mock recorder for MockWarehouseRepository.
+type MockWarehouseRepositoryMockRecorder struct {
+	mock *MockWarehouseRepository
+}
+
+// NewMockWarehouseRepository creates a new mock instance.
+func NewMockWarehouseRepository(ctrl *gomock.Controller) *MockWarehouseRepository {
+	mock := &MockWarehouseRepository{ctrl: ctrl}
+	mock.recorder = &MockWarehouseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarehouseRepository) EXPECT() *MockWarehouseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindActiveWarehouses mocks base method.
+func (m *MockWarehouseRepository) FindActiveWarehouses() ([]entity.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveWarehouses")
+	ret0, _ := ret[0].([]entity.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveWarehouses indicates an expected call of FindActiveWarehouses.
+func (mr *MockWarehouseRepositoryMockRecorder) FindActiveWarehouses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveWarehouses", reflect.TypeOf((*MockWarehouseRepository)(nil).FindActiveWarehouses))
+}
+
+// FindWarehouseByID mocks base method.
+func (m *MockWarehouseRepository) FindWarehouseByID(id string) (*entity.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWarehouseByID", id)
+	ret0, _ := ret[0].(*entity.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWarehouseByID indicates an expected call of FindWarehouseByID.
+func (mr *MockWarehouseRepositoryMockRecorder) FindWarehouseByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWarehouseByID", reflect.TypeOf((*MockWarehouseRepository)(nil).FindWarehouseByID), id)
+}
+
+// FindWarehouseStocks mocks base method.
+func (m *MockWarehouseRepository) FindWarehouseStocks(variantIDs []string) ([]entity.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWarehouseStocks", variantIDs)
+	ret0, _ := ret[0].([]entity.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWarehouseStocks indicates an expected call of FindWarehouseStocks.
+func (mr *MockWarehouseRepositoryMockRecorder) FindWarehouseStocks(variantIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWarehouseStocks", reflect.TypeOf((*MockWarehouseRepository)(nil).FindWarehouseStocks), variantIDs)
+}
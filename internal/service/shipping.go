@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"errors"
+
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
 )
@@ -11,4 +14,40 @@ type ShippingService interface {
 	GetDistricts(req request.GetDistrictsRequest) ([]response.DistrictResponse, error)
 	CalculateShippingCost(req request.CalculateShippingRequest) ([]response.ShippingCostResponse, error)
 	ValidateAndSaveAWB(req request.ValidateAWBRequest) (*response.ValidateAWBResponse, error)
+	// RefreshAWBTrackings re-reads the tracking data of shipments that are due for a check
+	// and moves orders whose shipment was delivered to delivered. It returns how many
+	// shipments were refreshed.
+	RefreshAWBTrackings(ctx context.Context) (int, error)
+	// SyncLocations re-reads stored provinces, cities and districts that are due for a
+	// refresh from the provider. It returns how many lists were refreshed.
+	SyncLocations(ctx context.Context) (int, error)
+	// WarmUpLocations stores the provinces and cities that were never stored. It returns
+	// how many lists were stored.
+	WarmUpLocations(ctx context.Context) (int, error)
+	// TrackShipment returns the shipments of an order when the email or the phone number
+	// suffix matches the one given at checkout, otherwise ErrOrderNotFound. Tracking older
+	// than the configured age is re-read from the courier first.
+	TrackShipment(req request.TrackShipmentRequest) (*response.ShipmentTrackingResponse, error)
+	// HandleTrackingWebhook applies a tracking update pushed by a provider. The call must
+	// be signed with the provider's secret and is applied at most once.
+	HandleTrackingWebhook(req request.ShippingWebhookRequest) error
 }
+
+var (
+	// ErrUnknownWebhookProvider is returned for a provider that has no webhook secret configured
+	ErrUnknownWebhookProvider = errors.New("unknown webhook provider")
+	// ErrInvalidWebhookSignature is returned when a webhook call is unsigned or its signature does not match
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	// ErrWebhookReplayed is returned when a webhook call was already applied or its timestamp is too old
+	ErrWebhookReplayed = errors.New("webhook call replayed")
+	// ErrInvalidWebhookPayload is returned when the provider payload holds no usable tracking update
+	ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+	// ErrAWBTrackingNotFound is returned when no saved AWB matches the update's AWB number and courier
+	ErrAWBTrackingNotFound = errors.New("AWB tracking not found")
+	// ErrShippingProvinceMismatch is returned when the province sent is not the one the destination district is in
+	ErrShippingProvinceMismatch = errors.New("shipping province does not match the destination district")
+	// ErrShippingDistrictUnknown is returned when the destination district was never listed, so its province is not known
+	ErrShippingDistrictUnknown = errors.New("shipping destination district is unknown")
+	// ErrOrderNotShippable is returned when an AWB is given for an order that is not processing or shipped
+	ErrOrderNotShippable = errors.New("order cannot be shipped in its current status")
+)
@@ -1,22 +1,30 @@
 package shipping
 
 import (
-	"encoding/json"
 	"fmt"
-	"strconv"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/request"
 	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
 )
 
+// locations returns where provinces, cities and districts are listed from
+func (s *ShippingService) locations() LocationSource {
+	if s.Locations != nil {
+		return s.Locations
+	}
+	return s.ShippingRepo
+}
+
 func (s *ShippingService) GetProvinces(req request.GetProvincesRequest) ([]response.ProvinceResponse, error) {
 	// Validate input if necessary
 
 	// Call repository
-	provinces, err := s.ShippingRepo.GetProvinces(req.ID)
+	provinces, err := s.locations().GetProvinces(req.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get provinces: %w", err)
 	}
@@ -25,8 +33,8 @@ func (s *ShippingService) GetProvinces(req request.GetProvincesRequest) ([]respo
 	var result []response.ProvinceResponse
 	for _, province := range provinces {
 		result = append(result, response.ProvinceResponse{
-			ProvinceID: strconv.Itoa(province.ProvinceID),
-			Province:   province.Province,
+			ProvinceID: province.ID,
+			Province:   province.Name,
 		})
 	}
 
@@ -37,7 +45,7 @@ func (s *ShippingService) GetCities(req request.GetCitiesRequest) ([]response.Ci
 	// Validate input if necessary
 
 	// Call repository
-	cities, err := s.ShippingRepo.GetCities(req.ProvinceID, req.ID)
+	cities, err := s.locations().GetCities(req.ProvinceID, req.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cities: %w", err)
 	}
@@ -46,9 +54,9 @@ func (s *ShippingService) GetCities(req request.GetCitiesRequest) ([]response.Ci
 	var result []response.CityResponse
 	for _, city := range cities {
 		result = append(result, response.CityResponse{
-			CityID:     strconv.Itoa(city.CityID),
-			ProvinceID: strconv.Itoa(city.ProvinceID),
-			CityName:   city.CityName,
+			CityID:     city.ID,
+			ProvinceID: city.ProvinceID,
+			CityName:   city.Name,
 		})
 	}
 
@@ -62,7 +70,7 @@ func (s *ShippingService) GetDistricts(req request.GetDistrictsRequest) ([]respo
 	}
 
 	// Call repository
-	districts, err := s.ShippingRepo.GetDistricts(req.CityID)
+	districts, err := s.locations().GetDistricts(req.CityID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +78,9 @@ func (s *ShippingService) GetDistricts(req request.GetDistrictsRequest) ([]respo
 	var result []response.DistrictResponse
 	for _, district := range districts {
 		result = append(result, response.DistrictResponse{
-			DistrictID:   strconv.Itoa(district.DistrictID),
+			DistrictID:   district.ID,
 			CityID:       req.CityID, // Use the city ID from the request parameter
-			DistrictName: district.DistrictName,
+			DistrictName: district.Name,
 		})
 	}
 
@@ -80,37 +88,113 @@ func (s *ShippingService) GetDistricts(req request.GetDistrictsRequest) ([]respo
 }
 
 func (s *ShippingService) CalculateShippingCost(req request.CalculateShippingRequest) ([]response.ShippingCostResponse, error) {
-	// Validate input
-	if req.Origin == "" || req.Destination == "" || req.Weight <= 0 || req.Courier == "" {
+	// Validate input; with a cart the origin and weight come from the cart
+	if req.Destination == "" || req.Courier == "" || (req.CartID == "" && (req.Origin == "" || req.Weight <= 0)) {
 		return nil, fmt.Errorf("origin, destination, weight, and courier are required")
 	}
 
-	// Call repository
-	costs, err := s.ShippingRepo.CalculateShippingCost(req.Origin, req.Destination, req.Weight, req.Courier)
+	// Province rules and warehouse choice use the destination's own province
+	provinceID, err := s.Provinces.Resolve(req.Destination, req.ProvinceID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate shipping cost: %w", err)
+		return nil, err
+	}
+	req.ProvinceID = provinceID
+
+	parcel := entity.ShippingParcel{ProvinceID: req.ProvinceID}
+	var quotes []entity.ShippingQuote
+	if req.CartID != "" {
+		cart, err := s.CartRepo.GetCartWithItems(req.CartID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cart: %w", err)
+		}
+		parcel = entity.NewShippingParcel(cart, req.ProvinceID)
+
+		quotes, err = s.quoteCart(cart, req)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Quotes of every provider come back merged, cheapest first
+		quotes, err = s.RateProvider.CalculateShippingCost(req.Origin, req.Destination, req.Weight, req.Courier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate shipping cost: %w", err)
+		}
+	}
+
+	quotes, err = s.Rules.Apply(parcel, quotes)
+	if err != nil {
+		return nil, err
 	}
 
 	var result []response.ShippingCostResponse
-	for _, cost := range costs {
-		result = append(result, response.ShippingCostResponse{
-			Service:     cost.Service,
-			Description: cost.Description,
-			Cost:        float64(cost.Cost),
-			ETD:         cost.ETD,
-		})
+	for _, cost := range quotes {
+		costResponse := response.ShippingCostResponse{
+			Courier:      cost.Courier,
+			CourierName:  cost.CourierName,
+			Service:      cost.Service,
+			Description:  cost.Description,
+			Cost:         float64(cost.Cost),
+			OriginalCost: float64(cost.OriginalCost),
+			ETD:          cost.ETD,
+		}
+		for _, shipment := range cost.Shipments {
+			costResponse.Shipments = append(costResponse.Shipments, response.ShipmentCostResponse{
+				WarehouseID:   shipment.WarehouseID,
+				WarehouseName: shipment.WarehouseName,
+				Origin:        shipment.Origin,
+				Cost:          float64(shipment.Cost),
+			})
+		}
+		if cost.AppliedRule != nil {
+			costResponse.AppliedRule = &response.AppliedShippingRule{
+				ID:   cost.AppliedRule.ID,
+				Name: cost.AppliedRule.Name,
+				Type: cost.AppliedRule.Type,
+			}
+		}
+		result = append(result, costResponse)
 	}
 
 	return result, nil
 }
 
-// ValidateAndSaveAWB validates AWB number with RajaOngkir and saves it to database
+// quoteCart quotes the cart from the warehouses that hold it. Without warehouses it is
+// quoted from the requested origin, or the configured one, by its own weight.
+func (s *ShippingService) quoteCart(cart *entity.Cart, req request.CalculateShippingRequest) ([]entity.ShippingQuote, error) {
+	shipments, err := s.Origins.Plan(cart, req.ProvinceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shipments) > 0 {
+		return QuoteShipments(s.RateProvider, shipments, req.Destination, req.Courier)
+	}
+
+	origin := req.Origin
+	if origin == "" {
+		origin = s.OriginID
+	}
+	if origin == "" {
+		return nil, fmt.Errorf("origin is required when there are no warehouses")
+	}
+
+	quotes, err := s.RateProvider.CalculateShippingCost(origin, req.Destination, WeightGrams(cart.CartItems), req.Courier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate shipping cost: %w", err)
+	}
+	return quotes, nil
+}
+
+// ValidateAndSaveAWB validates AWB number with RajaOngkir and saves it to database.
+// Only processing and shipped orders take an AWB; a processing order is moved to shipped.
 func (s *ShippingService) ValidateAndSaveAWB(req request.ValidateAWBRequest) (*response.ValidateAWBResponse, error) {
-	// Step 1: Validate that the invoice number exists
+	// Step 1: Validate that the invoice number exists and is ready to ship
 	order, err := s.AWBTrackingRepo.GetOrderByInvoiceNumber(req.InvoiceNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate invoice number: %w", err)
 	}
+	if order.OrderStatus != entity.OrderStatusProcessing && order.OrderStatus != entity.OrderStatusShipped {
+		return nil, fmt.Errorf("%w: order %s is %s", service.ErrOrderNotShippable, req.InvoiceNumber, order.OrderStatus)
+	}
 
 	// Step 2: Convert order ID to UUID
 	orderID, err := uuid.Parse(order.ID)
@@ -139,18 +223,7 @@ func (s *ShippingService) ValidateAndSaveAWB(req request.ValidateAWBRequest) (*r
 		}, nil // Return successful response with validation failure
 	}
 
-	// Step 5: Extract tracking data from API response
-	var parsedTrackingData *entity.TrackingData
-	if trackingData != nil && trackingData.Data != nil {
-		// Parse the tracking data - this depends on the actual structure from RajaOngkir
-		trackingBytes, _ := json.Marshal(trackingData.Data)
-		var trackingInfo entity.TrackingData
-		if json.Unmarshal(trackingBytes, &trackingInfo) == nil {
-			parsedTrackingData = &trackingInfo
-		}
-	}
-
-	// Step 6: Create AWB tracking record
+	// Step 5: Create AWB tracking record
 	awbTracking := &entity.AWBTracking{
 		ID:              uuid.New(),
 		OrderID:         orderID,
@@ -158,16 +231,28 @@ func (s *ShippingService) ValidateAndSaveAWB(req request.ValidateAWBRequest) (*r
 		Courier:         req.Courier,
 		LastPhoneNumber: req.LastPhoneNumber,
 		IsValidated:     true,
-		TrackingData:    parsedTrackingData,
+		TrackingData:    trackingData,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
 
-	// Step 7: Save to database
+	// Step 6: Save to database
 	if err := s.AWBTrackingRepo.CreateAWBTracking(awbTracking); err != nil {
 		return nil, fmt.Errorf("failed to save AWB tracking: %w", err)
 	}
 
+	// Step 7: Move the order to shipped and tell the customer it is on its way. The AWB is
+	// saved either way; an order left processing moves on once the shipment is delivered.
+	if order.OrderStatus == entity.OrderStatusProcessing {
+		note := fmt.Sprintf("Shipped with AWB %s (%s)", req.AWBNumber, req.Courier)
+		if err := s.changeOrderStatus(order, entity.OrderStatusShipped, note); err != nil {
+			log.Printf("failed to move order %s to shipped: %v", order.OrderNumber, err)
+		}
+	}
+	if order.OrderStatus == entity.OrderStatusShipped {
+		s.notifyShipmentDispatched(order, awbTracking)
+	}
+
 	// Step 8: Return success response
 	return &response.ValidateAWBResponse{
 		ID:            awbTracking.ID.String(),
@@ -5,80 +5,86 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
 	"github.com/hanifbg/landing_backend/internal/model/request"
-	"github.com/hanifbg/landing_backend/internal/model/response"
 	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
 	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Helper function to create a test shipping service
 func createTestShippingService(mockRepo repository.ShippingRepository) *ShippingService {
 	return &ShippingService{
 		ShippingRepo: mockRepo,
+		RateProvider: mockRepo,
 	}
 }
 
 // Helper function to create test province data
-func createTestProvinces() []response.RajaOngkirProvince {
-	return []response.RajaOngkirProvince{
+func createTestProvinces() []entity.ShippingProvince {
+	return []entity.ShippingProvince{
 		{
-			ProvinceID: 1,
-			Province:   "Bali",
+			ID:   "1",
+			Name: "Bali",
 		},
 		{
-			ProvinceID: 2,
-			Province:   "Bangka Belitung",
+			ID:   "2",
+			Name: "Bangka Belitung",
 		},
 	}
 }
 
 // Helper function to create test city data
-func createTestCities() []response.RajaOngkirCity {
-	return []response.RajaOngkirCity{
+func createTestCities() []entity.ShippingCity {
+	return []entity.ShippingCity{
 		{
-			CityID:     1,
-			ProvinceID: 1,
-			CityName:   "Badung",
+			ID:         "1",
+			ProvinceID: "1",
+			Name:       "Badung",
 		},
 		{
-			CityID:     2,
-			ProvinceID: 1,
-			CityName:   "Denpasar",
+			ID:         "2",
+			ProvinceID: "1",
+			Name:       "Denpasar",
 		},
 	}
 }
 
 // Helper function to create test district data
-func createTestDistricts() []response.RajaOngkirDistrict {
-	return []response.RajaOngkirDistrict{
+func createTestDistricts() []entity.ShippingDistrict {
+	return []entity.ShippingDistrict{
 		{
-			DistrictID:   1,
-			CityID:       575,
-			DistrictName: "Cengkareng",
+			ID:     "1",
+			CityID: "575",
+			Name:   "Cengkareng",
 		},
 		{
-			DistrictID:   2,
-			CityID:       575,
-			DistrictName: "Grogol Petamburan",
+			ID:     "2",
+			CityID: "575",
+			Name:   "Grogol Petamburan",
 		},
 	}
 }
 
 // Helper function to create test shipping cost data
-func createTestShippingCosts() []response.RajaOngkirCost {
-	return []response.RajaOngkirCost{
+func createTestShippingCosts() []entity.ShippingQuote {
+	return []entity.ShippingQuote{
 		{
-			Code:        "jne",
-			Name:        "Jalur Nugraha Ekakurir (JNE)",
+			Provider:    "rajaongkir",
+			Courier:     "jne",
+			CourierName: "Jalur Nugraha Ekakurir (JNE)",
 			Service:     "REG",
 			Description: "Layanan Reguler",
 			Cost:        15000,
 			ETD:         "1-2",
 		},
 		{
-			Code:        "jne",
-			Name:        "Jalur Nugraha Ekakurir (JNE)",
+			Provider:    "rajaongkir",
+			Courier:     "jne",
+			CourierName: "Jalur Nugraha Ekakurir (JNE)",
 			Service:     "OKE",
 			Description: "Ongkos Kirim Ekonomis",
 			Cost:        12000,
@@ -119,10 +125,10 @@ func TestShippingService_GetProvinces(t *testing.T) {
 		service := createTestShippingService(mockShippingRepo)
 
 		req := request.GetProvincesRequest{ID: "1"}
-		expectedProvinces := []response.RajaOngkirProvince{
+		expectedProvinces := []entity.ShippingProvince{
 			{
-				ProvinceID: 1,
-				Province:   "Bali",
+				ID:   "1",
+				Name: "Bali",
 			},
 		}
 
@@ -144,7 +150,7 @@ func TestShippingService_GetProvinces(t *testing.T) {
 		service := createTestShippingService(mockShippingRepo)
 
 		req := request.GetProvincesRequest{}
-		emptyProvinces := []response.RajaOngkirProvince{}
+		emptyProvinces := []entity.ShippingProvince{}
 
 		mockShippingRepo.EXPECT().GetProvinces("").Return(emptyProvinces, nil)
 
@@ -226,11 +232,11 @@ func TestShippingService_GetCities(t *testing.T) {
 		service := createTestShippingService(mockShippingRepo)
 
 		req := request.GetCitiesRequest{ProvinceID: "1", ID: "1"}
-		expectedCities := []response.RajaOngkirCity{
+		expectedCities := []entity.ShippingCity{
 			{
-				CityID:     1,
-				ProvinceID: 1,
-				CityName:   "Badung",
+				ID:         "1",
+				ProvinceID: "1",
+				Name:       "Badung",
 			},
 		}
 
@@ -252,7 +258,7 @@ func TestShippingService_GetCities(t *testing.T) {
 		service := createTestShippingService(mockShippingRepo)
 
 		req := request.GetCitiesRequest{}
-		emptyCities := []response.RajaOngkirCity{}
+		emptyCities := []entity.ShippingCity{}
 
 		mockShippingRepo.EXPECT().GetCities("", "").Return(emptyCities, nil)
 
@@ -330,18 +336,20 @@ func TestShippingService_CalculateShippingCost(t *testing.T) {
 		}
 
 		// Create test data with multiple shipping options
-		multipleCosts := []response.RajaOngkirCost{
+		multipleCosts := []entity.ShippingQuote{
 			{
-				Code:        "jne",
-				Name:        "Jalur Nugraha Ekakurir (JNE)",
+				Provider:    "rajaongkir",
+				Courier:     "jne",
+				CourierName: "Jalur Nugraha Ekakurir (JNE)",
 				Service:     "REG",
 				Description: "Layanan Reguler",
 				Cost:        15000,
 				ETD:         "1-2",
 			},
 			{
-				Code:        "jne",
-				Name:        "Jalur Nugraha Ekakurir (JNE)",
+				Provider:    "rajaongkir",
+				Courier:     "jne",
+				CourierName: "Jalur Nugraha Ekakurir (JNE)",
 				Service:     "YES",
 				Description: "Yakin Esok Sampai",
 				Cost:        18000,
@@ -374,7 +382,7 @@ func TestShippingService_CalculateShippingCost(t *testing.T) {
 			Weight:      1000,
 			Courier:     "invalid",
 		}
-		emptyCosts := []response.RajaOngkirCost{}
+		emptyCosts := []entity.ShippingQuote{}
 
 		mockShippingRepo.EXPECT().CalculateShippingCost("501", "114", 1000, "invalid").Return(emptyCosts, nil)
 
@@ -384,6 +392,149 @@ func TestShippingService_CalculateShippingCost(t *testing.T) {
 		assert.Len(t, result, 0)
 	})
 
+	t.Run("Success - Shipping rules adjust the quotes of the cart", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShippingRepo := repoMocks.NewMockShippingRepository(ctrl)
+		mockRuleRepo := repoMocks.NewMockShippingRuleRepository(ctrl)
+		mockCartRepo := repoMocks.NewMockCartRepository(ctrl)
+		mockLocationRepo := repoMocks.NewMockLocationRepository(ctrl)
+		service := createTestShippingService(mockShippingRepo)
+		service.Rules = NewRuleEvaluator(mockRuleRepo)
+		service.Provinces = NewProvinceResolver(mockLocationRepo)
+		service.CartRepo = mockCartRepo
+
+		req := request.CalculateShippingRequest{
+			Origin:      "501",
+			Destination: "114",
+			Weight:      1000,
+			Courier:     "jne",
+			CartID:      "cart-123",
+			ProvinceID:  "6",
+		}
+		cart := &entity.Cart{CartItems: []entity.CartItem{
+			{Quantity: 2, ProductVariant: &entity.ProductVariant{SKU: "SKU-1", Price: 300000, Weight: 500}},
+		}}
+
+		mockLocationRepo.EXPECT().FindLocationDistrictProvinceID("114").Return("6", nil)
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockShippingRepo.EXPECT().CalculateShippingCost("501", "114", 1000, "jne").Return(createTestShippingCosts(), nil)
+		mockRuleRepo.EXPECT().FindActiveShippingRules().Return([]entity.ShippingRule{
+			{ID: "rule-1", Name: "Free REG over 500k", Type: entity.ShippingRuleFreeShipping, MinSubtotal: 500000, ProvinceID: "6", IsActive: true},
+		}, nil)
+
+		result, err := service.CalculateShippingCost(req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "REG", result[0].Service)
+		assert.Equal(t, float64(0), result[0].Cost)
+		assert.Equal(t, float64(15000), result[0].OriginalCost)
+		assert.Equal(t, "rule-1", result[0].AppliedRule.ID)
+		assert.Equal(t, "Free REG over 500k", result[0].AppliedRule.Name)
+		assert.Equal(t, entity.ShippingRuleFreeShipping, result[0].AppliedRule.Type)
+	})
+
+	t.Run("Success - Cart ships from the warehouses holding it", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShippingRepo := repoMocks.NewMockShippingRepository(ctrl)
+		mockWarehouseRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		mockCartRepo := repoMocks.NewMockCartRepository(ctrl)
+		service := createTestShippingService(mockShippingRepo)
+		service.Origins = NewOriginPlanner(mockWarehouseRepo)
+		service.CartRepo = mockCartRepo
+
+		req := request.CalculateShippingRequest{
+			Destination: "114",
+			Courier:     "jne",
+			CartID:      "cart-123",
+		}
+		cart := &entity.Cart{CartItems: []entity.CartItem{
+			{ID: "item-1", ProductVariantID: "variant-1", Quantity: 1, ProductVariant: &entity.ProductVariant{SKU: "SKU-1", Weight: 1000}},
+		}}
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockWarehouseRepo.EXPECT().FindActiveWarehouses().Return([]entity.Warehouse{
+			{ID: "wh-1", Code: "JKT", Name: "Jakarta", DistrictID: "152"},
+		}, nil)
+		mockWarehouseRepo.EXPECT().FindWarehouseStocks([]string{"variant-1"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-1", ProductVariantID: "variant-1", Quantity: 5},
+		}, nil)
+		mockShippingRepo.EXPECT().CalculateShippingCost("152", "114", 1000, "jne").Return(createTestShippingCosts(), nil)
+
+		result, err := service.CalculateShippingCost(req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Len(t, result[0].Shipments, 1)
+		assert.Equal(t, "wh-1", result[0].Shipments[0].WarehouseID)
+		assert.Equal(t, "152", result[0].Shipments[0].Origin)
+		assert.Equal(t, result[0].Cost, result[0].Shipments[0].Cost)
+	})
+
+	t.Run("Error - No warehouse holds the cart", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShippingRepo := repoMocks.NewMockShippingRepository(ctrl)
+		mockWarehouseRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		mockCartRepo := repoMocks.NewMockCartRepository(ctrl)
+		service := createTestShippingService(mockShippingRepo)
+		service.Origins = NewOriginPlanner(mockWarehouseRepo)
+		service.CartRepo = mockCartRepo
+
+		req := request.CalculateShippingRequest{
+			Destination: "114",
+			Courier:     "jne",
+			CartID:      "cart-123",
+		}
+		cart := &entity.Cart{CartItems: []entity.CartItem{
+			{ID: "item-1", ProductVariantID: "variant-1", Quantity: 3, ProductVariant: &entity.ProductVariant{SKU: "SKU-1"}},
+		}}
+
+		mockCartRepo.EXPECT().GetCartWithItems("cart-123").Return(cart, nil)
+		mockWarehouseRepo.EXPECT().FindActiveWarehouses().Return([]entity.Warehouse{{ID: "wh-1", DistrictID: "152"}}, nil)
+		mockWarehouseRepo.EXPECT().FindWarehouseStocks([]string{"variant-1"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-1", ProductVariantID: "variant-1", Quantity: 2},
+		}, nil)
+
+		result, err := service.CalculateShippingCost(req)
+
+		assert.Nil(t, result)
+		var stockErr *repository.InsufficientStockError
+		assert.ErrorAs(t, err, &stockErr)
+		assert.Equal(t, []string{"SKU-1"}, stockErr.SKUs)
+	})
+
+	t.Run("Error - Shipping rules cannot be read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockShippingRepo := repoMocks.NewMockShippingRepository(ctrl)
+		mockRuleRepo := repoMocks.NewMockShippingRuleRepository(ctrl)
+		service := createTestShippingService(mockShippingRepo)
+		service.Rules = NewRuleEvaluator(mockRuleRepo)
+
+		req := request.CalculateShippingRequest{
+			Origin:      "501",
+			Destination: "114",
+			Weight:      1000,
+			Courier:     "jne",
+		}
+
+		mockShippingRepo.EXPECT().CalculateShippingCost("501", "114", 1000, "jne").Return(createTestShippingCosts(), nil)
+		mockRuleRepo.EXPECT().FindActiveShippingRules().Return(nil, errors.New("db down"))
+
+		result, err := service.CalculateShippingCost(req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to get shipping rules")
+	})
+
 	t.Run("Error - RajaOngkir API failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -512,3 +663,129 @@ func TestShippingService_GetDistricts(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestShippingService_ValidateAndSaveAWB(t *testing.T) {
+	t.Run("Success - Saved AWB notifies the customer", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+		mailer := repoMocks.NewMockMailer(ctrl)
+		whatsApp := repoMocks.NewMockWhatsApp(ctrl)
+		svc.Mailer = mailer
+		svc.WhatsAppRepo = whatsApp
+		svc.BaseURL = "https://shop.example.com"
+
+		order := &entity.Order{
+			ID:            uuid.New().String(),
+			OrderNumber:   "IQB-2025-00001",
+			OrderStatus:   entity.OrderStatusProcessing,
+			CustomerName:  "John",
+			CustomerEmail: "john@example.com",
+			CustomerPhone: "081234567890",
+		}
+		awbTrackingRepo.EXPECT().GetOrderByInvoiceNumber("IQB-2025-00001").Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber("JNE123", "jne").Return(nil, nil)
+		shippingRepo.EXPECT().ValidateAWB("JNE123", "jne", nil).Return(createTestTrackingResponse(false, "MANIFESTED"), nil)
+		awbTrackingRepo.EXPECT().CreateAWBTracking(gomock.Any()).Return(nil)
+
+		var change *entity.OrderStatusChange
+		paymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).
+			DoAndReturn(func(c *entity.OrderStatusChange) (bool, error) {
+				change = c
+				return true, nil
+			})
+		mailer.EXPECT().SendShipmentDispatched(order, gomock.Any()).Return(nil)
+
+		var message string
+		whatsApp.EXPECT().SendMessage("+6281234567890@s.whatsapp.net", gomock.Any()).
+			DoAndReturn(func(_, text string) error {
+				message = text
+				return nil
+			})
+
+		// Act
+		result, err := svc.ValidateAndSaveAWB(request.ValidateAWBRequest{InvoiceNumber: "IQB-2025-00001", AWBNumber: "JNE123", Courier: "jne"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.IsValidated)
+		assert.Contains(t, message, "Kurir: JNE")
+		assert.Contains(t, message, "Nomor Resi: JNE123")
+		assert.Contains(t, message, "https://shop.example.com/track-order/IQB-2025-00001")
+		require.NotNil(t, change)
+		assert.Equal(t, entity.OrderStatusProcessing, change.FromStatus)
+		assert.Equal(t, entity.OrderStatusShipped, change.ToStatus)
+		assert.Equal(t, trackingChangedBy, change.ChangedBy)
+		assert.Equal(t, entity.OrderStatusShipped, order.OrderStatus)
+	})
+
+	t.Run("Success - Notification failures do not fail the save", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+		mailer := repoMocks.NewMockMailer(ctrl)
+		whatsApp := repoMocks.NewMockWhatsApp(ctrl)
+		svc.Mailer = mailer
+		svc.WhatsAppRepo = whatsApp
+
+		order := &entity.Order{ID: uuid.New().String(), OrderNumber: "IQB-2025-00001", OrderStatus: entity.OrderStatusShipped, CustomerPhone: "081234567890"}
+		awbTrackingRepo.EXPECT().GetOrderByInvoiceNumber(gomock.Any()).Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber(gomock.Any(), gomock.Any()).Return(nil, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(false, "MANIFESTED"), nil)
+		awbTrackingRepo.EXPECT().CreateAWBTracking(gomock.Any()).Return(nil)
+		mailer.EXPECT().SendShipmentDispatched(gomock.Any(), gomock.Any()).Return(errors.New("smtp error"))
+		whatsApp.EXPECT().SendMessage(gomock.Any(), gomock.Any()).Return(errors.New("whatsapp error"))
+
+		// Act
+		result, err := svc.ValidateAndSaveAWB(request.ValidateAWBRequest{InvoiceNumber: "IQB-2025-00001", AWBNumber: "JNE123", Courier: "jne"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.IsValidated)
+	})
+
+	t.Run("Error - Invalid AWB sends nothing", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+		svc.Mailer = repoMocks.NewMockMailer(ctrl)
+		svc.WhatsAppRepo = repoMocks.NewMockWhatsApp(ctrl)
+
+		awbTrackingRepo.EXPECT().GetOrderByInvoiceNumber(gomock.Any()).Return(&entity.Order{ID: uuid.New().String(), OrderStatus: entity.OrderStatusProcessing}, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber(gomock.Any(), gomock.Any()).Return(nil, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("not found"))
+
+		// Act
+		result, err := svc.ValidateAndSaveAWB(request.ValidateAWBRequest{InvoiceNumber: "IQB-2025-00001", AWBNumber: "JNE123", Courier: "jne"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, result.IsValidated)
+	})
+
+	t.Run("Error - Order that is not processing or shipped takes no AWB", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+		svc.Mailer = repoMocks.NewMockMailer(ctrl)
+		svc.WhatsAppRepo = repoMocks.NewMockWhatsApp(ctrl)
+
+		awbTrackingRepo.EXPECT().GetOrderByInvoiceNumber("IQB-2025-00001").
+			Return(&entity.Order{ID: uuid.New().String(), OrderStatus: entity.OrderStatusCancelled}, nil)
+
+		// Act
+		result, err := svc.ValidateAndSaveAWB(request.ValidateAWBRequest{InvoiceNumber: "IQB-2025-00001", AWBNumber: "JNE123", Courier: "jne"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, service.ErrOrderNotShippable)
+	})
+}
@@ -1,20 +1,104 @@
 package shipping
 
 import (
+	"time"
+
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/repository"
 	"github.com/hanifbg/landing_backend/internal/repository/util"
 	"github.com/hanifbg/landing_backend/internal/service"
 )
 
+// DefaultRefreshInterval is how long a shipment waits between tracking refreshes
+// when shipping.awb_refresh_interval_mins is not set
+const DefaultRefreshInterval = time.Hour
+
+// AWBRefreshInterval is how long a shipment waits between tracking refreshes. The refresh
+// job runs at the same interval, so a shipment is checked again as soon as it is due.
+func AWBRefreshInterval(cfg *config.AppConfig) time.Duration {
+	if cfg.AWBRefreshIntervalMins > 0 {
+		return time.Duration(cfg.AWBRefreshIntervalMins) * time.Minute
+	}
+	return DefaultRefreshInterval
+}
+
+// DefaultTrackingMaxAge is how old tracking data may be before a customer lookup re-reads
+// it from the courier when shipping.awb_tracking_max_age_mins is not set
+const DefaultTrackingMaxAge = 15 * time.Minute
+
 type ShippingService struct {
 	ShippingRepo    repository.ShippingRepository
+	RateProvider    repository.ShippingRateProvider // Quotes shipping costs; usually every provider merged
+	Rules           *RuleEvaluator                  // Adjusts quotes; nil leaves them as quoted
+	Origins         *OriginPlanner                  // Picks the warehouses a cart ships from; nil ships from OriginID
+	Provinces       *ProvinceResolver               // Finds the destination's province; nil finds none
+	OriginID        string                          // RajaOngkir district ID carts ship from without warehouses
+	CartRepo        repository.CartRepository
 	AWBTrackingRepo repository.AWBTrackingRepository
+	PaymentRepo     repository.PaymentRepository
+	RefreshInterval time.Duration // Wait between two tracking refreshes of the same shipment
+	TrackingMaxAge  time.Duration // Tracking shown to customers is re-read once it is older than this
+	Mailer          repository.Mailer
+	WhatsAppRepo    repository.WhatsApp
+	BaseURL         string // Frontend address the tracking links in notifications point to
+	// WebhookProviders maps the :provider of the tracking webhook to how its calls are checked and read
+	WebhookProviders map[string]WebhookProvider
+
+	// Locations serves provinces, cities and districts; nil reads them from ShippingRepo.
+	// Without the cache it is a recorder, which reads ShippingRepo but stores what it read
+	// so Provinces can find the destination's province. Stored locations older than
+	// LocationMaxAge are re-read by SyncLocations, at most LocationSyncMaxRequests lists per run.
+	Locations               *LocationCache
+	LocationMaxAge          time.Duration
+	LocationSyncMaxRequests int
 }
 
 func New(cfg *config.AppConfig, repoWrapper *util.RepoWrapper) service.ShippingService {
+	trackingMaxAge := DefaultTrackingMaxAge
+	if cfg.AWBTrackingMaxAgeMins > 0 {
+		trackingMaxAge = time.Duration(cfg.AWBTrackingMaxAgeMins) * time.Minute
+	}
+
+	locationMaxAge := DefaultLocationMaxAge
+	if cfg.RajaOngkirCacheTTLHours > 0 {
+		locationMaxAge = time.Duration(cfg.RajaOngkirCacheTTLHours) * time.Hour
+	}
+	locationSyncMaxRequests := DefaultLocationSyncMaxRequests
+	if cfg.LocationSyncMaxRequests > 0 {
+		locationSyncMaxRequests = cfg.LocationSyncMaxRequests
+	}
+	locations := NewLocationRecorder(repoWrapper.ShippingRepo, repoWrapper.LocationRepo)
+	if cfg.RajaOngkirCacheEnabled {
+		locations = NewLocationCache(repoWrapper.ShippingRepo, repoWrapper.LocationRepo)
+	}
+
+	webhookProviders := map[string]WebhookProvider{}
+	if cfg.RajaOngkirWebhookSecret != "" {
+		webhookProviders[RajaOngkirWebhookProvider] = WebhookProvider{
+			Secret: cfg.RajaOngkirWebhookSecret,
+			Parser: RajaOngkirWebhookParser{},
+		}
+	}
+
 	return &ShippingService{
-		ShippingRepo:    repoWrapper.ShippingRepo,
-		AWBTrackingRepo: repoWrapper.AWBTrackingRepo,
+		ShippingRepo:     repoWrapper.ShippingRepo,
+		RateProvider:     NewRateAggregator(repoWrapper.ShippingRateProviders...),
+		Rules:            NewRuleEvaluator(repoWrapper.ShippingRuleRepo),
+		Origins:          NewOriginPlanner(repoWrapper.WarehouseRepo),
+		Provinces:        NewProvinceResolver(repoWrapper.LocationRepo),
+		OriginID:         cfg.ShippingOriginID,
+		CartRepo:         repoWrapper.CartRepo,
+		AWBTrackingRepo:  repoWrapper.AWBTrackingRepo,
+		PaymentRepo:      repoWrapper.PaymentRepo,
+		RefreshInterval:  AWBRefreshInterval(cfg),
+		TrackingMaxAge:   trackingMaxAge,
+		Mailer:           repoWrapper.MailRepo,
+		WhatsAppRepo:     repoWrapper.WhatsAppRepo,
+		BaseURL:          cfg.BaseURL,
+		WebhookProviders: webhookProviders,
+
+		Locations:               locations,
+		LocationMaxAge:          locationMaxAge,
+		LocationSyncMaxRequests: locationSyncMaxRequests,
 	}
 }
@@ -0,0 +1,278 @@
+package shipping
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+// DefaultLocationMaxAge is how old stored locations get before the sync job re-reads them
+// when shipping.rajaongkir_cache_ttl_hours is not set
+const DefaultLocationMaxAge = 7 * 24 * time.Hour
+
+// DefaultLocationSyncInterval is how often the location sync job runs when
+// shipping.location_sync_interval_mins is not set
+const DefaultLocationSyncInterval = time.Hour
+
+// DefaultLocationSyncMaxRequests is how many provider calls one run of the location sync job
+// may make when shipping.location_sync_max_requests is not set. RajaOngkir allows 100 calls
+// a day, which checkout quotes need more.
+const DefaultLocationSyncMaxRequests = 3
+
+// LocationSource lists the places shipping delivers to
+type LocationSource interface {
+	GetProvinces(provinceID string) ([]entity.ShippingProvince, error)
+	GetCities(provinceID, cityID string) ([]entity.ShippingCity, error)
+	GetDistricts(cityID string) ([]entity.ShippingDistrict, error)
+}
+
+// LocationCache serves locations from the database, so they can still be listed when the
+// provider is out of quota. Lists that are not stored yet are read from the provider and
+// stored on the way; Sync keeps the stored lists fresh.
+type LocationCache struct {
+	source LocationSource
+	store  repository.LocationRepository
+	live   bool // Always read the source; the store only records what was read
+}
+
+// NewLocationCache creates a cache of the source's locations kept in the given repository
+func NewLocationCache(source LocationSource, store repository.LocationRepository) *LocationCache {
+	return &LocationCache{source: source, store: store}
+}
+
+// NewLocationRecorder creates a cache that always reads the source and only records the
+// lists it reads, so ProvinceResolver can find the districts customers picked from
+func NewLocationRecorder(source LocationSource, store repository.LocationRepository) *LocationCache {
+	return &LocationCache{source: source, store: store, live: true}
+}
+
+// GetProvinces returns every province, or only the given one
+func (c *LocationCache) GetProvinces(provinceID string) ([]entity.ShippingProvince, error) {
+	if !c.live {
+		stored, err := c.store.FindLocationProvinces(provinceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stored provinces: %w", err)
+		}
+		if len(stored) > 0 {
+			provinces := make([]entity.ShippingProvince, 0, len(stored))
+			for _, province := range stored {
+				provinces = append(provinces, entity.ShippingProvince{ID: province.ID, Name: province.Name})
+			}
+			return provinces, nil
+		}
+	}
+
+	provinces, err := c.source.GetProvinces(provinceID)
+	if err != nil {
+		return nil, err
+	}
+	// Only the full list can replace the stored one
+	if provinceID == "" && len(provinces) > 0 {
+		if err := c.store.ReplaceLocationProvinces(provinces); err != nil {
+			log.Printf("failed to store provinces: %v", err)
+		}
+	}
+	return provinces, nil
+}
+
+// GetCities returns the cities of the province, or only the given city
+func (c *LocationCache) GetCities(provinceID, cityID string) ([]entity.ShippingCity, error) {
+	if !c.live {
+		stored, err := c.store.FindLocationCities(provinceID, cityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stored cities: %w", err)
+		}
+		if len(stored) > 0 {
+			cities := make([]entity.ShippingCity, 0, len(stored))
+			for _, city := range stored {
+				cities = append(cities, entity.ShippingCity{ID: city.ID, ProvinceID: city.ProvinceID, Name: city.Name})
+			}
+			return cities, nil
+		}
+	}
+
+	cities, err := c.source.GetCities(provinceID, cityID)
+	if err != nil {
+		return nil, err
+	}
+	if provinceID != "" && cityID == "" && len(cities) > 0 {
+		if err := c.store.ReplaceLocationCities(provinceID, cities); err != nil {
+			log.Printf("failed to store cities of province %s: %v", provinceID, err)
+		}
+	}
+	return cities, nil
+}
+
+// GetDistricts returns the districts of the city
+func (c *LocationCache) GetDistricts(cityID string) ([]entity.ShippingDistrict, error) {
+	if !c.live {
+		stored, err := c.store.FindLocationDistricts(cityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stored districts: %w", err)
+		}
+		if len(stored) > 0 {
+			districts := make([]entity.ShippingDistrict, 0, len(stored))
+			for _, district := range stored {
+				districts = append(districts, entity.ShippingDistrict{ID: district.ID, CityID: district.CityID, Name: district.Name})
+			}
+			return districts, nil
+		}
+	}
+
+	districts, err := c.source.GetDistricts(cityID)
+	if err != nil {
+		return nil, err
+	}
+	if len(districts) > 0 {
+		if err := c.store.ReplaceLocationDistricts(cityID, districts); err != nil {
+			log.Printf("failed to store districts of city %s: %v", cityID, err)
+		}
+	}
+	return districts, nil
+}
+
+// ProvinceResolver finds the province a destination district is in, so province shipping
+// rules and the choice of warehouse never rest on a province the client claims
+type ProvinceResolver struct {
+	store repository.LocationRepository
+}
+
+// NewProvinceResolver creates a resolver reading the stored locations
+func NewProvinceResolver(store repository.LocationRepository) *ProvinceResolver {
+	return &ProvinceResolver{store: store}
+}
+
+// Resolve returns the province the stored district is in. A provinceID naming another
+// province is rejected with ErrShippingProvinceMismatch, and a district that is not stored
+// with ErrShippingDistrictUnknown, since its province rules could not be applied. Districts
+// are stored once the customer lists them. A nil resolver gives no province.
+func (r *ProvinceResolver) Resolve(districtID, provinceID string) (string, error) {
+	if r == nil || districtID == "" {
+		return "", nil
+	}
+
+	stored, err := r.store.FindLocationDistrictProvinceID(districtID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get province of district %s: %w", districtID, err)
+	}
+	if stored == "" {
+		return "", fmt.Errorf("%w: district %s", service.ErrShippingDistrictUnknown, districtID)
+	}
+	if provinceID != "" && provinceID != stored {
+		return "", fmt.Errorf("%w: district %s is in province %s, got %s", service.ErrShippingProvinceMismatch, districtID, stored, provinceID)
+	}
+	return stored, nil
+}
+
+// Sync re-reads the stored lists synced before staleBefore from the source, oldest first:
+// the provinces, the cities of every province, and the districts of the cities whose
+// districts were ever asked for. Provinces whose cities were never stored are read too.
+// At most maxRequests lists are read, or all of them when maxRequests is 0. Sync stops at
+// the first list the source cannot read, since the rest would likely fail the same way.
+// It returns how many lists were stored. A recorder is never synced, since it does not
+// serve the stored lists.
+func (c *LocationCache) Sync(ctx context.Context, staleBefore time.Time, maxRequests int) (int, error) {
+	if c.live {
+		return 0, nil
+	}
+	synced := 0
+	remaining := func() int {
+		if maxRequests <= 0 {
+			return -1 // No limit
+		}
+		return maxRequests - synced
+	}
+
+	provinces, err := c.store.FindLocationProvinces("")
+	if err != nil {
+		return synced, fmt.Errorf("failed to get stored provinces: %w", err)
+	}
+	if len(provinces) == 0 || oldestProvince(provinces).Before(staleBefore) {
+		fresh, err := c.source.GetProvinces("")
+		if err != nil {
+			return synced, fmt.Errorf("failed to read provinces: %w", err)
+		}
+		if err := c.store.ReplaceLocationProvinces(fresh); err != nil {
+			return synced, fmt.Errorf("failed to store provinces: %w", err)
+		}
+		synced++
+	}
+
+	if remaining() == 0 {
+		return synced, nil
+	}
+	due, err := c.store.FindProvincesDueForCitySync(staleBefore, remaining())
+	if err != nil {
+		return synced, fmt.Errorf("failed to get provinces due for sync: %w", err)
+	}
+	for _, province := range due {
+		if err := ctx.Err(); err != nil {
+			return synced, err
+		}
+		cities, err := c.source.GetCities(province.ID, "")
+		if err != nil {
+			return synced, fmt.Errorf("failed to read cities of province %s: %w", province.ID, err)
+		}
+		if err := c.store.ReplaceLocationCities(province.ID, cities); err != nil {
+			return synced, fmt.Errorf("failed to store cities of province %s: %w", province.ID, err)
+		}
+		synced++
+	}
+
+	if remaining() == 0 {
+		return synced, nil
+	}
+	dueCities, err := c.store.FindCitiesDueForDistrictSync(staleBefore, remaining())
+	if err != nil {
+		return synced, fmt.Errorf("failed to get cities due for sync: %w", err)
+	}
+	for _, city := range dueCities {
+		if err := ctx.Err(); err != nil {
+			return synced, err
+		}
+		districts, err := c.source.GetDistricts(city.ID)
+		if err != nil {
+			return synced, fmt.Errorf("failed to read districts of city %s: %w", city.ID, err)
+		}
+		if err := c.store.ReplaceLocationDistricts(city.ID, districts); err != nil {
+			return synced, fmt.Errorf("failed to store districts of city %s: %w", city.ID, err)
+		}
+		synced++
+	}
+
+	return synced, nil
+}
+
+// SyncLocations re-reads the stored locations older than LocationMaxAge from the provider,
+// at most LocationSyncMaxRequests lists at a time
+func (s *ShippingService) SyncLocations(ctx context.Context) (int, error) {
+	if s.Locations == nil {
+		return 0, nil
+	}
+	return s.Locations.Sync(ctx, time.Now().Add(-s.LocationMaxAge), s.LocationSyncMaxRequests)
+}
+
+// WarmUpLocations stores the provinces, and the cities of every province, that were never
+// stored. It reads as many lists as it needs, so it is meant for startup, bounded by ctx.
+func (s *ShippingService) WarmUpLocations(ctx context.Context) (int, error) {
+	if s.Locations == nil {
+		return 0, nil
+	}
+	return s.Locations.Sync(ctx, time.Time{}, 0)
+}
+
+// oldestProvince returns when the least recently synced province was synced
+func oldestProvince(provinces []entity.LocationProvince) time.Time {
+	oldest := provinces[0].SyncedAt
+	for _, province := range provinces[1:] {
+		if province.SyncedAt.Before(oldest) {
+			oldest = province.SyncedAt
+		}
+	}
+	return oldest
+}
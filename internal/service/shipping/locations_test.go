@@ -0,0 +1,300 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/service"
+	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationCache_GetProvinces(t *testing.T) {
+	t.Run("Success - Stored provinces are served without the provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationProvinces("").Return([]entity.LocationProvince{
+			{ID: "1", Name: "Bali", SyncedAt: time.Now()},
+		}, nil)
+
+		provinces, err := cache.GetProvinces("")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ShippingProvince{{ID: "1", Name: "Bali"}}, provinces)
+	})
+
+	t.Run("Success - Provinces not stored yet are read and stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationProvinces("").Return(nil, nil)
+		mockSource.EXPECT().GetProvinces("").Return(createTestProvinces(), nil)
+		mockStore.EXPECT().ReplaceLocationProvinces(createTestProvinces()).Return(nil)
+
+		provinces, err := cache.GetProvinces("")
+
+		assert.NoError(t, err)
+		assert.Equal(t, createTestProvinces(), provinces)
+	})
+
+	t.Run("Success - Failing store keeps the provider's provinces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationProvinces("").Return(nil, nil)
+		mockSource.EXPECT().GetProvinces("").Return(createTestProvinces(), nil)
+		mockStore.EXPECT().ReplaceLocationProvinces(gomock.Any()).Return(errors.New("database error"))
+
+		provinces, err := cache.GetProvinces("")
+
+		assert.NoError(t, err)
+		assert.Len(t, provinces, 2)
+	})
+}
+
+func TestLocationCache_GetCities(t *testing.T) {
+	t.Run("Success - A single city is read but not stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationCities("5", "39").Return(nil, nil)
+		mockSource.EXPECT().GetCities("5", "39").Return([]entity.ShippingCity{{ID: "39", ProvinceID: "5", Name: "Bantul"}}, nil)
+
+		cities, err := cache.GetCities("5", "39")
+
+		assert.NoError(t, err)
+		assert.Len(t, cities, 1)
+	})
+}
+
+func TestLocationCache_GetDistricts(t *testing.T) {
+	t.Run("Success - Stored districts are served without the provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationDistricts("39").Return([]entity.LocationDistrict{
+			{ID: "501", CityID: "39", Name: "Sewon"},
+		}, nil)
+
+		districts, err := cache.GetDistricts("39")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.ShippingDistrict{{ID: "501", CityID: "39", Name: "Sewon"}}, districts)
+	})
+
+	t.Run("Error - Districts are neither stored nor readable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationDistricts("39").Return(nil, nil)
+		mockSource.EXPECT().GetDistricts("39").Return(nil, errors.New("quota exceeded"))
+
+		districts, err := cache.GetDistricts("39")
+
+		assert.Nil(t, districts)
+		assert.EqualError(t, err, "quota exceeded")
+	})
+
+	t.Run("Success - Recorder reads the provider and stores the districts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		recorder := NewLocationRecorder(mockSource, mockStore)
+
+		fresh := []entity.ShippingDistrict{{ID: "501", CityID: "39", Name: "Sewon"}}
+		mockSource.EXPECT().GetDistricts("39").Return(fresh, nil)
+		mockStore.EXPECT().ReplaceLocationDistricts("39", fresh).Return(nil)
+
+		districts, err := recorder.GetDistricts("39")
+
+		assert.NoError(t, err)
+		assert.Equal(t, fresh, districts)
+	})
+}
+
+func TestProvinceResolver_Resolve(t *testing.T) {
+	t.Run("Success - Province comes from the stored district", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		resolver := NewProvinceResolver(mockStore)
+
+		mockStore.EXPECT().FindLocationDistrictProvinceID("114").Return("6", nil)
+
+		provinceID, err := resolver.Resolve("114", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "6", provinceID)
+	})
+
+	t.Run("Error - District not stored is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		resolver := NewProvinceResolver(mockStore)
+
+		mockStore.EXPECT().FindLocationDistrictProvinceID("114").Return("", nil)
+
+		provinceID, err := resolver.Resolve("114", "6")
+
+		assert.Empty(t, provinceID)
+		assert.ErrorIs(t, err, service.ErrShippingDistrictUnknown)
+	})
+
+	t.Run("Error - Province of another district is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		resolver := NewProvinceResolver(mockStore)
+
+		mockStore.EXPECT().FindLocationDistrictProvinceID("114").Return("9", nil)
+
+		provinceID, err := resolver.Resolve("114", "6")
+
+		assert.Empty(t, provinceID)
+		assert.ErrorIs(t, err, service.ErrShippingProvinceMismatch)
+	})
+}
+
+func TestLocationCache_Sync(t *testing.T) {
+	staleBefore := time.Now().Add(-24 * time.Hour)
+
+	t.Run("Success - Stale lists are re-read oldest first within the budget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		cities := []entity.ShippingCity{{ID: "39", ProvinceID: "5", Name: "Bantul"}}
+		districts := []entity.ShippingDistrict{{ID: "501", CityID: "39", Name: "Sewon"}}
+
+		// Provinces are fresh, so the budget goes to one province's cities and one city's districts
+		mockStore.EXPECT().FindLocationProvinces("").Return([]entity.LocationProvince{{ID: "5", SyncedAt: time.Now()}}, nil)
+		mockStore.EXPECT().FindProvincesDueForCitySync(staleBefore, 2).Return([]entity.LocationProvince{{ID: "5"}}, nil)
+		mockSource.EXPECT().GetCities("5", "").Return(cities, nil)
+		mockStore.EXPECT().ReplaceLocationCities("5", cities).Return(nil)
+		mockStore.EXPECT().FindCitiesDueForDistrictSync(staleBefore, 1).Return([]entity.LocationCity{{ID: "39"}}, nil)
+		mockSource.EXPECT().GetDistricts("39").Return(districts, nil)
+		mockStore.EXPECT().ReplaceLocationDistricts("39", districts).Return(nil)
+
+		synced, err := cache.Sync(context.Background(), staleBefore, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, synced)
+	})
+
+	t.Run("Success - Stale provinces use up the budget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationProvinces("").Return([]entity.LocationProvince{
+			{ID: "1", SyncedAt: time.Now()},
+			{ID: "5", SyncedAt: staleBefore.Add(-time.Hour)},
+		}, nil)
+		mockSource.EXPECT().GetProvinces("").Return(createTestProvinces(), nil)
+		mockStore.EXPECT().ReplaceLocationProvinces(createTestProvinces()).Return(nil)
+
+		synced, err := cache.Sync(context.Background(), staleBefore, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, synced)
+	})
+
+	t.Run("Error - Sync stops at the first list the provider cannot read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		cache := NewLocationCache(mockSource, mockStore)
+
+		mockStore.EXPECT().FindLocationProvinces("").Return([]entity.LocationProvince{{ID: "5", SyncedAt: time.Now()}}, nil)
+		mockStore.EXPECT().FindProvincesDueForCitySync(staleBefore, -1).Return([]entity.LocationProvince{{ID: "5"}, {ID: "6"}}, nil)
+		mockSource.EXPECT().GetCities("5", "").Return(nil, errors.New("quota exceeded"))
+
+		synced, err := cache.Sync(context.Background(), staleBefore, 0)
+
+		assert.Equal(t, 0, synced)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read cities of province 5: quota exceeded")
+	})
+}
+
+func TestShippingService_WarmUpLocations(t *testing.T) {
+	t.Run("Success - Only lists never stored are read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSource := repoMocks.NewMockShippingRepository(ctrl)
+		mockStore := repoMocks.NewMockLocationRepository(ctrl)
+		service := createTestShippingService(mockSource)
+		service.Locations = NewLocationCache(mockSource, mockStore)
+
+		cities := []entity.ShippingCity{{ID: "17", ProvinceID: "1", Name: "Badung"}}
+
+		mockStore.EXPECT().FindLocationProvinces("").Return(nil, nil)
+		mockSource.EXPECT().GetProvinces("").Return(createTestProvinces(), nil)
+		mockStore.EXPECT().ReplaceLocationProvinces(createTestProvinces()).Return(nil)
+		mockStore.EXPECT().FindProvincesDueForCitySync(time.Time{}, -1).Return([]entity.LocationProvince{{ID: "1"}}, nil)
+		mockSource.EXPECT().GetCities("1", "").Return(cities, nil)
+		mockStore.EXPECT().ReplaceLocationCities("1", cities).Return(nil)
+		mockStore.EXPECT().FindCitiesDueForDistrictSync(time.Time{}, -1).Return(nil, nil)
+
+		stored, err := service.WarmUpLocations(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, stored)
+	})
+
+	t.Run("Success - Nothing to do without a location cache", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := createTestShippingService(repoMocks.NewMockShippingRepository(ctrl))
+
+		stored, err := service.WarmUpLocations(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, stored)
+	})
+}
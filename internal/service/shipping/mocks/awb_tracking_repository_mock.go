@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: awb_tracking.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockAWBTrackingRepository is a mock of AWBTrackingRepository interface.
+type MockAWBTrackingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAWBTrackingRepositoryMockRecorder
+}
+
+// MockAWBTrackingRepositoryMockRecorder is the mock recorder for MockAWBTrackingRepository.
+type MockAWBTrackingRepositoryMockRecorder struct {
+	mock *MockAWBTrackingRepository
+}
+
+// NewMockAWBTrackingRepository creates a new mock instance.
+func NewMockAWBTrackingRepository(ctrl *gomock.Controller) *MockAWBTrackingRepository {
+	mock := &MockAWBTrackingRepository{ctrl: ctrl}
+	mock.recorder = &MockAWBTrackingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAWBTrackingRepository) EXPECT() *MockAWBTrackingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimWebhookEvent mocks base method.
+func (m *MockAWBTrackingRepository) ClaimWebhookEvent(event *entity.ShippingWebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookEvent", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimWebhookEvent indicates an expected call of ClaimWebhookEvent.
+func (mr *MockAWBTrackingRepositoryMockRecorder) ClaimWebhookEvent(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookEvent", reflect.TypeOf((*MockAWBTrackingRepository)(nil).ClaimWebhookEvent), event)
+}
+
+// CreateAWBTracking mocks base method.
+func (m *MockAWBTrackingRepository) CreateAWBTracking(awbTracking *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAWBTracking", awbTracking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAWBTracking indicates an expected call of CreateAWBTracking.
+func (mr *MockAWBTrackingRepositoryMockRecorder) CreateAWBTracking(awbTracking interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAWBTracking", reflect.TypeOf((*MockAWBTrackingRepository)(nil).CreateAWBTracking), awbTracking)
+}
+
+// DeleteAWBTracking mocks base method.
+func (m *MockAWBTrackingRepository) DeleteAWBTracking(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAWBTracking", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAWBTracking indicates an expected call of DeleteAWBTracking.
+func (mr *MockAWBTrackingRepositoryMockRecorder) DeleteAWBTracking(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAWBTracking", reflect.TypeOf((*MockAWBTrackingRepository)(nil).DeleteAWBTracking), id)
+}
+
+// FindAWBTrackingsDueForRefresh mocks base method.
+func (m *MockAWBTrackingRepository) FindAWBTrackingsDueForRefresh(now, createdAfter time.Time, limit int) ([]*entity.AWBTracking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAWBTrackingsDueForRefresh", now, createdAfter, limit)
+	ret0, _ := ret[0].([]*entity.AWBTracking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAWBTrackingsDueForRefresh indicates an expected call of FindAWBTrackingsDueForRefresh.
+func (mr *MockAWBTrackingRepositoryMockRecorder) FindAWBTrackingsDueForRefresh(now, createdAfter, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAWBTrackingsDueForRefresh", reflect.TypeOf((*MockAWBTrackingRepository)(nil).FindAWBTrackingsDueForRefresh), now, createdAfter, limit)
+}
+
+// GetAWBTrackingByAWBNumber mocks base method.
+func (m *MockAWBTrackingRepository) GetAWBTrackingByAWBNumber(awbNumber, courier string) (*entity.AWBTracking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAWBTrackingByAWBNumber", awbNumber, courier)
+	ret0, _ := ret[0].(*entity.AWBTracking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAWBTrackingByAWBNumber indicates an expected call of GetAWBTrackingByAWBNumber.
+func (mr *MockAWBTrackingRepositoryMockRecorder) GetAWBTrackingByAWBNumber(awbNumber, courier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAWBTrackingByAWBNumber", reflect.TypeOf((*MockAWBTrackingRepository)(nil).GetAWBTrackingByAWBNumber), awbNumber, courier)
+}
+
+// GetAWBTrackingByOrderID mocks base method.
+func (m *MockAWBTrackingRepository) GetAWBTrackingByOrderID(orderID uuid.UUID) ([]*entity.AWBTracking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAWBTrackingByOrderID", orderID)
+	ret0, _ := ret[0].([]*entity.AWBTracking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAWBTrackingByOrderID indicates an expected call of GetAWBTrackingByOrderID.
+func (mr *MockAWBTrackingRepositoryMockRecorder) GetAWBTrackingByOrderID(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAWBTrackingByOrderID", reflect.TypeOf((*MockAWBTrackingRepository)(nil).GetAWBTrackingByOrderID), orderID)
+}
+
+// GetOrderByInvoiceNumber mocks base method.
+func (m *MockAWBTrackingRepository) GetOrderByInvoiceNumber(invoiceNumber string) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByInvoiceNumber", invoiceNumber)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByInvoiceNumber indicates an expected call of GetOrderByInvoiceNumber.
+func (mr *MockAWBTrackingRepositoryMockRecorder) GetOrderByInvoiceNumber(invoiceNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByInvoiceNumber", reflect.TypeOf((*MockAWBTrackingRepository)(nil).GetOrderByInvoiceNumber), invoiceNumber)
+}
+
+// ReleaseWebhookEvent mocks base method.
+func (m *MockAWBTrackingRepository) ReleaseWebhookEvent(eventID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWebhookEvent", eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWebhookEvent indicates an expected call of ReleaseWebhookEvent.
+func (mr *MockAWBTrackingRepositoryMockRecorder) ReleaseWebhookEvent(eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWebhookEvent", reflect.TypeOf((*MockAWBTrackingRepository)(nil).ReleaseWebhookEvent), eventID)
+}
+
+// UpdateAWBTracking mocks base method.
+func (m *MockAWBTrackingRepository) UpdateAWBTracking(awbTracking *entity.AWBTracking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAWBTracking", awbTracking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAWBTracking indicates an expected call of UpdateAWBTracking.
+func (mr *MockAWBTrackingRepositoryMockRecorder) UpdateAWBTracking(awbTracking interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAWBTracking", reflect.TypeOf((*MockAWBTrackingRepository)(nil).UpdateAWBTracking), awbTracking)
+}
@@ -8,9 +8,75 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	response "github.com/hanifbg/landing_backend/internal/model/response"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
 )
 
+// MockShippingRateProvider is a mock of ShippingRateProvider interface.
+type MockShippingRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRateProviderMockRecorder
+}
+
+// MockShippingRateProviderMockRecorder is the mock recorder for MockShippingRateProvider.
+type MockShippingRateProviderMockRecorder struct {
+	mock *MockShippingRateProvider
+}
+
+// NewMockShippingRateProvider creates a new mock instance.
+func NewMockShippingRateProvider(ctrl *gomock.Controller) *MockShippingRateProvider {
+	mock := &MockShippingRateProvider{ctrl: ctrl}
+	mock.recorder = &MockShippingRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRateProvider) EXPECT() *MockShippingRateProviderMockRecorder {
+	return m.recorder
+}
+
+// CalculateShippingCost mocks base method.
+func (m *MockShippingRateProvider) CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateShippingCost", origin, destination, weight, courier)
+	ret0, _ := ret[0].([]entity.ShippingQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateShippingCost indicates an expected call of CalculateShippingCost.
+func (mr *MockShippingRateProviderMockRecorder) CalculateShippingCost(origin, destination, weight, courier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateShippingCost", reflect.TypeOf((*MockShippingRateProvider)(nil).CalculateShippingCost), origin, destination, weight, courier)
+}
+
+// Couriers mocks base method.
+func (m *MockShippingRateProvider) Couriers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Couriers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Couriers indicates an expected call of Couriers.
+func (mr *MockShippingRateProviderMockRecorder) Couriers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Couriers", reflect.TypeOf((*MockShippingRateProvider)(nil).Couriers))
+}
+
+// Name mocks base method.
+func (m *MockShippingRateProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockShippingRateProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockShippingRateProvider)(nil).Name))
+}
+
 // MockShippingRepository is a mock of ShippingRepository interface.
 type MockShippingRepository struct {
 	ctrl     *gomock.Controller
@@ -35,10 +101,10 @@ func (m *MockShippingRepository) EXPECT() *MockShippingRepositoryMockRecorder {
 }
 
 // CalculateShippingCost mocks base method.
-func (m *MockShippingRepository) CalculateShippingCost(origin, destination string, weight int, courier string) ([]response.RajaOngkirCost, error) {
+func (m *MockShippingRepository) CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CalculateShippingCost", origin, destination, weight, courier)
-	ret0, _ := ret[0].([]response.RajaOngkirCost)
+	ret0, _ := ret[0].([]entity.ShippingQuote)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -49,11 +115,25 @@ func (mr *MockShippingRepositoryMockRecorder) CalculateShippingCost(origin, dest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateShippingCost", reflect.TypeOf((*MockShippingRepository)(nil).CalculateShippingCost), origin, destination, weight, courier)
 }
 
+// Couriers mocks base method.
+func (m *MockShippingRepository) Couriers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Couriers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Couriers indicates an expected call of Couriers.
+func (mr *MockShippingRepositoryMockRecorder) Couriers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Couriers", reflect.TypeOf((*MockShippingRepository)(nil).Couriers))
+}
+
 // GetCities mocks base method.
-func (m *MockShippingRepository) GetCities(provinceID, cityID string) ([]response.RajaOngkirCity, error) {
+func (m *MockShippingRepository) GetCities(provinceID, cityID string) ([]entity.ShippingCity, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCities", provinceID, cityID)
-	ret0, _ := ret[0].([]response.RajaOngkirCity)
+	ret0, _ := ret[0].([]entity.ShippingCity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -65,10 +145,10 @@ func (mr *MockShippingRepositoryMockRecorder) GetCities(provinceID, cityID inter
 }
 
 // GetDistricts mocks base method.
-func (m *MockShippingRepository) GetDistricts(cityID string) ([]response.RajaOngkirDistrict, error) {
+func (m *MockShippingRepository) GetDistricts(cityID string) ([]entity.ShippingDistrict, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDistricts", cityID)
-	ret0, _ := ret[0].([]response.RajaOngkirDistrict)
+	ret0, _ := ret[0].([]entity.ShippingDistrict)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,10 +160,10 @@ func (mr *MockShippingRepositoryMockRecorder) GetDistricts(cityID interface{}) *
 }
 
 // GetProvinces mocks base method.
-func (m *MockShippingRepository) GetProvinces(provinceID string) ([]response.RajaOngkirProvince, error) {
+func (m *MockShippingRepository) GetProvinces(provinceID string) ([]entity.ShippingProvince, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProvinces", provinceID)
-	ret0, _ := ret[0].([]response.RajaOngkirProvince)
+	ret0, _ := ret[0].([]entity.ShippingProvince)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -94,11 +174,25 @@ func (mr *MockShippingRepositoryMockRecorder) GetProvinces(provinceID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvinces", reflect.TypeOf((*MockShippingRepository)(nil).GetProvinces), provinceID)
 }
 
+// Name mocks base method.
+func (m *MockShippingRepository) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockShippingRepositoryMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockShippingRepository)(nil).Name))
+}
+
 // ValidateAWB mocks base method.
-func (m *MockShippingRepository) ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*response.RajaOngkirTrackingResponse, error) {
+func (m *MockShippingRepository) ValidateAWB(awbNumber, courier string, lastPhoneNumber *string) (*entity.TrackingData, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateAWB", awbNumber, courier, lastPhoneNumber)
-	ret0, _ := ret[0].(*response.RajaOngkirTrackingResponse)
+	ret0, _ := ret[0].(*entity.TrackingData)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shipping_rule.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// MockShippingRuleRepository is a mock of ShippingRuleRepository interface.
+type MockShippingRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShippingRuleRepositoryMockRecorder
+}
+
+// MockShippingRuleRepositoryMockRecorder is the mock recorder for MockShippingRuleRepository.
+type MockShippingRuleRepositoryMockRecorder struct {
+	mock *MockShippingRuleRepository
+}
+
+// NewMockShippingRuleRepository creates a new mock instance.
+func NewMockShippingRuleRepository(ctrl *gomock.Controller) *MockShippingRuleRepository {
+	mock := &MockShippingRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockShippingRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShippingRuleRepository) EXPECT() *MockShippingRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindActiveShippingRules mocks base method.
+func (m *MockShippingRuleRepository) FindActiveShippingRules() ([]entity.ShippingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveShippingRules")
+	ret0, _ := ret[0].([]entity.ShippingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveShippingRules indicates an expected call of FindActiveShippingRules.
+func (mr *MockShippingRuleRepositoryMockRecorder) FindActiveShippingRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveShippingRules", reflect.TypeOf((*MockShippingRuleRepository)(nil).FindActiveShippingRules))
+}
@@ -0,0 +1,61 @@
+package shipping
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"text/template"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/static"
+)
+
+// notifyShipmentDispatched tells the customer their order was handed to the courier.
+// Failures are logged; the AWB is saved either way.
+func (s *ShippingService) notifyShipmentDispatched(order *entity.Order, tracking *entity.AWBTracking) {
+	if s.Mailer != nil {
+		if err := s.Mailer.SendShipmentDispatched(order, tracking); err != nil {
+			log.Printf("failed to send shipment dispatched email for order %s: %v", order.OrderNumber, err)
+		}
+	}
+	s.sendShipmentWhatsApp(order, tracking, static.WAShipmentDispatchedTemplate)
+}
+
+// notifyShipmentDelivered tells the customer the courier delivered their order
+func (s *ShippingService) notifyShipmentDelivered(order *entity.Order, tracking *entity.AWBTracking) {
+	if s.Mailer != nil {
+		if err := s.Mailer.SendShipmentDelivered(order, tracking); err != nil {
+			log.Printf("failed to send shipment delivered email for order %s: %v", order.OrderNumber, err)
+		}
+	}
+	s.sendShipmentWhatsApp(order, tracking, static.WAShipmentDeliveredTemplate)
+}
+
+func (s *ShippingService) sendShipmentWhatsApp(order *entity.Order, tracking *entity.AWBTracking, messageTemplate string) {
+	if s.WhatsAppRepo == nil || order.CustomerPhone == "" {
+		return
+	}
+
+	tmpl, err := template.New("whatsappMessage").Parse(messageTemplate)
+	if err != nil {
+		log.Printf("failed to parse WhatsApp message template: %v", err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, request.WhatsAppShipmentRequest{
+		CustomerName: order.CustomerName,
+		OrderNumber:  order.OrderNumber,
+		Courier:      strings.ToUpper(tracking.Courier),
+		AWBNumber:    tracking.AWBNumber,
+		TrackingLink: entity.TrackingLink(s.BaseURL, order.OrderNumber),
+	}); err != nil {
+		log.Printf("failed to execute WhatsApp message template: %v", err)
+		return
+	}
+
+	if err := s.WhatsAppRepo.SendMessage(entity.WhatsAppAddress(order.CustomerPhone), buf.String()); err != nil {
+		log.Printf("failed to send WhatsApp message for order %s: %v", order.OrderNumber, err)
+	}
+}
@@ -0,0 +1,210 @@
+package shipping
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// Shipment is the part of a cart that one warehouse ships
+type Shipment struct {
+	Warehouse entity.Warehouse
+	Items     []entity.CartItem
+}
+
+// WeightGrams returns the weight of the items in whole grams. Couriers need a positive
+// weight, so items without weights count as 1 gram.
+func WeightGrams(items []entity.CartItem) int {
+	grams := 0
+	for _, item := range items {
+		if item.ProductVariant == nil {
+			continue
+		}
+		grams += int(math.Ceil(item.ProductVariant.Weight)) * item.Quantity
+	}
+	if grams < 1 {
+		return 1
+	}
+	return grams
+}
+
+// OriginPlanner picks the warehouses a cart ships from. The cost endpoint and checkout
+// share it, so an order ships from the warehouses it was quoted from.
+type OriginPlanner struct {
+	repo repository.WarehouseRepository
+}
+
+// NewOriginPlanner creates a planner reading warehouses from the given repository
+func NewOriginPlanner(repo repository.WarehouseRepository) *OriginPlanner {
+	return &OriginPlanner{repo: repo}
+}
+
+// Plan splits the cart into shipments. The nearest warehouse holding every item ships the
+// whole cart; warehouses in the destination province count as nearest, then the lowest
+// priority number. When no warehouse holds everything, the warehouse holding the most of
+// the remaining items ships them, until every item is assigned, so the cart goes out in as
+// few parcels as possible.
+//
+// Plan returns nil when there are no warehouses, or the planner is nil, and the configured
+// origin should be used. It returns *repository.InsufficientStockError when no warehouse
+// holds enough of an item.
+func (p *OriginPlanner) Plan(cart *entity.Cart, provinceID string) ([]Shipment, error) {
+	if p == nil || p.repo == nil {
+		return nil, nil
+	}
+
+	warehouses, err := p.repo.FindActiveWarehouses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouses: %w", err)
+	}
+	if len(warehouses) == 0 {
+		return nil, nil
+	}
+
+	// Warehouses come by priority; the ones in the destination province move to the front
+	sort.SliceStable(warehouses, func(i, j int) bool {
+		return provinceID != "" && warehouses[i].ProvinceID == provinceID && warehouses[j].ProvinceID != provinceID
+	})
+
+	variantIDs := make([]string, 0, len(cart.CartItems))
+	for _, item := range cart.CartItems {
+		variantIDs = append(variantIDs, item.ProductVariantID)
+	}
+	stocks, err := p.repo.FindWarehouseStocks(variantIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse stock: %w", err)
+	}
+	held := make(map[string]map[string]int, len(warehouses))
+	for _, warehouse := range warehouses {
+		held[warehouse.ID] = map[string]int{}
+	}
+	for _, stock := range stocks {
+		if held[stock.WarehouseID] != nil {
+			held[stock.WarehouseID][stock.ProductVariantID] = stock.Quantity
+		}
+	}
+
+	var shipments []Shipment
+	remaining := cart.CartItems
+	for len(remaining) > 0 {
+		best := -1
+		var bestItems, bestLeft []entity.CartItem
+		for i, warehouse := range warehouses {
+			items, left := takeItems(held[warehouse.ID], remaining)
+			if len(items) > len(bestItems) {
+				best, bestItems, bestLeft = i, items, left
+			}
+		}
+
+		if best < 0 {
+			return nil, &repository.InsufficientStockError{SKUs: itemSKUs(remaining)}
+		}
+
+		warehouse := warehouses[best]
+		for _, item := range bestItems {
+			held[warehouse.ID][item.ProductVariantID] -= item.Quantity
+		}
+		shipments = addToShipment(shipments, warehouse, bestItems)
+		remaining = bestLeft
+	}
+
+	return shipments, nil
+}
+
+// takeItems splits the items into the ones the stock can cover, in order, and the rest
+func takeItems(stock map[string]int, items []entity.CartItem) (taken, left []entity.CartItem) {
+	available := make(map[string]int, len(stock))
+	for variantID, quantity := range stock {
+		available[variantID] = quantity
+	}
+
+	for _, item := range items {
+		if available[item.ProductVariantID] >= item.Quantity {
+			available[item.ProductVariantID] -= item.Quantity
+			taken = append(taken, item)
+		} else {
+			left = append(left, item)
+		}
+	}
+	return taken, left
+}
+
+func addToShipment(shipments []Shipment, warehouse entity.Warehouse, items []entity.CartItem) []Shipment {
+	for i := range shipments {
+		if shipments[i].Warehouse.ID == warehouse.ID {
+			shipments[i].Items = append(shipments[i].Items, items...)
+			return shipments
+		}
+	}
+	return append(shipments, Shipment{Warehouse: warehouse, Items: items})
+}
+
+func itemSKUs(items []entity.CartItem) []string {
+	skus := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ProductVariant != nil {
+			skus = append(skus, item.ProductVariant.SKU)
+		} else {
+			skus = append(skus, item.ProductVariantID)
+		}
+	}
+	return skus
+}
+
+// QuoteShipments quotes every shipment from its warehouse and adds up the quotes of each
+// courier service. Only services every shipment can use are returned, cheapest first; the
+// ETD is the one of the first shipment.
+func QuoteShipments(provider repository.ShippingRateProvider, shipments []Shipment, destination, courier string) ([]entity.ShippingQuote, error) {
+	type serviceKey struct{ provider, courier, service string }
+
+	var order []serviceKey
+	merged := map[serviceKey]*entity.ShippingQuote{}
+	counts := map[serviceKey]int{}
+	for i, shipment := range shipments {
+		quotes, err := provider.CalculateShippingCost(shipment.Warehouse.DistrictID, destination, WeightGrams(shipment.Items), courier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to quote shipment from %s: %w", shipment.Warehouse.Code, err)
+		}
+
+		for _, quote := range quotes {
+			key := serviceKey{quote.Provider, strings.ToLower(quote.Courier), strings.ToUpper(quote.Service)}
+			// Only services every earlier shipment had can still cover all of them, and a
+			// service quoted twice for one shipment counts once
+			if counts[key] != i {
+				continue
+			}
+			counts[key]++
+
+			leg := entity.ShipmentQuote{
+				WarehouseID:   shipment.Warehouse.ID,
+				WarehouseName: shipment.Warehouse.Name,
+				Origin:        shipment.Warehouse.DistrictID,
+				Cost:          quote.Cost,
+			}
+			if existing, ok := merged[key]; ok {
+				existing.Cost += quote.Cost
+				existing.Shipments = append(existing.Shipments, leg)
+				continue
+			}
+			first := quote
+			first.Shipments = []entity.ShipmentQuote{leg}
+			merged[key] = &first
+			order = append(order, key)
+		}
+	}
+
+	result := []entity.ShippingQuote{}
+	for _, key := range order {
+		if counts[key] == len(shipments) {
+			result = append(result, *merged[key])
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Cost < result[j].Cost
+	})
+	return result, nil
+}
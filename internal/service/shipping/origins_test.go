@@ -0,0 +1,183 @@
+package shipping
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function to create a cart of the given variants, one unit of 1000 g each
+func createTestWarehouseCart(variantIDs ...string) *entity.Cart {
+	cart := &entity.Cart{}
+	for _, variantID := range variantIDs {
+		cart.CartItems = append(cart.CartItems, entity.CartItem{
+			ID:               "item-" + variantID,
+			ProductVariantID: variantID,
+			Quantity:         1,
+			ProductVariant:   &entity.ProductVariant{ID: variantID, SKU: "SKU-" + variantID, Weight: 1000},
+		})
+	}
+	return cart
+}
+
+// Helper function to create warehouses in priority order
+func createTestWarehouses() []entity.Warehouse {
+	return []entity.Warehouse{
+		{ID: "wh-jkt", Code: "JKT", Name: "Jakarta", DistrictID: "152", ProvinceID: "6", Priority: 1},
+		{ID: "wh-sby", Code: "SBY", Name: "Surabaya", DistrictID: "444", ProvinceID: "11", Priority: 2},
+	}
+}
+
+func TestOriginPlanner_Plan(t *testing.T) {
+	t.Run("Success - Nearest warehouse holding everything ships the cart", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		planner := NewOriginPlanner(mockRepo)
+
+		mockRepo.EXPECT().FindActiveWarehouses().Return(createTestWarehouses(), nil)
+		mockRepo.EXPECT().FindWarehouseStocks([]string{"a", "b"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-jkt", ProductVariantID: "a", Quantity: 5},
+			{WarehouseID: "wh-jkt", ProductVariantID: "b", Quantity: 5},
+			{WarehouseID: "wh-sby", ProductVariantID: "a", Quantity: 5},
+			{WarehouseID: "wh-sby", ProductVariantID: "b", Quantity: 5},
+		}, nil)
+
+		shipments, err := planner.Plan(createTestWarehouseCart("a", "b"), "11")
+
+		assert.NoError(t, err)
+		assert.Len(t, shipments, 1)
+		assert.Equal(t, "wh-sby", shipments[0].Warehouse.ID)
+		assert.Len(t, shipments[0].Items, 2)
+	})
+
+	t.Run("Success - Cart no warehouse holds is split", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		planner := NewOriginPlanner(mockRepo)
+
+		mockRepo.EXPECT().FindActiveWarehouses().Return(createTestWarehouses(), nil)
+		mockRepo.EXPECT().FindWarehouseStocks([]string{"a", "b", "c"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-jkt", ProductVariantID: "a", Quantity: 1},
+			{WarehouseID: "wh-sby", ProductVariantID: "b", Quantity: 1},
+			{WarehouseID: "wh-sby", ProductVariantID: "c", Quantity: 1},
+		}, nil)
+
+		shipments, err := planner.Plan(createTestWarehouseCart("a", "b", "c"), "")
+
+		assert.NoError(t, err)
+		assert.Len(t, shipments, 2)
+		assert.Equal(t, "wh-sby", shipments[0].Warehouse.ID)
+		assert.Len(t, shipments[0].Items, 2)
+		assert.Equal(t, "wh-jkt", shipments[1].Warehouse.ID)
+		assert.Equal(t, "a", shipments[1].Items[0].ProductVariantID)
+	})
+
+	t.Run("Success - No warehouses leaves the origin to the caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		planner := NewOriginPlanner(mockRepo)
+
+		mockRepo.EXPECT().FindActiveWarehouses().Return(nil, nil)
+
+		shipments, err := planner.Plan(createTestWarehouseCart("a"), "")
+
+		assert.NoError(t, err)
+		assert.Nil(t, shipments)
+	})
+
+	t.Run("Error - No warehouse holds enough of an item", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		planner := NewOriginPlanner(mockRepo)
+
+		mockRepo.EXPECT().FindActiveWarehouses().Return(createTestWarehouses(), nil)
+		mockRepo.EXPECT().FindWarehouseStocks([]string{"a", "b"}).Return([]entity.WarehouseStock{
+			{WarehouseID: "wh-jkt", ProductVariantID: "a", Quantity: 1},
+		}, nil)
+
+		shipments, err := planner.Plan(createTestWarehouseCart("a", "b"), "")
+
+		assert.Nil(t, shipments)
+		var stockErr *repository.InsufficientStockError
+		assert.ErrorAs(t, err, &stockErr)
+		assert.Equal(t, []string{"SKU-b"}, stockErr.SKUs)
+	})
+
+	t.Run("Error - Warehouses cannot be read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repoMocks.NewMockWarehouseRepository(ctrl)
+		planner := NewOriginPlanner(mockRepo)
+
+		mockRepo.EXPECT().FindActiveWarehouses().Return(nil, errors.New("database error"))
+
+		shipments, err := planner.Plan(createTestWarehouseCart("a"), "")
+
+		assert.Nil(t, shipments)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get warehouses")
+	})
+}
+
+func TestQuoteShipments(t *testing.T) {
+	shipments := []Shipment{
+		{Warehouse: createTestWarehouses()[0], Items: createTestWarehouseCart("a").CartItems},
+		{Warehouse: createTestWarehouses()[1], Items: createTestWarehouseCart("b", "c").CartItems},
+	}
+
+	t.Run("Success - Services every shipment can use are summed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		provider := createTestRateProvider(ctrl, "rajaongkir", "jne")
+
+		provider.EXPECT().CalculateShippingCost("152", "114", 1000, "jne").Return([]entity.ShippingQuote{
+			{Provider: "rajaongkir", Courier: "jne", Service: "REG", Cost: 10000},
+			{Provider: "rajaongkir", Courier: "jne", Service: "YES", Cost: 20000},
+		}, nil)
+		provider.EXPECT().CalculateShippingCost("444", "114", 2000, "jne").Return([]entity.ShippingQuote{
+			{Provider: "rajaongkir", Courier: "jne", Service: "REG", Cost: 18000},
+		}, nil)
+
+		quotes, err := QuoteShipments(provider, shipments, "114", "jne")
+
+		assert.NoError(t, err)
+		assert.Len(t, quotes, 1)
+		assert.Equal(t, "REG", quotes[0].Service)
+		assert.Equal(t, 28000, quotes[0].Cost)
+		assert.Len(t, quotes[0].Shipments, 2)
+		assert.Equal(t, "wh-jkt", quotes[0].Shipments[0].WarehouseID)
+		assert.Equal(t, 10000, quotes[0].Shipments[0].Cost)
+		assert.Equal(t, "wh-sby", quotes[0].Shipments[1].WarehouseID)
+		assert.Equal(t, 18000, quotes[0].Shipments[1].Cost)
+	})
+
+	t.Run("Error - A shipment cannot be quoted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		provider := createTestRateProvider(ctrl, "rajaongkir", "jne")
+
+		provider.EXPECT().CalculateShippingCost("152", "114", 1000, "jne").Return(nil, errors.New("quota exceeded"))
+
+		quotes, err := QuoteShipments(provider, shipments, "114", "jne")
+
+		assert.Nil(t, quotes)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to quote shipment from JKT")
+	})
+}
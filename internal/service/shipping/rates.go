@@ -0,0 +1,132 @@
+package shipping
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// RateAggregatorName identifies merged quotes in logs
+const RateAggregatorName = "aggregate"
+
+// RateAggregator asks several shipping providers for quotes at once and merges them,
+// cheapest first. It is itself a rate provider, so callers need not know how many
+// providers there are.
+type RateAggregator struct {
+	providers []repository.ShippingRateProvider
+}
+
+// NewRateAggregator creates an aggregator over the given providers. Quotes of equal
+// cost keep the order of the providers.
+func NewRateAggregator(providers ...repository.ShippingRateProvider) *RateAggregator {
+	return &RateAggregator{providers: providers}
+}
+
+func (a *RateAggregator) Name() string {
+	return RateAggregatorName
+}
+
+// Couriers returns every courier at least one provider can quote
+func (a *RateAggregator) Couriers() []string {
+	seen := map[string]bool{}
+	var couriers []string
+	for _, provider := range a.providers {
+		for _, courier := range provider.Couriers() {
+			if !seen[courier] {
+				seen[courier] = true
+				couriers = append(couriers, courier)
+			}
+		}
+	}
+	return couriers
+}
+
+// CalculateShippingCost asks each provider for the requested couriers it serves. A
+// provider that fails is left out as long as another one answers.
+func (a *RateAggregator) CalculateShippingCost(origin, destination string, weight int, courier string) ([]entity.ShippingQuote, error) {
+	type providerQuotes struct {
+		quotes []entity.ShippingQuote
+		err    error
+	}
+
+	requested := splitCouriers(courier)
+	results := make([]*providerQuotes, len(a.providers))
+	var wg sync.WaitGroup
+	for i, provider := range a.providers {
+		couriers := servedCouriers(provider, requested)
+		if len(couriers) == 0 {
+			continue
+		}
+
+		result := &providerQuotes{}
+		results[i] = result
+		wg.Add(1)
+		go func(provider repository.ShippingRateProvider, couriers string) {
+			defer wg.Done()
+			result.quotes, result.err = provider.CalculateShippingCost(origin, destination, weight, couriers)
+		}(provider, couriers)
+	}
+	wg.Wait()
+
+	quotes := []entity.ShippingQuote{}
+	var errs []error
+	asked := 0
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		asked++
+		if result.err != nil {
+			log.Printf("shipping provider %s failed to quote %s: %v", a.providers[i].Name(), courier, result.err)
+			errs = append(errs, fmt.Errorf("%s: %w", a.providers[i].Name(), result.err))
+			continue
+		}
+		quotes = append(quotes, result.quotes...)
+	}
+
+	if asked == 0 {
+		return nil, fmt.Errorf("no shipping provider serves courier %q", courier)
+	}
+	if len(errs) == asked {
+		return nil, errors.Join(errs...)
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].Cost < quotes[j].Cost
+	})
+	return quotes, nil
+}
+
+// splitCouriers reads a courier code or several joined by ':'
+func splitCouriers(courier string) []string {
+	var couriers []string
+	for _, code := range strings.Split(courier, ":") {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if code != "" {
+			couriers = append(couriers, code)
+		}
+	}
+	return couriers
+}
+
+// servedCouriers joins the requested couriers the provider serves with ':'
+func servedCouriers(provider repository.ShippingRateProvider, requested []string) string {
+	served := map[string]bool{}
+	for _, courier := range provider.Couriers() {
+		served[courier] = true
+	}
+
+	var couriers []string
+	for _, courier := range requested {
+		if served[courier] {
+			couriers = append(couriers, courier)
+		}
+	}
+	return strings.Join(couriers, ":")
+}
@@ -0,0 +1,108 @@
+package shipping
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function to create a rate provider serving the given couriers
+func createTestRateProvider(ctrl *gomock.Controller, name string, couriers ...string) *repoMocks.MockShippingRateProvider {
+	provider := repoMocks.NewMockShippingRateProvider(ctrl)
+	provider.EXPECT().Name().Return(name).AnyTimes()
+	provider.EXPECT().Couriers().Return(couriers).AnyTimes()
+	return provider
+}
+
+func TestRateAggregator_CalculateShippingCost(t *testing.T) {
+	t.Run("Success - Quotes of every provider are merged cheapest first", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rajaOngkir := createTestRateProvider(ctrl, "rajaongkir", "jne", "pos")
+		rateTable := createTestRateProvider(ctrl, "ratetable", "jne", "lokal")
+		aggregator := NewRateAggregator(rajaOngkir, rateTable)
+
+		rajaOngkir.EXPECT().CalculateShippingCost("501", "114", 1000, "jne").Return([]entity.ShippingQuote{
+			{Provider: "rajaongkir", Courier: "jne", Service: "REG", Cost: 15000},
+			{Provider: "rajaongkir", Courier: "jne", Service: "OKE", Cost: 12000},
+		}, nil)
+		rateTable.EXPECT().CalculateShippingCost("501", "114", 1000, "jne:lokal").Return([]entity.ShippingQuote{
+			{Provider: "ratetable", Courier: "lokal", Service: "SAMEDAY", Cost: 12000},
+		}, nil)
+
+		// Act
+		quotes, err := aggregator.CalculateShippingCost("501", "114", 1000, "JNE:lokal")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, quotes, 3)
+		assert.Equal(t, "OKE", quotes[0].Service)
+		assert.Equal(t, "SAMEDAY", quotes[1].Service)
+		assert.Equal(t, "REG", quotes[2].Service)
+	})
+
+	t.Run("Success - Failing provider is left out", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rajaOngkir := createTestRateProvider(ctrl, "rajaongkir", "jne")
+		rateTable := createTestRateProvider(ctrl, "ratetable", "jne")
+		aggregator := NewRateAggregator(rajaOngkir, rateTable)
+
+		rajaOngkir.EXPECT().CalculateShippingCost(gomock.Any(), gomock.Any(), gomock.Any(), "jne").
+			Return(nil, errors.New("quota exceeded"))
+		rateTable.EXPECT().CalculateShippingCost(gomock.Any(), gomock.Any(), gomock.Any(), "jne").
+			Return([]entity.ShippingQuote{{Provider: "ratetable", Courier: "jne", Service: "FLAT", Cost: 25000}}, nil)
+
+		// Act
+		quotes, err := aggregator.CalculateShippingCost("501", "114", 1000, "jne")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, quotes, 1)
+		assert.Equal(t, "ratetable", quotes[0].Provider)
+	})
+
+	t.Run("Error - Every provider fails", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rajaOngkir := createTestRateProvider(ctrl, "rajaongkir", "jne")
+		aggregator := NewRateAggregator(rajaOngkir)
+
+		rajaOngkir.EXPECT().CalculateShippingCost(gomock.Any(), gomock.Any(), gomock.Any(), "jne").
+			Return(nil, errors.New("quota exceeded"))
+
+		// Act
+		quotes, err := aggregator.CalculateShippingCost("501", "114", 1000, "jne")
+
+		// Assert
+		assert.Nil(t, quotes)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rajaongkir: quota exceeded")
+	})
+
+	t.Run("Error - No provider serves the courier", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		aggregator := NewRateAggregator(createTestRateProvider(ctrl, "rajaongkir", "jne"))
+
+		// Act
+		quotes, err := aggregator.CalculateShippingCost("501", "114", 1000, "gojek")
+
+		// Assert
+		assert.Nil(t, quotes)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no shipping provider serves courier")
+	})
+}
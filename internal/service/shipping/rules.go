@@ -0,0 +1,33 @@
+package shipping
+
+import (
+	"fmt"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// RuleEvaluator adjusts courier quotes with the active shipping rules. The cost endpoint
+// and checkout share it, so the customer pays the cost they were quoted.
+type RuleEvaluator struct {
+	repo repository.ShippingRuleRepository
+}
+
+// NewRuleEvaluator creates an evaluator reading its rules from the given repository
+func NewRuleEvaluator(repo repository.ShippingRuleRepository) *RuleEvaluator {
+	return &RuleEvaluator{repo: repo}
+}
+
+// Apply adjusts the quotes for the parcel, cheapest first. Rules are read on every call
+// so changes apply at once. A nil evaluator leaves the costs as quoted.
+func (e *RuleEvaluator) Apply(parcel entity.ShippingParcel, quotes []entity.ShippingQuote) ([]entity.ShippingQuote, error) {
+	var rules []entity.ShippingRule
+	if e != nil && e.repo != nil {
+		var err error
+		rules, err = e.repo.FindActiveShippingRules()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shipping rules: %w", err)
+		}
+	}
+	return entity.ApplyShippingRules(rules, parcel, quotes), nil
+}
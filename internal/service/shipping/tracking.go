@@ -0,0 +1,264 @@
+package shipping
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+const (
+	// refreshBatchSize caps how many shipments one refresh run checks
+	refreshBatchSize = 50
+	// maxRefreshBackoff caps the wait after repeated failed refreshes
+	maxRefreshBackoff = 24 * time.Hour
+	// maxTrackingAge stops refreshing shipments that never report delivery
+	maxTrackingAge = 30 * 24 * time.Hour
+	// trackingChangedBy is recorded as the author of order status changes AWB tracking makes
+	trackingChangedBy = "awb-tracking"
+)
+
+func (s *ShippingService) TrackShipment(req request.TrackShipmentRequest) (*response.ShipmentTrackingResponse, error) {
+	order, err := s.PaymentRepo.FindOrderByNumber(strings.TrimSpace(req.OrderNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order == nil || !ownsOrder(order, req.Email, req.Phone) {
+		// A wrong email or phone is reported exactly like an unknown order number
+		return nil, service.ErrOrderNotFound
+	}
+
+	orderID, err := uuid.Parse(order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID format: %w", err)
+	}
+	trackings, err := s.AWBTrackingRepo.GetAWBTrackingByOrderID(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipments: %w", err)
+	}
+
+	shipments := make([]response.ShipmentResponse, 0, len(trackings))
+	for _, tracking := range trackings {
+		if s.trackingIsStale(tracking) {
+			// The stored tracking is still shown when the courier cannot be reached
+			if err := s.refreshAWBTracking(tracking); err != nil {
+				log.Printf("failed to refresh AWB %s (%s) for order %s: %v", tracking.AWBNumber, tracking.Courier, order.OrderNumber, err)
+			}
+		}
+		shipments = append(shipments, toShipmentResponse(tracking))
+	}
+
+	return &response.ShipmentTrackingResponse{
+		OrderNumber: order.OrderNumber,
+		OrderStatus: order.OrderStatus,
+		Shipments:   shipments,
+	}, nil
+}
+
+// trackingIsStale reports whether an undelivered shipment was last read from the courier
+// longer ago than the tracking max age
+func (s *ShippingService) trackingIsStale(tracking *entity.AWBTracking) bool {
+	if tracking.DeliveredAt != nil {
+		return false
+	}
+	checkedAt := tracking.UpdatedAt
+	if tracking.LastCheckedAt != nil {
+		checkedAt = *tracking.LastCheckedAt
+	}
+	return time.Since(checkedAt) > s.TrackingMaxAge
+}
+
+func toShipmentResponse(tracking *entity.AWBTracking) response.ShipmentResponse {
+	shipment := response.ShipmentResponse{
+		Courier:       tracking.Courier,
+		AWBNumber:     tracking.AWBNumber,
+		Manifest:      []entity.Manifest{},
+		LastCheckedAt: tracking.LastCheckedAt,
+	}
+	if tracking.TrackingData != nil {
+		shipment.Delivered = tracking.TrackingData.Delivered
+		shipment.DeliveryStatus = tracking.TrackingData.DeliveryStatus
+		if tracking.TrackingData.Manifest != nil {
+			shipment.Manifest = tracking.TrackingData.Manifest
+		}
+	}
+	return shipment
+}
+
+// ownsOrder reports whether the email or the full phone number matches the order's. Order
+// numbers are sequential, so a part of the phone number is too easy to guess.
+func ownsOrder(order *entity.Order, email, phone string) bool {
+	if email != "" && strings.EqualFold(strings.TrimSpace(email), strings.TrimSpace(order.CustomerEmail)) {
+		return true
+	}
+	return phone != "" && entity.SamePhoneNumber(phone, order.CustomerPhone)
+}
+
+func (s *ShippingService) RefreshAWBTrackings(ctx context.Context) (int, error) {
+	now := time.Now()
+	trackings, err := s.AWBTrackingRepo.FindAWBTrackingsDueForRefresh(now, now.Add(-maxTrackingAge), refreshBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find shipments to refresh: %w", err)
+	}
+
+	refreshed := 0
+	for _, tracking := range trackings {
+		if err := ctx.Err(); err != nil {
+			return refreshed, err
+		}
+
+		if err := s.refreshAWBTracking(tracking); err != nil {
+			log.Printf("failed to refresh AWB %s (%s) for order %s: %v", tracking.AWBNumber, tracking.Courier, tracking.OrderID, err)
+			continue
+		}
+		refreshed++
+	}
+
+	if refreshed > 0 {
+		log.Printf("refreshed tracking of %d shipments", refreshed)
+	}
+
+	return refreshed, nil
+}
+
+// refreshAWBTracking re-reads one shipment's tracking data. A failed check is saved
+// with the next check pushed back, doubling the wait after every failure in a row.
+func (s *ShippingService) refreshAWBTracking(tracking *entity.AWBTracking) error {
+	checkErr := s.checkAWBTracking(tracking)
+
+	now := time.Now()
+	tracking.LastCheckedAt = &now
+	if checkErr != nil {
+		tracking.CheckFailures++
+	} else {
+		tracking.CheckFailures = 0
+	}
+	next := now.Add(refreshBackoff(s.RefreshInterval, tracking.CheckFailures))
+	tracking.NextCheckAt = &next
+	tracking.UpdatedAt = now
+
+	if err := s.AWBTrackingRepo.UpdateAWBTracking(tracking); err != nil {
+		return fmt.Errorf("failed to save tracking: %w", err)
+	}
+	return checkErr
+}
+
+func (s *ShippingService) checkAWBTracking(tracking *entity.AWBTracking) error {
+	trackingData, err := s.ShippingRepo.ValidateAWB(tracking.AWBNumber, tracking.Courier, tracking.LastPhoneNumber)
+	if err != nil {
+		return fmt.Errorf("failed to track AWB: %w", err)
+	}
+	return s.applyTrackingData(tracking, trackingData)
+}
+
+// applyTrackingData stores fresh tracking data on the shipment. The first time the data
+// reports delivery DeliveredAt is set, and once every shipment of the order is delivered
+// the order is marked delivered.
+func (s *ShippingService) applyTrackingData(tracking *entity.AWBTracking, trackingData *entity.TrackingData) error {
+	tracking.TrackingData = trackingData
+	if !trackingData.Delivered || tracking.DeliveredAt != nil {
+		return nil
+	}
+
+	deliveredAt := time.Now()
+	tracking.DeliveredAt = &deliveredAt
+	if err := s.markOrderDelivered(tracking); err != nil {
+		// DeliveredAt stays empty so the next refresh tries again
+		tracking.DeliveredAt = nil
+		return err
+	}
+	return nil
+}
+
+// markOrderDelivered moves the shipment's order to delivered and tells the customer, once
+// no other shipment of the order is still on its way. A processing order is moved through
+// shipped first. Orders that are already delivered, cancelled or refunded are left as they are.
+func (s *ShippingService) markOrderDelivered(tracking *entity.AWBTracking) error {
+	delivered, err := s.allShipmentsDelivered(tracking)
+	if err != nil {
+		return err
+	}
+	if !delivered {
+		return nil
+	}
+
+	order, err := s.PaymentRepo.FindOrderByID(tracking.OrderID.String())
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.OrderStatus != entity.OrderStatusProcessing && order.OrderStatus != entity.OrderStatusShipped {
+		return nil
+	}
+
+	if order.OrderStatus == entity.OrderStatusProcessing {
+		note := fmt.Sprintf("Shipped according to %s AWB %s", tracking.Courier, tracking.AWBNumber)
+		if err := s.changeOrderStatus(order, entity.OrderStatusShipped, note); err != nil {
+			return err
+		}
+	}
+	note := fmt.Sprintf("Delivered according to %s AWB %s", tracking.Courier, tracking.AWBNumber)
+	if err := s.changeOrderStatus(order, entity.OrderStatusDelivered, note); err != nil {
+		return err
+	}
+
+	s.notifyShipmentDelivered(order, tracking)
+	return nil
+}
+
+// allShipmentsDelivered reports whether every other shipment of the tracking's order
+// is delivered too
+func (s *ShippingService) allShipmentsDelivered(tracking *entity.AWBTracking) (bool, error) {
+	trackings, err := s.AWBTrackingRepo.GetAWBTrackingByOrderID(tracking.OrderID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get shipments of the order: %w", err)
+	}
+	for _, other := range trackings {
+		if other.ID != tracking.ID && other.DeliveredAt == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// changeOrderStatus moves the order to the status and records the change
+func (s *ShippingService) changeOrderStatus(order *entity.Order, status, note string) error {
+	updated, err := s.PaymentRepo.UpdateOrderStatus(&entity.OrderStatusChange{
+		ID:         uuid.New().String(),
+		OrderID:    order.ID,
+		FromStatus: order.OrderStatus,
+		ToStatus:   status,
+		Note:       note,
+		ChangedBy:  trackingChangedBy,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to move order to %s: %w", status, err)
+	}
+	if !updated {
+		// The status changed since it was read; the next refresh looks again
+		return fmt.Errorf("order %s changed status while being moved to %s", order.OrderNumber, status)
+	}
+
+	order.OrderStatus = status
+	return nil
+}
+
+// refreshBackoff is the wait before the next check: the refresh interval, doubled for
+// every failure in a row, at most maxRefreshBackoff
+func refreshBackoff(interval time.Duration, failures int) time.Duration {
+	wait := interval
+	for i := 0; i < failures && wait < maxRefreshBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxRefreshBackoff {
+		wait = maxRefreshBackoff
+	}
+	return wait
+}
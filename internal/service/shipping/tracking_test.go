@@ -0,0 +1,477 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/service"
+	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper function to create a shipping service that refreshes tracking every hour
+func createTestTrackingService(ctrl *gomock.Controller) (*ShippingService, *repoMocks.MockShippingRepository, *repoMocks.MockAWBTrackingRepository, *repoMocks.MockPaymentRepository) {
+	shippingRepo := repoMocks.NewMockShippingRepository(ctrl)
+	awbTrackingRepo := repoMocks.NewMockAWBTrackingRepository(ctrl)
+	paymentRepo := repoMocks.NewMockPaymentRepository(ctrl)
+
+	svc := &ShippingService{
+		ShippingRepo:    shippingRepo,
+		AWBTrackingRepo: awbTrackingRepo,
+		PaymentRepo:     paymentRepo,
+		RefreshInterval: time.Hour,
+		TrackingMaxAge:  15 * time.Minute,
+	}
+	return svc, shippingRepo, awbTrackingRepo, paymentRepo
+}
+
+// Helper function to create a test AWB tracking record
+func createTestAWBTracking(orderID uuid.UUID) *entity.AWBTracking {
+	return &entity.AWBTracking{
+		ID:          uuid.New(),
+		OrderID:     orderID,
+		AWBNumber:   "JNE123",
+		Courier:     "jne",
+		IsValidated: true,
+	}
+}
+
+// Helper function to create tracking data as the shipping provider returns it
+func createTestTrackingResponse(delivered bool, status string) *entity.TrackingData {
+	return &entity.TrackingData{
+		Delivered:      delivered,
+		DeliveryStatus: entity.DeliveryStatus{Status: status},
+	}
+}
+
+func TestShippingService_RefreshAWBTrackings(t *testing.T) {
+	t.Run("Success - Shipment in transit gets fresh tracking data", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+
+		tracking := createTestAWBTracking(uuid.New())
+		tracking.CheckFailures = 3
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), refreshBatchSize).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB("JNE123", "jne", nil).Return(createTestTrackingResponse(false, "ON PROCESS"), nil)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.Equal(t, "ON PROCESS", tracking.TrackingData.DeliveryStatus.Status)
+		assert.Zero(t, tracking.CheckFailures)
+		assert.Nil(t, tracking.DeliveredAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *tracking.NextCheckAt, time.Minute)
+	})
+
+	t.Run("Success - Delivered shipment moves its order to delivered", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+		mailer := repoMocks.NewMockMailer(ctrl)
+		whatsApp := repoMocks.NewMockWhatsApp(ctrl)
+		svc.Mailer = mailer
+		svc.WhatsAppRepo = whatsApp
+		svc.BaseURL = "https://shop.example.com"
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		order := &entity.Order{
+			ID:            orderID.String(),
+			OrderNumber:   "IQB-2025-00001",
+			OrderStatus:   entity.OrderStatusShipped,
+			CustomerName:  "John",
+			CustomerPhone: "0812-3456-7890",
+		}
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(true, "DELIVERED"), nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking}, nil)
+		paymentRepo.EXPECT().FindOrderByID(orderID.String()).Return(order, nil)
+
+		var change *entity.OrderStatusChange
+		paymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).
+			DoAndReturn(func(c *entity.OrderStatusChange) (bool, error) {
+				change = c
+				return true, nil
+			})
+		mailer.EXPECT().SendShipmentDelivered(order, tracking).Return(nil)
+
+		var message string
+		whatsApp.EXPECT().SendMessage("+6281234567890@s.whatsapp.net", gomock.Any()).
+			DoAndReturn(func(_, text string) error {
+				message = text
+				return nil
+			})
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.NotNil(t, tracking.DeliveredAt)
+		assert.Equal(t, entity.OrderStatusShipped, change.FromStatus)
+		assert.Equal(t, entity.OrderStatusDelivered, change.ToStatus)
+		assert.Equal(t, trackingChangedBy, change.ChangedBy)
+		assert.Contains(t, message, "#IQB-2025-00001 telah sampai")
+		assert.Contains(t, message, "https://shop.example.com/track-order/IQB-2025-00001")
+	})
+
+	t.Run("Success - Delivered shipment of a split order waits for the other shipments", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		other := createTestAWBTracking(orderID)
+		other.AWBNumber = "JNE456"
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(true, "DELIVERED"), nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking, other}, nil)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.NotNil(t, tracking.DeliveredAt)
+	})
+
+	t.Run("Success - Last delivered shipment of a split order moves the order through shipped", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		other := createTestAWBTracking(orderID)
+		deliveredAt := time.Now().Add(-time.Hour)
+		other.DeliveredAt = &deliveredAt
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(true, "DELIVERED"), nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking, other}, nil)
+		paymentRepo.EXPECT().FindOrderByID(orderID.String()).
+			Return(&entity.Order{ID: orderID.String(), OrderStatus: entity.OrderStatusProcessing}, nil)
+
+		var changes []*entity.OrderStatusChange
+		paymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).
+			DoAndReturn(func(c *entity.OrderStatusChange) (bool, error) {
+				changes = append(changes, c)
+				return true, nil
+			}).Times(2)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.NotNil(t, tracking.DeliveredAt)
+		require.Len(t, changes, 2)
+		assert.Equal(t, entity.OrderStatusProcessing, changes[0].FromStatus)
+		assert.Equal(t, entity.OrderStatusShipped, changes[0].ToStatus)
+		assert.Equal(t, entity.OrderStatusShipped, changes[1].FromStatus)
+		assert.Equal(t, entity.OrderStatusDelivered, changes[1].ToStatus)
+	})
+
+	t.Run("Success - Delivered shipment of a refunded order leaves the order alone", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(true, "DELIVERED"), nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking}, nil)
+		paymentRepo.EXPECT().FindOrderByID(orderID.String()).
+			Return(&entity.Order{ID: orderID.String(), OrderStatus: entity.OrderStatusRefunded}, nil)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.NotNil(t, tracking.DeliveredAt)
+	})
+
+	t.Run("Error - Courier failure backs off the next check", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+
+		tracking := createTestAWBTracking(uuid.New())
+		tracking.CheckFailures = 1
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, refreshed)
+		assert.Equal(t, 2, tracking.CheckFailures)
+		assert.WithinDuration(t, time.Now().Add(4*time.Hour), *tracking.NextCheckAt, time.Minute)
+	})
+
+	t.Run("Error - Order update failure keeps refreshing the shipment", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(createTestTrackingResponse(true, "DELIVERED"), nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking}, nil)
+		paymentRepo.EXPECT().FindOrderByID(orderID.String()).
+			Return(&entity.Order{ID: orderID.String(), OrderStatus: entity.OrderStatusShipped}, nil)
+		paymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).Return(false, nil)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, refreshed)
+		assert.Nil(t, tracking.DeliveredAt)
+		assert.Equal(t, 1, tracking.CheckFailures)
+	})
+
+	t.Run("Error - Stops when the context is cancelled", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, awbTrackingRepo, _ := createTestTrackingService(ctrl)
+
+		awbTrackingRepo.EXPECT().FindAWBTrackingsDueForRefresh(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*entity.AWBTracking{createTestAWBTracking(uuid.New())}, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		refreshed, err := svc.RefreshAWBTrackings(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, refreshed)
+	})
+}
+
+// Helper function to create an order placed by a test customer
+func createTestTrackedOrder() *entity.Order {
+	return &entity.Order{
+		ID:            uuid.New().String(),
+		OrderNumber:   "IQB-2025-00001",
+		OrderStatus:   entity.OrderStatusShipped,
+		CustomerEmail: "john@example.com",
+		CustomerPhone: "+62 812-3456-7890",
+	}
+}
+
+func TestShippingService_TrackShipment(t *testing.T) {
+	t.Run("Success - Recently checked shipment is served from the database", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		order := createTestTrackedOrder()
+		tracking := createTestAWBTracking(uuid.MustParse(order.ID))
+		checkedAt := time.Now().Add(-5 * time.Minute)
+		tracking.LastCheckedAt = &checkedAt
+		tracking.TrackingData = &entity.TrackingData{
+			DeliveryStatus: entity.DeliveryStatus{Status: "ON PROCESS"},
+			Manifest:       []entity.Manifest{{ManifestDescription: "Picked up", CityName: "Jakarta"}},
+		}
+
+		paymentRepo.EXPECT().FindOrderByNumber("IQB-2025-00001").Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(uuid.MustParse(order.ID)).Return([]*entity.AWBTracking{tracking}, nil)
+
+		// Act
+		result, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: " IQB-2025-00001 ", Email: "John@Example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entity.OrderStatusShipped, result.OrderStatus)
+		assert.Len(t, result.Shipments, 1)
+		assert.Equal(t, "JNE123", result.Shipments[0].AWBNumber)
+		assert.Equal(t, "ON PROCESS", result.Shipments[0].DeliveryStatus.Status)
+		assert.Equal(t, "Picked up", result.Shipments[0].Manifest[0].ManifestDescription)
+	})
+
+	t.Run("Success - Stale shipment is refreshed from the courier", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		order := createTestTrackedOrder()
+		tracking := createTestAWBTracking(uuid.MustParse(order.ID))
+		tracking.UpdatedAt = time.Now().Add(-time.Hour)
+
+		paymentRepo.EXPECT().FindOrderByNumber(gomock.Any()).Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(gomock.Any()).Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB("JNE123", "jne", nil).Return(createTestTrackingResponse(false, "ON TRANSIT"), nil)
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		result, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Phone: "0812 3456 7890"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "ON TRANSIT", result.Shipments[0].DeliveryStatus.Status)
+		assert.NotNil(t, result.Shipments[0].LastCheckedAt)
+		assert.Empty(t, result.Shipments[0].Manifest)
+	})
+
+	t.Run("Success - Courier failure still returns the stored tracking", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, shippingRepo, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		order := createTestTrackedOrder()
+		tracking := createTestAWBTracking(uuid.MustParse(order.ID))
+		tracking.TrackingData = &entity.TrackingData{DeliveryStatus: entity.DeliveryStatus{Status: "ON PROCESS"}}
+
+		paymentRepo.EXPECT().FindOrderByNumber(gomock.Any()).Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(gomock.Any()).Return([]*entity.AWBTracking{tracking}, nil)
+		shippingRepo.EXPECT().ValidateAWB(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		result, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Email: "john@example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "ON PROCESS", result.Shipments[0].DeliveryStatus.Status)
+		assert.Equal(t, 1, tracking.CheckFailures)
+	})
+
+	t.Run("Success - Delivered shipment is not refreshed", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+
+		order := createTestTrackedOrder()
+		tracking := createTestAWBTracking(uuid.MustParse(order.ID))
+		deliveredAt := time.Now().Add(-48 * time.Hour)
+		tracking.DeliveredAt = &deliveredAt
+		tracking.TrackingData = &entity.TrackingData{Delivered: true}
+
+		paymentRepo.EXPECT().FindOrderByNumber(gomock.Any()).Return(order, nil)
+		awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(gomock.Any()).Return([]*entity.AWBTracking{tracking}, nil)
+
+		// Act
+		result, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Email: "john@example.com"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, result.Shipments[0].Delivered)
+	})
+
+	t.Run("Error - Wrong phone number is reported as an unknown order", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _, paymentRepo := createTestTrackingService(ctrl)
+
+		paymentRepo.EXPECT().FindOrderByNumber(gomock.Any()).Return(createTestTrackedOrder(), nil)
+
+		// Act
+		result, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Phone: "081234561234"})
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Error - End of the phone number is not enough", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _, paymentRepo := createTestTrackingService(ctrl)
+
+		paymentRepo.EXPECT().FindOrderByNumber(gomock.Any()).Return(createTestTrackedOrder(), nil)
+
+		// Act
+		_, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-00001", Phone: "34567890"})
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	})
+
+	t.Run("Error - Unknown order number", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _, paymentRepo := createTestTrackingService(ctrl)
+
+		paymentRepo.EXPECT().FindOrderByNumber("IQB-2025-99999").Return(nil, nil)
+
+		// Act
+		_, err := svc.TrackShipment(request.TrackShipmentRequest{OrderNumber: "IQB-2025-99999", Email: "john@example.com"})
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	})
+}
+
+func TestRefreshBackoff(t *testing.T) {
+	assert.Equal(t, time.Hour, refreshBackoff(time.Hour, 0))
+	assert.Equal(t, 8*time.Hour, refreshBackoff(time.Hour, 3))
+	assert.Equal(t, maxRefreshBackoff, refreshBackoff(time.Hour, 10))
+}
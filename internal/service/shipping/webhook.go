@@ -0,0 +1,172 @@
+package shipping
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+)
+
+// webhookTolerance is how far a webhook timestamp may be from now. Older calls are
+// treated as replays even when their signature is valid.
+const webhookTolerance = 5 * time.Minute
+
+// WebhookParser reads the tracking update out of a provider's webhook payload
+type WebhookParser interface {
+	Parse(payload []byte) (*WebhookTrackingUpdate, error)
+}
+
+// WebhookTrackingUpdate is the tracking of one AWB as pushed by a provider
+type WebhookTrackingUpdate struct {
+	AWBNumber    string
+	Courier      string // Lowercase courier code, as saved on the AWB
+	TrackingData entity.TrackingData
+}
+
+// WebhookProvider holds the secret a provider signs its calls with and the parser for its payload
+type WebhookProvider struct {
+	Secret string
+	Parser WebhookParser
+}
+
+func (s *ShippingService) HandleTrackingWebhook(req request.ShippingWebhookRequest) error {
+	provider, ok := s.WebhookProviders[req.Provider]
+	if !ok {
+		return service.ErrUnknownWebhookProvider
+	}
+	if !validWebhookSignature(provider.Secret, req) {
+		return service.ErrInvalidWebhookSignature
+	}
+
+	now := time.Now()
+	if err := checkWebhookTimestamp(req.Timestamp, now); err != nil {
+		return err
+	}
+
+	update, err := provider.Parser.Parse(req.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %v", service.ErrInvalidWebhookPayload, err)
+	}
+
+	tracking, err := s.AWBTrackingRepo.GetAWBTrackingByAWBNumber(update.AWBNumber, update.Courier)
+	if err != nil {
+		return fmt.Errorf("failed to get AWB tracking: %w", err)
+	}
+	if tracking == nil {
+		return service.ErrAWBTrackingNotFound
+	}
+
+	// The event is claimed before anything is applied, so a replay racing the
+	// original call can neither mark the order delivered nor message the customer
+	event := &entity.ShippingWebhookEvent{
+		ID:         uuid.New().String(),
+		Provider:   req.Provider,
+		EventID:    strings.ToLower(req.Signature),
+		AWBNumber:  tracking.AWBNumber,
+		Courier:    tracking.Courier,
+		ReceivedAt: now,
+	}
+	if err := s.AWBTrackingRepo.ClaimWebhookEvent(event); err != nil {
+		if errors.Is(err, repository.ErrWebhookEventSeen) {
+			return service.ErrWebhookReplayed
+		}
+		return fmt.Errorf("failed to claim webhook event: %w", err)
+	}
+
+	// Pushes can arrive out of order; one older than the stored data is dropped
+	if tracking.TrackingData != nil && latestManifestAt(&update.TrackingData).Before(latestManifestAt(tracking.TrackingData)) {
+		log.Printf("ignored pushed tracking of AWB %s (%s) older than the stored tracking", tracking.AWBNumber, tracking.Courier)
+		return nil
+	}
+
+	// The pushed data is saved even when the order cannot be marked delivered yet;
+	// DeliveredAt stays empty so the background refresh tries again
+	if err := s.applyTrackingData(tracking, &update.TrackingData); err != nil {
+		log.Printf("failed to apply pushed tracking of AWB %s (%s): %v", tracking.AWBNumber, tracking.Courier, err)
+	}
+
+	// Fresh data was just pushed, so the background refresh can wait a full interval
+	next := now.Add(s.RefreshInterval)
+	tracking.LastCheckedAt = &now
+	tracking.NextCheckAt = &next
+	tracking.CheckFailures = 0
+	tracking.UpdatedAt = now
+
+	if err := s.AWBTrackingRepo.UpdateAWBTracking(tracking); err != nil {
+		// Without the claim the provider's retry is applied instead of rejected as a replay
+		if releaseErr := s.AWBTrackingRepo.ReleaseWebhookEvent(event.ID); releaseErr != nil {
+			log.Printf("failed to release webhook event %s: %v", event.ID, releaseErr)
+		}
+		return fmt.Errorf("failed to save pushed tracking: %w", err)
+	}
+
+	return nil
+}
+
+// manifestTimeLayouts are the layouts of "<manifest_date> <manifest_time>" couriers use
+var manifestTimeLayouts = []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// latestManifestAt returns the time of the newest manifest entry, or the zero time when
+// no entry has a readable date
+func latestManifestAt(trackingData *entity.TrackingData) time.Time {
+	var latest time.Time
+	for _, manifest := range trackingData.Manifest {
+		value := strings.TrimSpace(manifest.ManifestDate + " " + manifest.ManifestTime)
+		for _, layout := range manifestTimeLayouts {
+			at, err := time.Parse(layout, value)
+			if err != nil {
+				continue
+			}
+			if at.After(latest) {
+				latest = at
+			}
+			break
+		}
+	}
+	return latest
+}
+
+// validWebhookSignature checks the signature, the hex HMAC-SHA256 of "<timestamp>.<payload>"
+// keyed with the provider's secret
+func validWebhookSignature(secret string, req request.ShippingWebhookRequest) bool {
+	if secret == "" || req.Timestamp == "" || req.Signature == "" {
+		return false
+	}
+
+	signature, err := hex.DecodeString(req.Signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(signature, signWebhook(secret, req.Timestamp, req.Payload))
+}
+
+func signWebhook(secret, timestamp string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func checkWebhookTimestamp(timestamp string, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return service.ErrInvalidWebhookSignature
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > webhookTolerance || age < -webhookTolerance {
+		return fmt.Errorf("%w: timestamp is %s off", service.ErrWebhookReplayed, age.Round(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package shipping
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+)
+
+// RajaOngkirWebhookProvider is the :provider RajaOngkir tracking pushes are sent to
+const RajaOngkirWebhookProvider = "rajaongkir"
+
+// RajaOngkirWebhookParser reads RajaOngkir tracking pushes, which have the same shape as
+// the waybill tracking response
+type RajaOngkirWebhookParser struct{}
+
+func (RajaOngkirWebhookParser) Parse(payload []byte) (*WebhookTrackingUpdate, error) {
+	var push struct {
+		Data *entity.TrackingData `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &push); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	trackingData := push.Data
+	if trackingData == nil {
+		return nil, errors.New("payload holds no tracking data")
+	}
+
+	awbNumber := strings.TrimSpace(trackingData.Summary.WaybillNumber)
+	courier := strings.ToLower(strings.TrimSpace(trackingData.Summary.CourierCode))
+	if awbNumber == "" || courier == "" {
+		return nil, errors.New("payload holds no waybill number or courier code")
+	}
+
+	return &WebhookTrackingUpdate{
+		AWBNumber:    awbNumber,
+		Courier:      courier,
+		TrackingData: *trackingData,
+	}, nil
+}
@@ -0,0 +1,333 @@
+package shipping
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hanifbg/landing_backend/internal/model/entity"
+	"github.com/hanifbg/landing_backend/internal/model/request"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service"
+	repoMocks "github.com/hanifbg/landing_backend/internal/service/shipping/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+// Helper function to read a recorded webhook payload from testdata
+func loadWebhookFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return payload
+}
+
+// Helper function to create a webhook call signed with the test secret
+func createSignedWebhookRequest(payload []byte, signedAt time.Time) request.ShippingWebhookRequest {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	return request.ShippingWebhookRequest{
+		Provider:  RajaOngkirWebhookProvider,
+		Timestamp: timestamp,
+		Signature: hex.EncodeToString(signWebhook(testWebhookSecret, timestamp, payload)),
+		Payload:   payload,
+	}
+}
+
+func TestRajaOngkirWebhookParser_Parse(t *testing.T) {
+	t.Run("Success - Shipment in transit", func(t *testing.T) {
+		update, err := RajaOngkirWebhookParser{}.Parse(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CGK1234567890", update.AWBNumber)
+		assert.Equal(t, "jne", update.Courier)
+		assert.False(t, update.TrackingData.Delivered)
+		assert.Equal(t, "ON PROCESS", update.TrackingData.DeliveryStatus.Status)
+		assert.Len(t, update.TrackingData.Manifest, 2)
+		assert.Equal(t, "BANDUNG", update.TrackingData.Manifest[1].CityName)
+	})
+
+	t.Run("Success - Delivered shipment", func(t *testing.T) {
+		update, err := RajaOngkirWebhookParser{}.Parse(loadWebhookFixture(t, "rajaongkir_webhook_delivered.json"))
+
+		assert.NoError(t, err)
+		assert.True(t, update.TrackingData.Delivered)
+		assert.Equal(t, "JOHN DOE", update.TrackingData.DeliveryStatus.PODReceiver)
+		assert.Len(t, update.TrackingData.Manifest, 3)
+	})
+
+	t.Run("Error - Payload without waybill number", func(t *testing.T) {
+		update, err := RajaOngkirWebhookParser{}.Parse(loadWebhookFixture(t, "rajaongkir_webhook_missing_waybill.json"))
+
+		assert.Error(t, err)
+		assert.Nil(t, update)
+	})
+
+	t.Run("Error - Payload is not JSON", func(t *testing.T) {
+		update, err := RajaOngkirWebhookParser{}.Parse([]byte("not json"))
+
+		assert.Error(t, err)
+		assert.Nil(t, update)
+	})
+}
+
+// Helper function to create a shipping service accepting RajaOngkir pushes
+func createTestWebhookService(ctrl *gomock.Controller) (*ShippingService, *repoMocks.MockAWBTrackingRepository, *repoMocks.MockPaymentRepository) {
+	svc, _, awbTrackingRepo, paymentRepo := createTestTrackingService(ctrl)
+	svc.WebhookProviders = map[string]WebhookProvider{
+		RajaOngkirWebhookProvider: {Secret: testWebhookSecret, Parser: RajaOngkirWebhookParser{}},
+	}
+	return svc, awbTrackingRepo, paymentRepo
+}
+
+func TestShippingService_HandleTrackingWebhook(t *testing.T) {
+	t.Run("Success - Pushed tracking is saved with the event", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, _ := createTestWebhookService(ctrl)
+
+		tracking := createTestAWBTracking(uuid.New())
+		tracking.CheckFailures = 2
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber("CGK1234567890", "jne").Return(tracking, nil)
+
+		var event *entity.ShippingWebhookEvent
+		awbTrackingRepo.EXPECT().ClaimWebhookEvent(gomock.Any()).
+			DoAndReturn(func(e *entity.ShippingWebhookEvent) error {
+				event = e
+				return nil
+			})
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil)
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "ON PROCESS", tracking.TrackingData.DeliveryStatus.Status)
+		assert.Len(t, tracking.TrackingData.Manifest, 2)
+		assert.Zero(t, tracking.CheckFailures)
+		assert.Nil(t, tracking.DeliveredAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *tracking.NextCheckAt, time.Minute)
+		assert.Equal(t, RajaOngkirWebhookProvider, event.Provider)
+		assert.Equal(t, req.Signature, event.EventID)
+	})
+
+	t.Run("Success - Pushed delivery moves the order to delivered", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, paymentRepo := createTestWebhookService(ctrl)
+
+		orderID := uuid.New()
+		tracking := createTestAWBTracking(orderID)
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_delivered.json"), time.Now())
+
+		gomock.InOrder(
+			awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber("CGK1234567890", "jne").Return(tracking, nil),
+			awbTrackingRepo.EXPECT().ClaimWebhookEvent(gomock.Any()).Return(nil),
+			awbTrackingRepo.EXPECT().GetAWBTrackingByOrderID(orderID).Return([]*entity.AWBTracking{tracking}, nil),
+			paymentRepo.EXPECT().FindOrderByID(orderID.String()).
+				Return(&entity.Order{ID: orderID.String(), OrderStatus: entity.OrderStatusShipped}, nil),
+			paymentRepo.EXPECT().UpdateOrderStatus(gomock.Any()).Return(true, nil),
+			awbTrackingRepo.EXPECT().UpdateAWBTracking(tracking).Return(nil),
+		)
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, tracking.TrackingData.Delivered)
+		assert.NotNil(t, tracking.DeliveredAt)
+	})
+
+	t.Run("Success - Push older than the stored tracking is ignored", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, _ := createTestWebhookService(ctrl)
+
+		delivered, err := RajaOngkirWebhookParser{}.Parse(loadWebhookFixture(t, "rajaongkir_webhook_delivered.json"))
+		require.NoError(t, err)
+		tracking := createTestAWBTracking(uuid.New())
+		tracking.TrackingData = &delivered.TrackingData
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber("CGK1234567890", "jne").Return(tracking, nil)
+		awbTrackingRepo.EXPECT().ClaimWebhookEvent(gomock.Any()).Return(nil)
+
+		// Act
+		err = svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, tracking.TrackingData.Delivered)
+		assert.Len(t, tracking.TrackingData.Manifest, 3)
+	})
+
+	t.Run("Error - Replayed call is rejected before anything is applied", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, _ := createTestWebhookService(ctrl)
+
+		tracking := createTestAWBTracking(uuid.New())
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_delivered.json"), time.Now())
+
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber(gomock.Any(), gomock.Any()).Return(tracking, nil)
+		awbTrackingRepo.EXPECT().ClaimWebhookEvent(gomock.Any()).
+			Return(&repository.AWBTrackingError{Operation: "ClaimWebhookEvent", Err: repository.ErrWebhookEventSeen})
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrWebhookReplayed)
+		assert.Nil(t, tracking.TrackingData)
+		assert.Nil(t, tracking.DeliveredAt)
+	})
+
+	t.Run("Error - Old call is rejected as a replay", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now().Add(-time.Hour))
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrWebhookReplayed)
+	})
+
+	t.Run("Error - Unsigned call is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+		req.Signature = ""
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrInvalidWebhookSignature)
+	})
+
+	t.Run("Error - Tampered payload is rejected", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+		req.Payload = loadWebhookFixture(t, "rajaongkir_webhook_delivered.json")
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrInvalidWebhookSignature)
+	})
+
+	t.Run("Error - Provider without a secret is unknown", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+		req.Provider = "other"
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrUnknownWebhookProvider)
+	})
+
+	t.Run("Error - Payload the parser cannot read", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, _, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_missing_waybill.json"), time.Now())
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrInvalidWebhookPayload)
+	})
+
+	t.Run("Error - Unknown AWB", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.ErrorIs(t, err, service.ErrAWBTrackingNotFound)
+	})
+
+	t.Run("Error - Save failure releases the event for the retry", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, awbTrackingRepo, _ := createTestWebhookService(ctrl)
+
+		req := createSignedWebhookRequest(loadWebhookFixture(t, "rajaongkir_webhook_on_process.json"), time.Now())
+		awbTrackingRepo.EXPECT().GetAWBTrackingByAWBNumber(gomock.Any(), gomock.Any()).Return(createTestAWBTracking(uuid.New()), nil)
+		var event *entity.ShippingWebhookEvent
+		awbTrackingRepo.EXPECT().ClaimWebhookEvent(gomock.Any()).
+			DoAndReturn(func(e *entity.ShippingWebhookEvent) error {
+				event = e
+				return nil
+			})
+		awbTrackingRepo.EXPECT().UpdateAWBTracking(gomock.Any()).Return(errors.New("database error"))
+		awbTrackingRepo.EXPECT().ReleaseWebhookEvent(gomock.Any()).
+			DoAndReturn(func(eventID string) error {
+				assert.Equal(t, event.ID, eventID)
+				return nil
+			})
+
+		// Act
+		err := svc.HandleTrackingWebhook(req)
+
+		// Assert
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, service.ErrWebhookReplayed)
+	})
+}
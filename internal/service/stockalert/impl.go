@@ -0,0 +1,59 @@
+package stockalert
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"text/template"
+	"time"
+
+	"github.com/hanifbg/landing_backend/internal/model/static"
+	"github.com/hanifbg/landing_backend/internal/repository"
+)
+
+var lowStockTemplate = template.Must(template.New("lowStockMessage").Parse(static.LowStockTelegramTemplate))
+
+// Check alerts on the given variants that have just dropped to their low-stock
+// threshold. Call it after their stock changed. Each variant is alerted once until
+// it is restocked above the threshold. Failures are logged, never returned, so a
+// Telegram outage cannot fail the stock change itself; an alert that could not be
+// sent is released and goes out on the variant's next stock change.
+func (a *Alerter) Check(variantIDs []string) {
+	if a == nil || len(variantIDs) == 0 {
+		return
+	}
+
+	// Postgres keeps microseconds, so the claim time is cut to them to be found again
+	claimedAt := time.Now().Truncate(time.Microsecond)
+	variants, err := a.inventoryRepo.ClaimLowStockAlerts(variantIDs, claimedAt)
+	if err != nil {
+		log.Printf("failed to check low stock: %v", err)
+		return
+	}
+	if len(variants) == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := lowStockTemplate.Execute(&buf, variants); err != nil {
+		log.Printf("failed to execute low stock message template: %v", err)
+		a.release(variants, claimedAt)
+		return
+	}
+
+	if _, err := a.telegramRepo.SendMessage(context.Background(), a.chatID, a.threadID, buf.String()); err != nil {
+		log.Printf("failed to send low stock alert: %v", err)
+		a.release(variants, claimedAt)
+	}
+}
+
+// release undoes the claim of variants whose alert was not sent
+func (a *Alerter) release(variants []repository.LowStockVariant, claimedAt time.Time) {
+	ids := make([]string, len(variants))
+	for i, variant := range variants {
+		ids[i] = variant.VariantID
+	}
+	if err := a.inventoryRepo.ReleaseLowStockAlerts(ids, claimedAt); err != nil {
+		log.Printf("failed to release low stock alerts: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package stockalert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanifbg/landing_backend/internal/model/response"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/service/stockalert/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlerter_Check(t *testing.T) {
+	t.Run("Success - Claimed variants are sent to the low-stock thread", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+		telegramRepo := mocks.NewMockTelegramAPI(ctrl)
+		alerter := NewAlerter(inventoryRepo, telegramRepo, -100, 20)
+
+		inventoryRepo.EXPECT().ClaimLowStockAlerts([]string{"variant-1", "variant-2"}, gomock.Any()).
+			Return([]repository.LowStockVariant{
+				{VariantID: "variant-1", ProductName: "Smart Tasbih", VariantName: "Black", SKU: "SKU-001", StockQuantity: 2, LowStockThreshold: 5},
+			}, nil)
+
+		var message string
+		telegramRepo.EXPECT().SendMessage(gomock.Any(), int64(-100), int64(20), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ int64, text string) (*response.Message, error) {
+				message = text
+				return nil, nil
+			})
+
+		// Act
+		alerter.Check([]string{"variant-1", "variant-2"})
+
+		// Assert
+		assert.Contains(t, message, "SKU-001")
+		assert.Contains(t, message, "Smart Tasbih - Black")
+		assert.Contains(t, message, "`2` left (threshold `5`)")
+	})
+
+	t.Run("Success - Nothing sent when no variant crossed its threshold", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+		telegramRepo := mocks.NewMockTelegramAPI(ctrl)
+		alerter := NewAlerter(inventoryRepo, telegramRepo, -100, 20)
+
+		inventoryRepo.EXPECT().ClaimLowStockAlerts([]string{"variant-1"}, gomock.Any()).Return(nil, nil)
+
+		// Act & Assert: the Telegram mock fails the test if it is called
+		alerter.Check([]string{"variant-1"})
+	})
+
+	t.Run("Success - Nil alerter does nothing", func(t *testing.T) {
+		var alerter *Alerter
+
+		assert.NotPanics(t, func() { alerter.Check([]string{"variant-1"}) })
+	})
+
+	t.Run("Error - Failed send releases the claim", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+		telegramRepo := mocks.NewMockTelegramAPI(ctrl)
+		alerter := NewAlerter(inventoryRepo, telegramRepo, -100, 20)
+
+		var claimedAt time.Time
+		inventoryRepo.EXPECT().ClaimLowStockAlerts([]string{"variant-1"}, gomock.Any()).
+			DoAndReturn(func(_ []string, at time.Time) ([]repository.LowStockVariant, error) {
+				claimedAt = at
+				return []repository.LowStockVariant{{VariantID: "variant-1", SKU: "SKU-001", StockQuantity: 2, LowStockThreshold: 5}}, nil
+			})
+		telegramRepo.EXPECT().SendMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("telegram down"))
+
+		var releasedAt time.Time
+		inventoryRepo.EXPECT().ReleaseLowStockAlerts([]string{"variant-1"}, gomock.Any()).
+			DoAndReturn(func(_ []string, at time.Time) error {
+				releasedAt = at
+				return nil
+			})
+
+		// Act
+		alerter.Check([]string{"variant-1"})
+
+		// Assert
+		assert.Equal(t, claimedAt, releasedAt)
+	})
+
+	t.Run("Error - Claim failure sends nothing", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		inventoryRepo := mocks.NewMockInventoryRepository(ctrl)
+		telegramRepo := mocks.NewMockTelegramAPI(ctrl)
+		alerter := NewAlerter(inventoryRepo, telegramRepo, -100, 20)
+
+		inventoryRepo.EXPECT().ClaimLowStockAlerts(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+
+		// Act & Assert
+		alerter.Check([]string{"variant-1"})
+	})
+}
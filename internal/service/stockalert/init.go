@@ -0,0 +1,33 @@
+package stockalert
+
+import (
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/repository"
+	"github.com/hanifbg/landing_backend/internal/repository/util"
+)
+
+// Alerter tells operations on Telegram when variants run low on stock. A nil
+// Alerter sends nothing.
+type Alerter struct {
+	inventoryRepo repository.InventoryRepository
+	telegramRepo  repository.TelegramAPI
+	chatID        int64
+	threadID      int64
+}
+
+func New(cfg *config.AppConfig, repo *util.RepoWrapper) *Alerter {
+	chatID := cfg.TeleLowStockChatID
+	if chatID == 0 {
+		chatID = cfg.TeleOrderChatID
+	}
+	return NewAlerter(repo.InventoryRepo, repo.TelegramRepo, chatID, cfg.TeleLowStockThreadID)
+}
+
+func NewAlerter(inventoryRepo repository.InventoryRepository, telegramRepo repository.TelegramAPI, chatID, threadID int64) *Alerter {
+	return &Alerter{
+		inventoryRepo: inventoryRepo,
+		telegramRepo:  telegramRepo,
+		chatID:        chatID,
+		threadID:      threadID,
+	}
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/hanifbg/landing_backend/internal/repository (interfaces: InventoryRepository,TelegramAPI)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/hanifbg/landing_backend/internal/model/entity"
+	response "github.com/hanifbg/landing_backend/internal/model/response"
+	repository "github.com/hanifbg/landing_backend/internal/repository"
+)
+
+// MockInventoryRepository is a mock of InventoryRepository interface.
+type MockInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryRepositoryMockRecorder
+}
+
+// MockInventoryRepositoryMockRecorder is the mock recorder for MockInventoryRepository.
+type MockInventoryRepositoryMockRecorder struct {
+	mock *MockInventoryRepository
+}
+
+// NewMockInventoryRepository creates a new mock instance.
+func NewMockInventoryRepository(ctrl *gomock.Controller) *MockInventoryRepository {
+	mock := &MockInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryRepository) EXPECT() *MockInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdjustStock mocks base method.
+func (m *MockInventoryRepository) AdjustStock(arg0 *entity.InventoryMovement) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStock", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStock indicates an expected call of AdjustStock.
+func (mr *MockInventoryRepositoryMockRecorder) AdjustStock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStock", reflect.TypeOf((*MockInventoryRepository)(nil).AdjustStock), arg0)
+}
+
+// ClaimLowStockAlerts mocks base method.
+func (m *MockInventoryRepository) ClaimLowStockAlerts(arg0 []string, arg1 time.Time) ([]repository.LowStockVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLowStockAlerts", arg0, arg1)
+	ret0, _ := ret[0].([]repository.LowStockVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLowStockAlerts indicates an expected call of ClaimLowStockAlerts.
+func (mr *MockInventoryRepositoryMockRecorder) ClaimLowStockAlerts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLowStockAlerts", reflect.TypeOf((*MockInventoryRepository)(nil).ClaimLowStockAlerts), arg0, arg1)
+}
+
+// FindInventoryMovements mocks base method.
+func (m *MockInventoryRepository) FindInventoryMovements(arg0 string, arg1, arg2 int) ([]entity.InventoryMovement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInventoryMovements", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entity.InventoryMovement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindInventoryMovements indicates an expected call of FindInventoryMovements.
+func (mr *MockInventoryRepositoryMockRecorder) FindInventoryMovements(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInventoryMovements", reflect.TypeOf((*MockInventoryRepository)(nil).FindInventoryMovements), arg0, arg1, arg2)
+}
+
+// RebuildStock mocks base method.
+func (m *MockInventoryRepository) RebuildStock(arg0 string) (*repository.StockRebuild, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildStock", arg0)
+	ret0, _ := ret[0].(*repository.StockRebuild)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildStock indicates an expected call of RebuildStock.
+func (mr *MockInventoryRepositoryMockRecorder) RebuildStock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildStock", reflect.TypeOf((*MockInventoryRepository)(nil).RebuildStock), arg0)
+}
+
+// ReleaseLowStockAlerts mocks base method.
+func (m *MockInventoryRepository) ReleaseLowStockAlerts(arg0 []string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLowStockAlerts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLowStockAlerts indicates an expected call of ReleaseLowStockAlerts.
+func (mr *MockInventoryRepositoryMockRecorder) ReleaseLowStockAlerts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLowStockAlerts", reflect.TypeOf((*MockInventoryRepository)(nil).ReleaseLowStockAlerts), arg0, arg1)
+}
+
+// SumInventoryMovements mocks base method.
+func (m *MockInventoryRepository) SumInventoryMovements(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumInventoryMovements", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumInventoryMovements indicates an expected call of SumInventoryMovements.
+func (mr *MockInventoryRepositoryMockRecorder) SumInventoryMovements(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumInventoryMovements", reflect.TypeOf((*MockInventoryRepository)(nil).SumInventoryMovements), arg0)
+}
+
+// MockTelegramAPI is a mock of TelegramAPI interface.
+type MockTelegramAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTelegramAPIMockRecorder
+}
+
+// MockTelegramAPIMockRecorder is the mock recorder for MockTelegramAPI.
+type MockTelegramAPIMockRecorder struct {
+	mock *MockTelegramAPI
+}
+
+// NewMockTelegramAPI creates a new mock instance.
+func NewMockTelegramAPI(ctrl *gomock.Controller) *MockTelegramAPI {
+	mock := &MockTelegramAPI{ctrl: ctrl}
+	mock.recorder = &MockTelegramAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTelegramAPI) EXPECT() *MockTelegramAPIMockRecorder {
+	return m.recorder
+}
+
+// SendMessage mocks base method.
+func (m *MockTelegramAPI) SendMessage(arg0 context.Context, arg1, arg2 int64, arg3 string) (*response.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*response.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockTelegramAPIMockRecorder) SendMessage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockTelegramAPI)(nil).SendMessage), arg0, arg1, arg2, arg3)
+}
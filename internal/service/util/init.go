@@ -1,9 +1,15 @@
 package util
 
 import (
+	"log"
+
 	"github.com/hanifbg/landing_backend/config"
 	"github.com/hanifbg/landing_backend/internal/repository/util"
 	"github.com/hanifbg/landing_backend/internal/service"
+	"github.com/hanifbg/landing_backend/internal/service/address"
+	"github.com/hanifbg/landing_backend/internal/service/adminuser"
+	"github.com/hanifbg/landing_backend/internal/service/audit"
+	"github.com/hanifbg/landing_backend/internal/service/auth"
 	"github.com/hanifbg/landing_backend/internal/service/cart"
 	"github.com/hanifbg/landing_backend/internal/service/category"
 	"github.com/hanifbg/landing_backend/internal/service/payment"
@@ -12,20 +18,44 @@ import (
 )
 
 type ServiceWrapper struct {
-	ProductService  service.ProductService
-	CartService     service.CartService
-	PaymentService  service.PaymentService
-	ShippingService service.ShippingService
-	CategoryService service.CategoryService
+	ProductService       service.ProductService
+	ProductAdminService  service.ProductAdminService
+	InventoryService     service.InventoryAdminService
+	CartService          service.CartService
+	PaymentService       service.PaymentService
+	OrderAdminService    service.OrderAdminService
+	ShippingService      service.ShippingService
+	CategoryService      service.CategoryService
+	CategoryAdminService service.CategoryAdminService
+	AuthService          service.AuthService
+	AddressService       service.AddressService
+	AdminUserService     service.AdminUserService
+	AuditLogService      service.AuditLogService
 }
 
 func New(cfg *config.AppConfig, repoWrapper *util.RepoWrapper) (serviceWrapper *ServiceWrapper, err error) {
+	productService := product.New(cfg, repoWrapper)
+	paymentService := payment.New(cfg, repoWrapper)
+	adminUserService := adminuser.New(cfg, repoWrapper)
+	if err := adminUserService.BootstrapOwner(cfg.AdminOwnerEmail, cfg.AdminOwnerPassword); err != nil {
+		log.Printf("failed to create the owner admin: %v", err)
+	}
+	categoryService := category.NewCategoryService(repoWrapper.CategoryRepo, repoWrapper.ProductRepo, repoWrapper.AuditLogRepo)
+
 	serviceWrapper = &ServiceWrapper{
-		ProductService:  product.New(cfg, repoWrapper),
-		CartService:     cart.New(cfg, repoWrapper),
-		PaymentService:  payment.New(cfg, repoWrapper),
-		ShippingService: shipping.New(cfg, repoWrapper),
-		CategoryService: category.NewCategoryService(repoWrapper.CategoryRepo, repoWrapper.ProductRepo),
+		ProductService:       productService,
+		ProductAdminService:  productService,
+		InventoryService:     productService,
+		CartService:          cart.New(cfg, repoWrapper),
+		PaymentService:       paymentService,
+		OrderAdminService:    paymentService,
+		ShippingService:      shipping.New(cfg, repoWrapper),
+		CategoryService:      categoryService,
+		CategoryAdminService: categoryService,
+		AuthService:          auth.New(cfg, repoWrapper),
+		AddressService:       address.New(cfg, repoWrapper),
+		AdminUserService:     adminUserService,
+		AuditLogService:      audit.New(cfg, repoWrapper),
 	}
 
 	return
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hanifbg/landing_backend/config"
+	"github.com/hanifbg/landing_backend/internal/scheduler"
+	"github.com/hanifbg/landing_backend/internal/service/shipping"
+)
+
+const defaultPaymentExpirySweepInterval = 5 * time.Minute
+
+const defaultLocationWarmupTimeout = 30 * time.Second
+
+// WarmUp prepares data the first requests need before the HTTP server starts
+func (w *ServiceWrapper) WarmUp(cfg *config.AppConfig) {
+	if !cfg.RajaOngkirWarmupOnStartup {
+		return
+	}
+
+	timeout := defaultLocationWarmupTimeout
+	if cfg.RajaOngkirWarmupTimeoutSecs > 0 {
+		timeout = time.Duration(cfg.RajaOngkirWarmupTimeoutSecs) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stored, err := w.ShippingService.WarmUpLocations(ctx)
+	if err != nil {
+		log.Printf("location warm-up stopped after %d lists: %v", stored, err)
+		return
+	}
+	log.Printf("location warm-up stored %d lists", stored)
+}
+
+// Jobs returns the background jobs run alongside the HTTP server
+func (w *ServiceWrapper) Jobs(cfg *config.AppConfig) []scheduler.Job {
+	paymentExpiryInterval := defaultPaymentExpirySweepInterval
+	if cfg.PaymentExpirySweepIntervalMins > 0 {
+		paymentExpiryInterval = time.Duration(cfg.PaymentExpirySweepIntervalMins) * time.Minute
+	}
+
+	locationSyncInterval := shipping.DefaultLocationSyncInterval
+	if cfg.LocationSyncIntervalMins > 0 {
+		locationSyncInterval = time.Duration(cfg.LocationSyncIntervalMins) * time.Minute
+	}
+
+	return []scheduler.Job{
+		{
+			Name:     "payment-expiry",
+			Interval: paymentExpiryInterval,
+			Run: func(ctx context.Context) error {
+				_, err := w.PaymentService.ExpireOverduePayments(ctx)
+				return err
+			},
+		},
+		{
+			Name:     "awb-tracking-refresh",
+			Interval: shipping.AWBRefreshInterval(cfg),
+			Run: func(ctx context.Context) error {
+				_, err := w.ShippingService.RefreshAWBTrackings(ctx)
+				return err
+			},
+		},
+		{
+			Name:     "location-sync",
+			Interval: locationSyncInterval,
+			Run: func(ctx context.Context) error {
+				_, err := w.ShippingService.SyncLocations(ctx)
+				return err
+			},
+		},
+	}
+}